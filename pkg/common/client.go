@@ -2,13 +2,22 @@ package common
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"math/rand"
+	"mime/multipart"
+	"net"
 	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/keycloak/keycloak-operator/pkg/apis/keycloak/v1alpha1"
@@ -22,30 +31,335 @@ import (
 
 const (
 	authURL = "auth/realms/master/protocol/openid-connect/token"
+
+	pageSize = 100
+
+	// maxFederatedIdentityCandidates is how many users GetUserByFederatedIdentity
+	// checks on servers that ignore its search parameters
+	maxFederatedIdentityCandidates = 10
+
+	requiredActionUpdatePassword = "UPDATE_PASSWORD"
+
+	defaultTimeout                 = 10 * time.Second
+	defaultTokenRefreshGracePeriod = 10 * time.Second
+
+	// maxErrorBodySize is how much of the body of an error response is kept
+	// in the APIError
+	maxErrorBodySize = 4096
 )
 
 type Requester interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+// Client is a Keycloak admin API client, create it with NewClient. The zero
+// value is usable but unauthenticated and uses http.DefaultClient
 type Client struct {
 	requester Requester
 	URL       string
-	token     string
+
+	// tokenMu guards the token and the credentials used to refresh it
+	tokenMu      sync.Mutex
+	token        string
+	refreshToken string
+	tokenExpiry  time.Time
+	username     string
+	password     string
+	// tokenRefreshGracePeriod is how long before the token expires it's
+	// refreshed, defaultTokenRefreshGracePeriod is used when it's zero
+	tokenRefreshGracePeriod time.Duration
+}
+
+// ClientOption configures the Client created by NewClient
+type ClientOption func(o *clientOptions)
+
+type clientOptions struct {
+	requester               Requester
+	tlsConfig               *tls.Config
+	insecureSkipVerify      bool
+	proxy                   *url.URL
+	timeout                 time.Duration
+	username                string
+	password                string
+	tokenRefreshGracePeriod time.Duration
+	retryMaxAttempts        int
+	retryInitialDelay       time.Duration
+}
+
+// WithHTTPClient sets the client used for the requests to Keycloak, it can't
+// be combined with the TLS, proxy or timeout options
+func WithHTTPClient(requester Requester) ClientOption {
+	return func(o *clientOptions) {
+		o.requester = requester
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the HTTP client, e.g. RootCAs
+// with the internal CA that signed the certificate of the Keycloak server
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(o *clientOptions) {
+		o.tlsConfig = config
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the certificate of the
+// Keycloak server.
+//
+// WARNING: this makes the client accept any certificate, anyone able to
+// intercept the traffic can impersonate the server and read the admin
+// credentials and tokens. Only use it in development environments, use
+// WithTLSConfig with the CA of a self-signed certificate instead
+func WithInsecureSkipVerify() ClientOption {
+	return func(o *clientOptions) {
+		o.insecureSkipVerify = true
+	}
+}
+
+// WithProxy routes all the requests through the given HTTP proxy, it can be
+// combined with the TLS options
+func WithProxy(proxyURL *url.URL) ClientOption {
+	return func(o *clientOptions) {
+		o.proxy = proxyURL
+	}
+}
+
+// WithTimeout sets the timeout of the requests, the default is 10 seconds
+func WithTimeout(d time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.timeout = d
+	}
+}
+
+// WithCredentials sets the admin credentials the client logs in with
+func WithCredentials(user, pass string) ClientOption {
+	return func(o *clientOptions) {
+		o.username = user
+		o.password = pass
+	}
+}
+
+// WithTokenRefreshGracePeriod sets how long before the auth token expires the
+// client logs in again, the default is 10 seconds
+func WithTokenRefreshGracePeriod(d time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.tokenRefreshGracePeriod = d
+	}
+}
+
+// WithRetry retries the requests that fail with a network error or a 5xx
+// response up to maxAttempts times in total. POST requests aren't idempotent,
+// so they're only retried when the connection couldn't be established. The
+// delay between the attempts starts at initialDelay and doubles after each
+// attempt, with jitter. The retries stop when the context of the request is
+// done
+func WithRetry(maxAttempts int, initialDelay time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.retryMaxAttempts = maxAttempts
+		o.retryInitialDelay = initialDelay
+	}
+}
+
+// NewClient returns a client for the Keycloak server at keycloakURL, e.g.
+// "https://keycloak.example.com". No request is made until the client is
+// first used, it logs in then if WithCredentials was given
+func NewClient(keycloakURL string, opts ...ClientOption) (*Client, error) {
+	u, err := url.Parse(keycloakURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid Keycloak URL")
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.Errorf("invalid Keycloak URL %q, scheme and host must be set", keycloakURL)
+	}
+
+	o := &clientOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	requester := o.requester
+	if requester == nil {
+		requester = newRequester(o)
+	} else if o.tlsConfig != nil || o.insecureSkipVerify || o.proxy != nil || o.timeout != 0 {
+		return nil, errors.New("WithHTTPClient can't be combined with the TLS, proxy or timeout options")
+	}
+	if o.retryMaxAttempts > 1 {
+		requester = &retryRequester{
+			requester:    requester,
+			maxAttempts:  o.retryMaxAttempts,
+			initialDelay: o.retryInitialDelay,
+		}
+	}
+
+	return &Client{
+		requester:               requester,
+		URL:                     strings.TrimSuffix(keycloakURL, "/"),
+		username:                o.username,
+		password:                o.password,
+		tokenRefreshGracePeriod: o.tokenRefreshGracePeriod,
+	}, nil
+}
+
+// newRequester returns the HTTP client configured by the options
+func newRequester(o *clientOptions) Requester {
+	tlsConfig := o.tlsConfig
+	if o.insecureSkipVerify {
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		} else {
+			tlsConfig = tlsConfig.Clone()
+		}
+		tlsConfig.InsecureSkipVerify = true // nolint
+	}
+
+	timeout := o.timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	transport := &http.Transport{TLSClientConfig: tlsConfig}
+	if o.proxy != nil {
+		transport.Proxy = http.ProxyURL(o.proxy)
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}
+}
+
+// retryRequester retries the requests that fail with a network error or a
+// 5xx response with exponential backoff. Keycloak may have applied a POST that
+// failed with a 502 or 504, so those are only retried when they weren't sent
+type retryRequester struct {
+	requester    Requester
+	maxAttempts  int
+	initialDelay time.Duration
+}
+
+func (r *retryRequester) Do(req *http.Request) (*http.Response, error) {
+	delay := r.initialDelay
+	for attempt := 1; ; attempt++ {
+		res, err := r.requester.Do(req)
+		if attempt >= r.maxAttempts || req.Context().Err() != nil {
+			return res, err
+		}
+		if err == nil && (res.StatusCode < 500 || !isIdempotent(req.Method)) {
+			return res, nil
+		}
+		if err != nil && !isIdempotent(req.Method) && !isDialError(err) {
+			return res, err
+		}
+		// The body was consumed by the failed attempt, requests whose body
+		// can't be read again aren't retried
+		if req.Body != nil && req.GetBody == nil {
+			return res, err
+		}
+		if res != nil {
+			res.Body.Close()
+		}
+
+		// Wait between half and the full delay so the clients that failed
+		// together don't retry together
+		wait := delay / 2
+		if wait > 0 {
+			wait += time.Duration(rand.Int63n(int64(wait)))
+		}
+		logrus.Debugf("retrying %s %s in %s, attempt %d failed", req.Method, req.URL, wait, attempt)
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+		delay *= 2
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, errors.Wrap(err, "error resetting the request body")
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// isIdempotent reports whether sending a request with the method more than
+// once has the same effect as sending it once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// isDialError reports whether the request failed because the connection
+// couldn't be established, i.e. before it was sent
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return stderrors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// newAPIError returns the error for an unexpected response, the message is
+// formatted from format and args followed by the status and the error message
+// of the server if the body has one
+func newAPIError(res *http.Response, format string, args ...interface{}) *APIError {
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+	if err != nil {
+		logrus.Errorf("error reading response %+v", err)
+	}
+	message := fmt.Sprintf("%s: (%d) %s", fmt.Sprintf(format, args...), res.StatusCode, res.Status)
+	if serverMessage := errorMessage(body); serverMessage != "" {
+		message += ": " + serverMessage
+	}
+	return &APIError{
+		StatusCode: res.StatusCode,
+		Body:       string(body),
+		Message:    message,
+	}
+}
+
+// errorMessage returns the error message of an error response body, Keycloak
+// uses errorMessage, error_description or error depending on the endpoint
+func errorMessage(body []byte) string {
+	errorRes := &struct {
+		ErrorMessage     string `json:"errorMessage"`
+		ErrorDescription string `json:"error_description"`
+		Error            string `json:"error"`
+	}{}
+	if err := json.Unmarshal(body, errorRes); err != nil {
+		return ""
+	}
+	switch {
+	case errorRes.ErrorMessage != "":
+		return errorRes.ErrorMessage
+	case errorRes.ErrorDescription != "":
+		return errorRes.ErrorDescription
+	}
+	return errorRes.Error
+}
+
+// do performs the request, http.DefaultClient is used for zero value clients
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	if c.requester == nil {
+		return http.DefaultClient.Do(req)
+	}
+	return c.requester.Do(req)
 }
 
 // T is a generic type for keycloak spec resources
 type T interface{}
 
 // Generic create function for creating new Keycloak resources
-func (c *Client) create(obj T, resourcePath, resourceName string) (string, error) {
+func (c *Client) create(ctx context.Context, obj T, resourcePath, resourceName string) (string, error) {
 	jsonValue, err := json.Marshal(obj)
 	if err != nil {
 		logrus.Errorf("error %+v marshalling object", err)
-		return "", nil
+		return "", errors.Wrapf(err, "error marshalling %s", resourceName)
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf("%s/auth/admin/%s", c.URL, resourcePath),
 		bytes.NewBuffer(jsonValue),
@@ -56,8 +370,10 @@ func (c *Client) create(obj T, resourcePath, resourceName string) (string, error
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
-	res, err := c.requester.Do(req)
+	if err := c.authorize(ctx, req); err != nil {
+		return "", err
+	}
+	res, err := c.do(req)
 
 	if err != nil {
 		logrus.Errorf("error on request %+v", err)
@@ -66,12 +382,7 @@ func (c *Client) create(obj T, resourcePath, resourceName string) (string, error
 	defer res.Body.Close()
 
 	if res.StatusCode != 201 && res.StatusCode != 204 {
-		return "", fmt.Errorf("failed to create %s: (%d) %s", resourceName, res.StatusCode, res.Status)
-	}
-
-	if resourceName == "client" {
-		d, _ := ioutil.ReadAll(res.Body)
-		fmt.Println("user response ", string(d))
+		return "", newAPIError(res, "failed to create %s", resourceName)
 	}
 
 	location := strings.Split(res.Header.Get("Location"), "/")
@@ -79,28 +390,192 @@ func (c *Client) create(obj T, resourcePath, resourceName string) (string, error
 	return uid, nil
 }
 
-func (c *Client) CreateRealm(realm *v1alpha1.KeycloakRealm) (string, error) {
-	return c.create(realm.Spec.Realm, "realms", "realm")
+// Generic post function for Keycloak actions that respond with a
+// representation rather than a Location header
+func (c *Client) post(ctx context.Context, obj T, resourcePath, resourceName string, unMarshalFunc func(body []byte) (T, error)) (T, error) {
+	var body io.Reader
+	if obj != nil {
+		jsonValue, err := json.Marshal(obj)
+		if err != nil {
+			logrus.Errorf("error %+v marshalling object", err)
+			return nil, errors.Wrapf(err, "error marshalling %s", resourceName)
+		}
+		body = bytes.NewBuffer(jsonValue)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/auth/admin/%s", c.URL, resourcePath),
+		body,
+	)
+	if err != nil {
+		logrus.Errorf("error creating POST %s request %+v", resourceName, err)
+		return nil, errors.Wrapf(err, "error creating POST %s request", resourceName)
+	}
+
+	if obj != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+	res, err := c.do(req)
+	if err != nil {
+		logrus.Errorf("error on request %+v", err)
+		return nil, errors.Wrapf(err, "error performing POST %s request", resourceName)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, newAPIError(res, "failed to POST %s", resourceName)
+	}
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		logrus.Errorf("error reading response %+v", err)
+		return nil, errors.Wrapf(err, "error reading %s POST response", resourceName)
+	}
+
+	return unMarshalFunc(resBody)
+}
+
+func (c *Client) CreateRealm(ctx context.Context, realm *v1alpha1.KeycloakRealm) (string, error) {
+	return c.create(ctx, realm.Spec.Realm, "realms", "realm")
+}
+
+// CreateClient creates the client and returns the ID Keycloak assigned to it,
+// ErrAlreadyExists is returned if the clientId is in use
+func (c *Client) CreateClient(ctx context.Context, client *v1alpha1.KeycloakAPIClient, realmName string) (string, error) {
+	return c.create(ctx, client, fmt.Sprintf("realms/%s/clients", realmName), "client")
+}
+
+func (c *Client) CreateUser(ctx context.Context, user *v1alpha1.KeycloakAPIUser, realmName string) (string, error) {
+	return c.create(ctx, user, fmt.Sprintf("realms/%s/users", realmName), "user")
 }
 
-func (c *Client) CreateClient(client *v1alpha1.KeycloakAPIClient, realmName string) (string, error) {
-	return c.create(client, fmt.Sprintf("realms/%s/clients", realmName), "client")
+// BulkCreateUsers creates the users with up to concurrency requests in
+// flight and sets the ID Keycloak assigned to each created user. The returned
+// errors are in the same order as the users, the error of a user that was
+// created is nil
+func (c *Client) BulkCreateUsers(ctx context.Context, users []*v1alpha1.KeycloakAPIUser, realmName string, concurrency int) []error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	errs := make([]error, len(users))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, user := range users {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, user *v1alpha1.KeycloakAPIUser) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			id, err := c.CreateUser(ctx, user, realmName)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			user.ID = id
+		}(i, user)
+	}
+	wg.Wait()
+	return errs
 }
 
-func (c *Client) CreateUser(user *v1alpha1.KeycloakAPIUser, realmName string) (string, error) {
-	return c.create(user, fmt.Sprintf("realms/%s/users", realmName), "user")
+func (c *Client) CreateFederatedIdentity(ctx context.Context, fid v1alpha1.FederatedIdentity, userID string, realmName string) (string, error) {
+	return c.create(ctx, fid, fmt.Sprintf("realms/%s/users/%s/federated-identity/%s", realmName, userID, fid.IdentityProvider), "federated-identity")
 }
 
-func (c *Client) CreateFederatedIdentity(fid v1alpha1.FederatedIdentity, userID string, realmName string) (string, error) {
-	return c.create(fid, fmt.Sprintf("realms/%s/users/%s/federated-identity/%s", realmName, userID, fid.IdentityProvider), "federated-identity")
+func (c *Client) RemoveFederatedIdentity(ctx context.Context, fid v1alpha1.FederatedIdentity, userID string, realmName string) error {
+	return c.delete(ctx, fmt.Sprintf("realms/%s/users/%s/federated-identity/%s", realmName, userID, fid.IdentityProvider), "federated-identity", fid)
 }
 
-func (c *Client) RemoveFederatedIdentity(fid v1alpha1.FederatedIdentity, userID string, realmName string) error {
-	return c.delete(fmt.Sprintf("realms/%s/users/%s/federated-identity/%s", realmName, userID, fid.IdentityProvider), "federated-identity", fid)
+// UnlinkUserFromIdP removes the link between a user and the given identity
+// provider, ErrNotFound is returned if the user isn't linked to it
+func (c *Client) UnlinkUserFromIdP(ctx context.Context, userID, realmName, providerAlias string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/users/%s/federated-identity/%s", realmName, userID, providerAlias), "federated-identity", nil)
+}
+
+// UnlinkAllUsersFromIdP removes the links to the given identity provider from
+// every user in the realm, using up to concurrency parallel requests. It returns
+// the number of links removed. Users that turn out not to be linked are
+// skipped, any other failure is returned once all users have been processed.
+//
+// Users are looked up with the idpAlias search parameter, which requires
+// Keycloak 22 or later. Older servers ignore it and every user in the realm is
+// attempted instead.
+func (c *Client) UnlinkAllUsersFromIdP(ctx context.Context, realmName, providerAlias string, concurrency int) (int, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	// Collect the users up front, paging through the filtered list while
+	// links are being removed would skip users
+	var userIDs []string
+	for first := 0; ; first += pageSize {
+		query := url.Values{}
+		query.Set("idpAlias", providerAlias)
+		query.Set("briefRepresentation", "true")
+		query.Set("first", strconv.Itoa(first))
+		query.Set("max", strconv.Itoa(pageSize))
+		result, err := c.list(ctx, fmt.Sprintf("realms/%s/users?%s", realmName, query.Encode()), "users", func(body []byte) (T, error) {
+			var users []*v1alpha1.KeycloakAPIUser
+			err := json.Unmarshal(body, &users)
+			return users, err
+		})
+		if err != nil {
+			return 0, err
+		}
+		users, _ := result.([]*v1alpha1.KeycloakAPIUser)
+		for _, user := range users {
+			userIDs = append(userIDs, user.ID)
+		}
+		if len(users) < pageSize {
+			break
+		}
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		removed  int
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+	for _, userID := range userIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(userID string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			err := c.UnlinkUserFromIdP(ctx, userID, realmName, providerAlias)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				removed++
+			case stderrors.Is(err, ErrNotFound):
+			case firstErr == nil:
+				firstErr = fmt.Errorf("failed to unlink user %s: %w", userID, err)
+			}
+		}(userID)
+	}
+	wg.Wait()
+
+	return removed, firstErr
 }
 
-func (c *Client) GetUserFederatedIdentities(userID string, realmName string) ([]v1alpha1.FederatedIdentity, error) {
-	result, err := c.get(fmt.Sprintf("realms/%s/users/%s/federated-identity", realmName, userID), "federated-identity", func(body []byte) (T, error) {
+// GetUserFederatedIdentities returns the identity provider accounts linked to
+// the user, ErrNotFound is returned if the user doesn't exist
+func (c *Client) GetUserFederatedIdentities(ctx context.Context, userID string, realmName string) ([]v1alpha1.FederatedIdentity, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/users/%s/federated-identity", realmName, userID), "federated-identity", func(body []byte) (T, error) {
 		var fids []v1alpha1.FederatedIdentity
 		err := json.Unmarshal(body, &fids)
 		return fids, err
@@ -108,30 +583,236 @@ func (c *Client) GetUserFederatedIdentities(userID string, realmName string) ([]
 	if err != nil {
 		return nil, err
 	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
 	return result.([]v1alpha1.FederatedIdentity), err
 }
 
-func (c *Client) CreateUserClientRole(role *v1alpha1.KeycloakUserRole, realmName, clientID, userID string) (string, error) {
+// CreateIdentityProviderMapper adds a mapper to the identity provider and sets
+// the ID Keycloak assigned to it on the mapper
+func (c *Client) CreateIdentityProviderMapper(ctx context.Context, alias, realmName string, mapper *IdentityProviderMapper) error {
+	id, err := c.create(ctx, mapper, fmt.Sprintf("realms/%s/identity-provider/instances/%s/mappers", realmName, alias), "identity provider mapper")
+	if err != nil {
+		return err
+	}
+	mapper.ID = id
+	return nil
+}
+
+// CreateClientScope creates the client scope and sets the ID Keycloak assigned
+// to it on the scope, ErrAlreadyExists is returned if the name is in use
+func (c *Client) CreateClientScope(ctx context.Context, scope *ClientScope, realmName string) error {
+	id, err := c.create(ctx, scope, fmt.Sprintf("realms/%s/client-scopes", realmName), "client scope")
+	if err != nil {
+		return err
+	}
+	scope.ID = id
+	return nil
+}
+
+// CopyClientScope creates a client scope named newName with the settings and
+// protocol mappers of the client scope sourceScopeID and returns its ID. If the
+// scope is created but a protocol mapper can't be copied, the ID is returned
+// along with a *ClientScopeCopyIncompleteError.
+func (c *Client) CopyClientScope(ctx context.Context, sourceScopeID, newName, realmName string) (string, error) {
+	source, err := c.GetClientScope(ctx, sourceScopeID, realmName)
+	if err != nil {
+		return "", err
+	}
+	mappers, err := c.ListProtocolMappersForClientScope(ctx, sourceScopeID, realmName)
+	if err != nil {
+		return "", err
+	}
+
+	scope := *source
+	scope.ID = ""
+	scope.Name = newName
+	if err := c.CreateClientScope(ctx, &scope, realmName); err != nil {
+		return "", err
+	}
+
+	for _, mapper := range mappers {
+		copied := *mapper
+		copied.ID = ""
+		if err := c.CreateProtocolMapperForClientScope(ctx, scope.ID, realmName, &copied); err != nil {
+			return scope.ID, &ClientScopeCopyIncompleteError{ScopeID: scope.ID, Err: err}
+		}
+	}
+	return scope.ID, nil
+}
+
+// CreateProtocolMapperForClient adds a protocol mapper to the client and sets
+// the ID Keycloak assigned to it on the mapper
+func (c *Client) CreateProtocolMapperForClient(ctx context.Context, clientID, realmName string, mapper *ProtocolMapper) error {
+	id, err := c.create(ctx, mapper, fmt.Sprintf("realms/%s/clients/%s/protocol-mappers/models", realmName, clientID), "client protocol mapper")
+	if err != nil {
+		return err
+	}
+	mapper.ID = id
+	return nil
+}
+
+// CreateProtocolMapperForClientScope adds a protocol mapper to the client scope
+// and sets the ID Keycloak assigned to it on the mapper
+func (c *Client) CreateProtocolMapperForClientScope(ctx context.Context, scopeID, realmName string, mapper *ProtocolMapper) error {
+	id, err := c.create(ctx, mapper, fmt.Sprintf("realms/%s/client-scopes/%s/protocol-mappers/models", realmName, scopeID), "client scope protocol mapper")
+	if err != nil {
+		return err
+	}
+	mapper.ID = id
+	return nil
+}
+
+// CreateClientRole creates the role of the client, ErrAlreadyExists is
+// returned if the name is in use. Keycloak doesn't return the ID of the role,
+// use GetClientRoleByName to get it
+func (c *Client) CreateClientRole(ctx context.Context, clientID, realmName string, role *v1alpha1.KeycloakUserRole) error {
+	_, err := c.create(ctx, role, fmt.Sprintf("realms/%s/clients/%s/roles", realmName, clientID), "client role")
+	return err
+}
+
+// AddCompositesToClientRole makes the realm and client roles composites of
+// the role of the client
+func (c *Client) AddCompositesToClientRole(ctx context.Context, clientID, roleName, realmName string, composites []*v1alpha1.KeycloakUserRole) error {
+	_, err := c.create(ctx, composites, clientRolePath(clientID, roleName, realmName)+"/composites", "client role composites")
+	return err
+}
+
+// AddClientScopeRealmScopeMappings allows the realm roles to be included in
+// the tokens issued with the client scope
+func (c *Client) AddClientScopeRealmScopeMappings(ctx context.Context, scopeID, realmName string, roles []*v1alpha1.KeycloakUserRole) error {
+	_, err := c.create(ctx, roles, fmt.Sprintf("realms/%s/client-scopes/%s/scope-mappings/realm", realmName, scopeID), "client scope realm scope mappings")
+	return err
+}
+
+// AddClientScopeClientScopeMappings allows the roles of the client
+// targetClientID to be included in the tokens issued with the client scope
+func (c *Client) AddClientScopeClientScopeMappings(ctx context.Context, scopeID, targetClientID, realmName string, roles []*v1alpha1.KeycloakUserRole) error {
+	_, err := c.create(ctx, roles, fmt.Sprintf("realms/%s/client-scopes/%s/scope-mappings/clients/%s", realmName, scopeID, targetClientID), "client scope client scope mappings")
+	return err
+}
+
+// RegisterRequiredAction registers the required action provider in the realm,
+// ErrAlreadyExists is returned if it's already registered
+func (c *Client) RegisterRequiredAction(ctx context.Context, realmName, providerID string) error {
+	provider := map[string]string{
+		"providerId": providerID,
+	}
+	_, err := c.create(ctx, provider, fmt.Sprintf("realms/%s/authentication/register-required-action", realmName), "required action")
+	return err
+}
+
+// CreateAuthzResource creates the protected resource in the resource server of
+// the client and returns the ID Keycloak assigned to it, ErrAlreadyExists is
+// returned if the name is in use
+func (c *Client) CreateAuthzResource(ctx context.Context, clientID, realmName string, resource *AuthzResource) (string, error) {
+	// Keycloak responds with the created resource rather than a Location
+	result, err := c.post(ctx, resource, authzResourcesPath(clientID, realmName), "authz resource", unmarshalAuthzResource)
+	if err != nil {
+		return "", err
+	}
+	return result.(*AuthzResource).ID, nil
+}
+
+// CreateAuthzScope creates the scope in the resource server of the client and
+// returns the ID Keycloak assigned to it, ErrAlreadyExists is returned if the
+// name is in use
+func (c *Client) CreateAuthzScope(ctx context.Context, clientID, realmName string, scope *AuthzScope) (string, error) {
+	result, err := c.post(ctx, scope, authzScopesPath(clientID, realmName), "authz scope", func(body []byte) (T, error) {
+		scope := &AuthzScope{}
+		err := json.Unmarshal(body, scope)
+		return scope, err
+	})
+	if err != nil {
+		return "", err
+	}
+	return result.(*AuthzScope).ID, nil
+}
+
+// CreateComponent creates the component, e.g. a user federation provider, and
+// sets the ID Keycloak assigned to it on the component
+func (c *Client) CreateComponent(ctx context.Context, component *Component, realmName string) error {
+	if err := validateComponent(component); err != nil {
+		return err
+	}
+	id, err := c.create(ctx, component, fmt.Sprintf("realms/%s/components", realmName), "component")
+	if err != nil {
+		return err
+	}
+	component.ID = id
+	return nil
+}
+
+func validateComponent(component *Component) error {
+	if component.ProviderID == "" {
+		return errors.New("component provider ID must be set")
+	}
+	if component.ProviderType == "" {
+		return errors.New("component provider type must be set")
+	}
+	return nil
+}
+
+// CreateAuthzPolicy creates the policy of the given type, e.g. "role" or
+// "group", in the resource server of the client. policy is the JSON
+// representation for that type
+func (c *Client) CreateAuthzPolicy(ctx context.Context, clientID, realmName, policyType string, policy json.RawMessage) (*AuthzPolicy, error) {
+	result, err := c.post(ctx, policy, authzPoliciesPath(clientID, realmName)+"/"+policyType, "authz policy", unmarshalAuthzPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*AuthzPolicy), nil
+}
+
+// CreateAuthzResourcePermission creates the resource based permission in the
+// resource server of the client and returns the ID Keycloak assigned to it
+func (c *Client) CreateAuthzResourcePermission(ctx context.Context, clientID, realmName string, permission *AuthzPermission) (string, error) {
+	return c.createAuthzPermission(ctx, clientID, realmName, "resource", permission)
+}
+
+// CreateAuthzScopePermission creates the scope based permission in the
+// resource server of the client and returns the ID Keycloak assigned to it
+func (c *Client) CreateAuthzScopePermission(ctx context.Context, clientID, realmName string, permission *AuthzPermission) (string, error) {
+	return c.createAuthzPermission(ctx, clientID, realmName, "scope", permission)
+}
+
+func (c *Client) createAuthzPermission(ctx context.Context, clientID, realmName, permissionType string, permission *AuthzPermission) (string, error) {
+	result, err := c.post(ctx, permission, authzPermissionsPath(clientID, realmName)+"/"+permissionType, "authz permission", func(body []byte) (T, error) {
+		permission := &AuthzPermission{}
+		err := json.Unmarshal(body, permission)
+		return permission, err
+	})
+	if err != nil {
+		return "", err
+	}
+	return result.(*AuthzPermission).ID, nil
+}
+
+func (c *Client) CreateUserClientRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, clientID, userID string) (string, error) {
 	return c.create(
+		ctx,
 		[]*v1alpha1.KeycloakUserRole{role},
 		fmt.Sprintf("realms/%s/users/%s/role-mappings/clients/%s", realmName, userID, clientID),
 		"user-client-role",
 	)
 }
-func (c *Client) CreateUserRealmRole(role *v1alpha1.KeycloakUserRole, realmName, userID string) (string, error) {
+func (c *Client) CreateUserRealmRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, userID string) (string, error) {
 	return c.create(
+		ctx,
 		[]*v1alpha1.KeycloakUserRole{role},
 		fmt.Sprintf("realms/%s/users/%s/role-mappings/realm", realmName, userID),
 		"user-realm-role",
 	)
 }
 
-func (c *Client) CreateAuthenticatorConfig(authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName, executionID string) (string, error) {
-	return c.create(authenticatorConfig, fmt.Sprintf("realms/%s/authentication/executions/%s/config", realmName, executionID), "AuthenticatorConfig")
+func (c *Client) CreateAuthenticatorConfig(ctx context.Context, authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName, executionID string) (string, error) {
+	return c.create(ctx, authenticatorConfig, fmt.Sprintf("realms/%s/authentication/executions/%s/config", realmName, executionID), "AuthenticatorConfig")
 }
 
-func (c *Client) DeleteUserClientRole(role *v1alpha1.KeycloakUserRole, realmName, clientID, userID string) error {
+func (c *Client) DeleteUserClientRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, clientID, userID string) error {
 	err := c.delete(
+		ctx,
 		fmt.Sprintf("realms/%s/users/%s/role-mappings/clients/%s", realmName, userID, clientID),
 		"user-client-role",
 		[]*v1alpha1.KeycloakUserRole{role},
@@ -139,8 +820,9 @@ func (c *Client) DeleteUserClientRole(role *v1alpha1.KeycloakUserRole, realmName
 	return err
 }
 
-func (c *Client) DeleteUserRealmRole(role *v1alpha1.KeycloakUserRole, realmName, userID string) error {
+func (c *Client) DeleteUserRealmRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, userID string) error {
 	err := c.delete(
+		ctx,
 		fmt.Sprintf("realms/%s/users/%s/role-mappings/realm", realmName, userID),
 		"user-realm-role",
 		[]*v1alpha1.KeycloakUserRole{role},
@@ -148,20 +830,50 @@ func (c *Client) DeleteUserRealmRole(role *v1alpha1.KeycloakUserRole, realmName,
 	return err
 }
 
-func (c *Client) UpdatePassword(user *v1alpha1.KeycloakAPIUser, realmName, newPass string) error {
+func (c *Client) UpdatePassword(ctx context.Context, user *v1alpha1.KeycloakAPIUser, realmName, newPass string) error {
+	return c.resetPassword(ctx, user.ID, realmName, newPass, false)
+}
+
+// SetTemporaryPassword resets the user's password to a temporary one and adds
+// the UPDATE_PASSWORD required action, so the user has to choose a new
+// password on the next login. If the password is reset but the required action
+// can't be added, a *PasswordResetIncompleteError is returned.
+func (c *Client) SetTemporaryPassword(ctx context.Context, userID, realmName, password string) error {
+	if err := c.resetPassword(ctx, userID, realmName, password, true); err != nil {
+		return err
+	}
+
+	user, err := c.GetUser(ctx, userID, realmName)
+	if err != nil {
+		return &PasswordResetIncompleteError{UserID: userID, Err: err}
+	}
+
+	for _, action := range user.RequiredActions {
+		if action == requiredActionUpdatePassword {
+			return nil
+		}
+	}
+	user.RequiredActions = append(user.RequiredActions, requiredActionUpdatePassword)
+	if err := c.UpdateUser(ctx, user, realmName); err != nil {
+		return &PasswordResetIncompleteError{UserID: userID, Err: err}
+	}
+	return nil
+}
+
+func (c *Client) resetPassword(ctx context.Context, userID, realmName, newPass string, temporary bool) error {
 	passReset := &v1alpha1.KeycloakAPIPasswordReset{}
 	passReset.Type = "password"
-	passReset.Temporary = false
+	passReset.Temporary = temporary
 	passReset.Value = newPass
-	u := fmt.Sprintf("realms/%s/users/%s/reset-password", realmName, user.ID)
-	if err := c.update(passReset, u, "paswordreset"); err != nil {
-		return errors.Wrap(err, "error calling keycloak api ")
+	u := fmt.Sprintf("realms/%s/users/%s/reset-password", realmName, userID)
+	if err := c.update(ctx, passReset, u, "paswordreset"); err != nil {
+		return fmt.Errorf("error calling keycloak api: %w", err)
 	}
 	return nil
 }
 
-func (c *Client) FindUserByEmail(email, realm string) (*v1alpha1.KeycloakAPIUser, error) {
-	result, err := c.get(fmt.Sprintf("realms/%s/users?first=0&max=1&search=%s", realm, email), "user", func(body []byte) (T, error) {
+func (c *Client) FindUserByEmail(ctx context.Context, email, realm string) (*v1alpha1.KeycloakAPIUser, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/users?first=0&max=1&search=%s", realm, email), "user", func(body []byte) (T, error) {
 		var users []*v1alpha1.KeycloakAPIUser
 		if err := json.Unmarshal(body, &users); err != nil {
 			return nil, err
@@ -180,8 +892,8 @@ func (c *Client) FindUserByEmail(email, realm string) (*v1alpha1.KeycloakAPIUser
 	return result.(*v1alpha1.KeycloakAPIUser), nil
 }
 
-func (c *Client) FindUserByUsername(name, realm string) (*v1alpha1.KeycloakAPIUser, error) {
-	result, err := c.get(fmt.Sprintf("realms/%s/users?username=%s&max=-1", realm, name), "user", func(body []byte) (T, error) {
+func (c *Client) FindUserByUsername(ctx context.Context, name, realm string) (*v1alpha1.KeycloakAPIUser, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/users?username=%s&max=-1", realm, name), "user", func(body []byte) (T, error) {
 		var users []*v1alpha1.KeycloakAPIUser
 		if err := json.Unmarshal(body, &users); err != nil {
 			return nil, err
@@ -203,15 +915,61 @@ func (c *Client) FindUserByUsername(name, realm string) (*v1alpha1.KeycloakAPIUs
 	return result.(*v1alpha1.KeycloakAPIUser), nil
 }
 
-func (c *Client) CreateIdentityProvider(identityProvider *v1alpha1.KeycloakIdentityProvider, realmName string) (string, error) {
-	return c.create(identityProvider, fmt.Sprintf("realms/%s/identity-provider/instances", realmName), "identity provider")
-}
-
-// Generic get function for returning a Keycloak resource
-func (c *Client) get(resourcePath, resourceName string, unMarshalFunc func(body []byte) (T, error)) (T, error) {
-	u := fmt.Sprintf("%s/auth/admin/%s", c.URL, resourcePath)
-	req, err := http.NewRequest(
-		"GET",
+// GetUserByFederatedIdentity finds the user linked to the given identity
+// provider account, ErrNotFound is returned if no user is linked to it. The lookup
+// relies on the idpAlias and idpUserId search parameters of the users endpoint,
+// which are only honoured by Keycloak 22 and later. Older servers ignore them
+// and return the first users of the realm instead; at most 10 of those are
+// checked, with one request each for their federated identities, so on those
+// servers a linked user outside the first 10 is not found.
+func (c *Client) GetUserByFederatedIdentity(ctx context.Context, realmName, providerAlias, externalUserID string) (*v1alpha1.KeycloakAPIUser, error) {
+	query := url.Values{}
+	query.Set("idpAlias", providerAlias)
+	query.Set("idpUserId", externalUserID)
+	query.Set("max", strconv.Itoa(maxFederatedIdentityCandidates))
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/users?%s", realmName, query.Encode()), "user", func(body []byte) (T, error) {
+		var users []*v1alpha1.KeycloakAPIUser
+		err := json.Unmarshal(body, &users)
+		return users, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+
+	for _, user := range result.([]*v1alpha1.KeycloakAPIUser) {
+		fids, err := c.GetUserFederatedIdentities(ctx, user.ID, realmName)
+		if stderrors.Is(err, ErrNotFound) {
+			// the user was deleted after the search
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, fid := range fids {
+			if fid.IdentityProvider == providerAlias && fid.UserID == externalUserID {
+				return user, nil
+			}
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// CreateIdentityProvider registers a new identity provider in the realm,
+// ErrAlreadyExists is returned if the alias is already in use
+func (c *Client) CreateIdentityProvider(ctx context.Context, identityProvider *IdentityProvider, realmName string) error {
+	_, err := c.create(ctx, identityProvider, fmt.Sprintf("realms/%s/identity-provider/instances", realmName), "identity provider")
+	return err
+}
+
+// Generic get function for returning a Keycloak resource
+func (c *Client) get(ctx context.Context, resourcePath, resourceName string, unMarshalFunc func(body []byte) (T, error)) (T, error) {
+	u := fmt.Sprintf("%s/auth/admin/%s", c.URL, resourcePath)
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
 		u,
 		nil,
 	)
@@ -220,8 +978,10 @@ func (c *Client) get(resourcePath, resourceName string, unMarshalFunc func(body
 		return nil, errors.Wrapf(err, "error creating GET %s request", resourceName)
 	}
 
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
-	res, err := c.requester.Do(req)
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+	res, err := c.do(req)
 	if err != nil {
 		logrus.Errorf("error on request %+v", err)
 		return nil, errors.Wrapf(err, "error performing GET %s request", resourceName)
@@ -234,7 +994,7 @@ func (c *Client) get(resourcePath, resourceName string, unMarshalFunc func(body
 	}
 
 	if res.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to GET %s: (%d) %s", resourceName, res.StatusCode, res.Status)
+		return nil, newAPIError(res, "failed to GET %s", resourceName)
 	}
 
 	body, err := ioutil.ReadAll(res.Body)
@@ -251,396 +1011,2284 @@ func (c *Client) get(resourcePath, resourceName string, unMarshalFunc func(body
 	return obj, nil
 }
 
-func (c *Client) GetRealm(realmName string) (*v1alpha1.KeycloakRealm, error) {
-	result, err := c.get(fmt.Sprintf("realms/%s", realmName), "realm", func(body []byte) (T, error) {
+// Generic get function for the public Keycloak endpoints of a realm, e.g. the
+// OpenID Connect discovery document, the requests aren't authenticated
+func (c *Client) getPublic(ctx context.Context, resourcePath, resourceName string, unMarshalFunc func(body []byte) (T, error)) (T, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/auth/%s", c.URL, resourcePath),
+		nil,
+	)
+	if err != nil {
+		logrus.Errorf("error creating GET %s request %+v", resourceName, err)
+		return nil, errors.Wrapf(err, "error creating GET %s request", resourceName)
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		logrus.Errorf("error on request %+v", err)
+		return nil, errors.Wrapf(err, "error performing GET %s request", resourceName)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, newAPIError(res, "failed to GET %s", resourceName)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		logrus.Errorf("error reading response %+v", err)
+		return nil, errors.Wrapf(err, "error reading %s GET response", resourceName)
+	}
+	return unMarshalFunc(body)
+}
+
+// GetRealm returns the realm with the given name, or ErrNotFound if it does
+// not exist
+func (c *Client) GetRealm(ctx context.Context, realmName string) (*v1alpha1.KeycloakRealm, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s", realmName), "realm", func(body []byte) (T, error) {
 		realm := &v1alpha1.KeycloakAPIRealm{}
 		err := json.Unmarshal(body, realm)
 		return realm, err
 	})
+	if err != nil {
+		return nil, err
+	}
 	if result == nil {
-		return nil, nil
+		return nil, ErrNotFound
 	}
 	ret := &v1alpha1.KeycloakRealm{
 		Spec: v1alpha1.KeycloakRealmSpec{
 			Realm: result.(*v1alpha1.KeycloakAPIRealm),
 		},
 	}
-	return ret, err
+	return ret, nil
 }
 
-func (c *Client) GetClient(clientID, realmName string) (*v1alpha1.KeycloakAPIClient, error) {
-	result, err := c.get(fmt.Sprintf("realms/%s/clients/%s", realmName, clientID), "client", func(body []byte) (T, error) {
-		client := &v1alpha1.KeycloakAPIClient{}
-		err := json.Unmarshal(body, client)
-		return client, err
+// getRealmAttributes returns the attributes of the realm, they're read from
+// the raw representation since v1alpha1.KeycloakAPIRealm doesn't have them
+func (c *Client) getRealmAttributes(ctx context.Context, realmName string) (map[string]string, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s", realmName), "realm", func(body []byte) (T, error) {
+		realm := &struct {
+			Attributes map[string]string `json:"attributes"`
+		}{}
+		err := json.Unmarshal(body, realm)
+		return realm.Attributes, err
 	})
 	if err != nil {
 		return nil, err
 	}
 	if result == nil {
-		return nil, nil
+		return nil, ErrNotFound
 	}
-	ret := result.(*v1alpha1.KeycloakAPIClient)
-	return ret, err
+	attributes := result.(map[string]string)
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+	return attributes, nil
 }
 
-func (c *Client) GetClientSecret(clientID, realmName string) (string, error) {
-	//"https://{{ rhsso_route }}/auth/admin/realms/{{ rhsso_realm }}/clients/{{ rhsso_client_id }}/client-secret"
-	result, err := c.get(fmt.Sprintf("realms/%s/clients/%s/client-secret", realmName, clientID), "client-secret", func(body []byte) (T, error) {
-		res := map[string]string{}
-		if err := json.Unmarshal(body, &res); err != nil {
-			return nil, err
-		}
-		return res["value"], nil
-	})
+// GetRealmAttribute returns the value of the realm attribute key and whether
+// the realm has it, ErrNotFound is returned if the realm doesn't exist
+func (c *Client) GetRealmAttribute(ctx context.Context, realmName, key string) (string, bool, error) {
+	attributes, err := c.getRealmAttributes(ctx, realmName)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to get: "+fmt.Sprintf("realms/%s/clients/%s/client-secret", realmName, clientID))
-	}
-	if result == nil {
-		return "", nil
+		return "", false, err
 	}
-	return result.(string), nil
+	value, ok := attributes[key]
+	return value, ok, nil
 }
 
-func (c *Client) GetClientInstall(clientID, realmName string) ([]byte, error) {
-	var response []byte
-	if _, err := c.get(fmt.Sprintf("realms/%s/clients/%s/installation/providers/keycloak-oidc-keycloak-json", realmName, clientID), "client-installation", func(body []byte) (T, error) {
-		response = body
-		return body, nil
-	}); err != nil {
+// GetWellKnownConfiguration returns the OpenID Connect discovery document of
+// the realm, ErrNotFound is returned if the realm doesn't exist
+func (c *Client) GetWellKnownConfiguration(ctx context.Context, realmName string) (*OIDCConfiguration, error) {
+	result, err := c.getPublic(ctx, fmt.Sprintf("realms/%s/.well-known/openid-configuration", realmName), "OpenID configuration", func(body []byte) (T, error) {
+		config := &OIDCConfiguration{}
+		err := json.Unmarshal(body, config)
+		return config, err
+	})
+	if err != nil {
 		return nil, err
 	}
-	return response, nil
+	return result.(*OIDCConfiguration), nil
 }
 
-func (c *Client) GetUser(userID, realmName string) (*v1alpha1.KeycloakAPIUser, error) {
-	result, err := c.get(fmt.Sprintf("realms/%s/users/%s", realmName, userID), "user", func(body []byte) (T, error) {
-		user := &v1alpha1.KeycloakAPIUser{}
-		err := json.Unmarshal(body, user)
-		return user, err
+// GetJWKS returns the public keys of the realm, allowing the signatures of
+// its tokens to be validated locally
+func (c *Client) GetJWKS(ctx context.Context, realmName string) (*JWKS, error) {
+	result, err := c.getPublic(ctx, fmt.Sprintf("realms/%s/protocol/openid-connect/certs", realmName), "JWKS", func(body []byte) (T, error) {
+		jwks := &JWKS{}
+		err := json.Unmarshal(body, jwks)
+		return jwks, err
 	})
 	if err != nil {
 		return nil, err
 	}
-	if result == nil {
-		return nil, nil
-	}
-	ret := result.(*v1alpha1.KeycloakAPIUser)
-	return ret, err
+	return result.(*JWKS), nil
 }
 
-func (c *Client) GetIdentityProvider(alias string, realmName string) (*v1alpha1.KeycloakIdentityProvider, error) {
-	result, err := c.get(fmt.Sprintf("realms/%s/identity-provider/instances/%s", realmName, alias), "identity provider", func(body []byte) (T, error) {
-		provider := &v1alpha1.KeycloakIdentityProvider{}
-		err := json.Unmarshal(body, provider)
-		return provider, err
-	})
+// IntrospectToken asks the realm whether token is active, authenticating as the
+// confidential client clientID. An inactive token isn't an error, Active is
+// false in the result instead
+func (c *Client) IntrospectToken(ctx context.Context, realmName, clientID, clientSecret, token string) (*TokenIntrospection, error) {
+	form := url.Values{}
+	form.Add("token", token)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/auth/realms/%s/protocol/openid-connect/token/introspect", c.URL, realmName),
+		strings.NewReader(form.Encode()),
+	)
 	if err != nil {
-		return nil, err
+		logrus.Errorf("error creating POST token introspection request %+v", err)
+		return nil, errors.Wrap(err, "error creating POST token introspection request")
 	}
-	if result == nil {
-		return nil, nil
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(clientID, clientSecret)
+	res, err := c.do(req)
+	if err != nil {
+		logrus.Errorf("error on request %+v", err)
+		return nil, errors.Wrap(err, "error performing POST token introspection request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, newAPIError(res, "failed to introspect token")
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		logrus.Errorf("error reading response %+v", err)
+		return nil, errors.Wrap(err, "error reading token introspection response")
 	}
-	return result.(*v1alpha1.KeycloakIdentityProvider), err
+	introspection := &TokenIntrospection{}
+	if err := json.Unmarshal(body, introspection); err != nil {
+		return nil, errors.Wrap(err, "error parsing token introspection response")
+	}
+	return introspection, nil
 }
 
-func (c *Client) GetAuthenticatorConfig(configID, realmName string) (*v1alpha1.AuthenticatorConfig, error) {
-	result, err := c.get(fmt.Sprintf("realms/%s/authentication/config/%s", realmName, configID), "AuthenticatorConfig", func(body []byte) (T, error) {
-		authenticatorConfig := &v1alpha1.AuthenticatorConfig{}
-		err := json.Unmarshal(body, authenticatorConfig)
-		return authenticatorConfig, err
-	})
+const (
+	grantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenTypeAccessToken   = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+// ExchangeToken exchanges subjectToken for a token of the client clientID
+// issued for audience, which is omitted when empty. The client must be allowed
+// to exchange tokens by the token-exchange permission of the realm
+func (c *Client) ExchangeToken(ctx context.Context, realmName, subjectToken, audience, clientID, clientSecret string) (*TokenResponse, error) {
+	form := url.Values{}
+	form.Add("grant_type", grantTypeTokenExchange)
+	form.Add("subject_token", subjectToken)
+	form.Add("subject_token_type", tokenTypeAccessToken)
+	form.Add("requested_token_type", tokenTypeAccessToken)
+	if audience != "" {
+		form.Add("audience", audience)
+	}
+	return c.requestRealmToken(ctx, realmName, clientID, clientSecret, form, "token exchange")
+}
+
+// RefreshToken exchanges refreshToken for new tokens of the client clientID,
+// the refresh token of the client is used when it's empty. The new access and
+// refresh tokens replace the ones of the client so subsequent requests use
+// them without logging in again
+func (c *Client) RefreshToken(ctx context.Context, realmName, clientID, clientSecret, refreshToken string) (*TokenResponse, error) {
+	if refreshToken == "" {
+		c.tokenMu.Lock()
+		refreshToken = c.refreshToken
+		c.tokenMu.Unlock()
+	}
+	if refreshToken == "" {
+		return nil, errors.New("refresh token must be set")
+	}
+
+	form := url.Values{}
+	form.Add("grant_type", "refresh_token")
+	form.Add("refresh_token", refreshToken)
+	tokenRes, err := c.requestRealmToken(ctx, realmName, clientID, clientSecret, form, "token refresh")
 	if err != nil {
 		return nil, err
 	}
-	if result == nil {
-		return nil, nil
+
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.token = tokenRes.AccessToken
+	c.refreshToken = tokenRes.RefreshToken
+	c.tokenExpiry = time.Time{}
+	if tokenRes.ExpiresIn > 0 {
+		c.tokenExpiry = time.Now().Add(time.Duration(tokenRes.ExpiresIn) * time.Second)
 	}
-	return result.(*v1alpha1.AuthenticatorConfig), err
+	return tokenRes, nil
 }
 
-// Generic put function for updating Keycloak resources
-func (c *Client) update(obj T, resourcePath, resourceName string) error {
-	jsonValue, err := json.Marshal(obj)
-	if err != nil {
-		return nil
+// RevokeToken revokes token, an access or refresh token issued to the client
+// clientID. tokenTypeHint is either "access_token", "refresh_token" or empty
+// to let Keycloak detect the type. Revoking a token that is already invalid
+// isn't an error
+func (c *Client) RevokeToken(ctx context.Context, realmName, clientID, clientSecret, token, tokenTypeHint string) error {
+	if tokenTypeHint != "" && tokenTypeHint != "access_token" && tokenTypeHint != "refresh_token" {
+		return errors.Errorf("unsupported token type hint %q", tokenTypeHint)
 	}
 
-	req, err := http.NewRequest(
-		"PUT",
-		fmt.Sprintf("%s/auth/admin/%s", c.URL, resourcePath),
-		bytes.NewBuffer(jsonValue),
+	form := url.Values{}
+	form.Add("token", token)
+	if tokenTypeHint != "" {
+		form.Add("token_type_hint", tokenTypeHint)
+	}
+	form.Add("client_id", clientID)
+	if clientSecret != "" {
+		form.Add("client_secret", clientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/auth/realms/%s/protocol/openid-connect/revoke", c.URL, realmName),
+		strings.NewReader(form.Encode()),
 	)
 	if err != nil {
-		logrus.Errorf("error creating UPDATE %s request %+v", resourceName, err)
-		return errors.Wrapf(err, "error creating UPDATE %s request", resourceName)
+		logrus.Errorf("error creating POST token revocation request %+v", err)
+		return errors.Wrap(err, "error creating POST token revocation request")
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Add("Authorization", "Bearer "+c.token)
-	res, err := c.requester.Do(req)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	res, err := c.do(req)
 	if err != nil {
 		logrus.Errorf("error on request %+v", err)
-		return errors.Wrapf(err, "error performing UPDATE %s request", resourceName)
+		return errors.Wrap(err, "error performing POST token revocation request")
 	}
 	defer res.Body.Close()
-	if res.StatusCode < 200 || res.StatusCode > 299 {
-		logrus.Errorf("failed to UPDATE %s %v", resourceName, res.Status)
-		return fmt.Errorf("failed to UPDATE %s: (%d) %s", resourceName, res.StatusCode, res.Status)
-	}
 
+	if res.StatusCode != 200 {
+		return newAPIError(res, "failed to revoke token")
+	}
 	return nil
 }
 
-func (c *Client) UpdateRealm(realm *v1alpha1.KeycloakRealm) error {
-	return c.update(realm, fmt.Sprintf("realms/%s", realm.Spec.Realm.ID), "realm")
-}
+// requestRealmToken posts form to the token endpoint of the realm as the
+// client clientID, the secret is omitted for public clients
+func (c *Client) requestRealmToken(ctx context.Context, realmName, clientID, clientSecret string, form url.Values, requestName string) (*TokenResponse, error) {
+	form.Set("client_id", clientID)
+	if clientSecret != "" {
+		form.Set("client_secret", clientSecret)
+	}
 
-func (c *Client) UpdateClient(specClient *v1alpha1.KeycloakAPIClient, realmName string) error {
-	return c.update(specClient, fmt.Sprintf("realms/%s/clients/%s", realmName, specClient.ID), "client")
-}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/auth/realms/%s/protocol/openid-connect/token", c.URL, realmName),
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		logrus.Errorf("error creating POST %s request %+v", requestName, err)
+		return nil, errors.Wrapf(err, "error creating POST %s request", requestName)
+	}
 
-func (c *Client) UpdateUser(specUser *v1alpha1.KeycloakAPIUser, realmName string) error {
-	return c.update(specUser, fmt.Sprintf("realms/%s/users/%s", realmName, specUser.ID), "user")
-}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	res, err := c.do(req)
+	if err != nil {
+		logrus.Errorf("error on request %+v", err)
+		return nil, errors.Wrapf(err, "error performing POST %s request", requestName)
+	}
+	defer res.Body.Close()
 
-func (c *Client) UpdateIdentityProvider(specIdentityProvider *v1alpha1.KeycloakIdentityProvider, realmName string) error {
-	return c.update(specIdentityProvider, fmt.Sprintf("realms/%s/identity-provider/instances/%s", realmName, specIdentityProvider.Alias), "identity provider")
-}
+	if res.StatusCode != 200 {
+		return nil, newAPIError(res, "failed to POST %s", requestName)
+	}
 
-func (c *Client) UpdateAuthenticatorConfig(authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string) error {
-	return c.update(authenticatorConfig, fmt.Sprintf("realms/%s/authentication/config/%s", realmName, authenticatorConfig.ID), "AuthenticatorConfig")
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		logrus.Errorf("error reading response %+v", err)
+		return nil, errors.Wrapf(err, "error reading %s response", requestName)
+	}
+	tokenRes := &TokenResponse{}
+	if err := json.Unmarshal(body, tokenRes); err != nil {
+		return nil, errors.Wrapf(err, "error parsing %s response", requestName)
+	}
+	return tokenRes, nil
 }
 
-// Generic delete function for deleting Keycloak resources
-func (c *Client) delete(resourcePath, resourceName string, obj T) error {
-	req, err := http.NewRequest(
-		"DELETE",
-		fmt.Sprintf("%s/auth/admin/%s", c.URL, resourcePath),
-		nil,
-	)
-
-	if obj != nil {
-		jsonValue, err := json.Marshal(obj)
-		if err != nil {
-			return nil
-		}
-		req, err = http.NewRequest(
-			"DELETE",
-			fmt.Sprintf("%s/auth/admin/%s", c.URL, resourcePath),
-			bytes.NewBuffer(jsonValue),
-		)
-		if err != nil {
-			return nil
-		}
-		req.Header.Set("Content-Type", "application/json")
+// ListRealmKeys returns the keys of the realm and the kid of the active key
+// of each algorithm
+func (c *Client) ListRealmKeys(ctx context.Context, realmName string) (*RealmKeyMetadata, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/keys", realmName), "realm keys", func(body []byte) (T, error) {
+		keys := &RealmKeyMetadata{}
+		err := json.Unmarshal(body, keys)
+		return keys, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
 	}
+	return result.(*RealmKeyMetadata), nil
+}
 
+// GetClient returns the client with the given ID, or ErrNotFound if the realm
+// has no such client
+func (c *Client) GetClient(ctx context.Context, clientID, realmName string) (*v1alpha1.KeycloakAPIClient, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/clients/%s", realmName, clientID), "client", func(body []byte) (T, error) {
+		client := &v1alpha1.KeycloakAPIClient{}
+		err := json.Unmarshal(body, client)
+		return client, err
+	})
 	if err != nil {
-		logrus.Errorf("error creating DELETE %s request %+v", resourceName, err)
-		return errors.Wrapf(err, "error creating DELETE %s request", resourceName)
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
 	}
+	ret := result.(*v1alpha1.KeycloakAPIClient)
+	return ret, err
+}
 
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
-	res, err := c.requester.Do(req)
+// GetClientSecret returns the secret of the client, ErrNoSecret is returned
+// for clients without a secret such as public clients and ErrNotFound if the
+// realm has no such client
+func (c *Client) GetClientSecret(ctx context.Context, clientID, realmName string) (string, error) {
+	//"https://{{ rhsso_route }}/auth/admin/realms/{{ rhsso_realm }}/clients/{{ rhsso_client_id }}/client-secret"
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/clients/%s/client-secret", realmName, clientID), "client-secret", unmarshalClientSecret)
 	if err != nil {
-		logrus.Errorf("error on request %+v", err)
-		return errors.Wrapf(err, "error performing DELETE %s request", resourceName)
+		return "", fmt.Errorf("failed to get: realms/%s/clients/%s/client-secret: %w", realmName, clientID, err)
 	}
-	defer res.Body.Close()
-	if res.StatusCode == 404 {
-		logrus.Errorf("Resource %v/%v already deleted", resourcePath, resourceName)
+	if result == nil {
+		return "", ErrNotFound
 	}
-	if res.StatusCode != 204 && res.StatusCode != 404 {
-		return fmt.Errorf("failed to DELETE %s: (%d) %s", resourceName, res.StatusCode, res.Status)
+	if result.(string) == "" {
+		return "", ErrNoSecret
 	}
-
-	return nil
+	return result.(string), nil
 }
 
-func (c *Client) DeleteRealm(realmName string) error {
-	err := c.delete(fmt.Sprintf("realms/%s", realmName), "realm", nil)
-	return err
+// GetClientRoleByName returns the role of the client with the given name,
+// ErrNotFound is returned if the client has no such role
+func (c *Client) GetClientRoleByName(ctx context.Context, clientID, roleName, realmName string) (*v1alpha1.KeycloakUserRole, error) {
+	result, err := c.get(ctx, clientRolePath(clientID, roleName, realmName), "client role", func(body []byte) (T, error) {
+		role := &v1alpha1.KeycloakUserRole{}
+		err := json.Unmarshal(body, role)
+		return role, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*v1alpha1.KeycloakUserRole), nil
 }
 
-func (c *Client) DeleteClient(clientID, realmName string) error {
-	err := c.delete(fmt.Sprintf("realms/%s/clients/%s", realmName, clientID), "client", nil)
-	return err
+// clientRolePath returns the resource path of the role of the client, role
+// names may contain spaces and slashes so the name is escaped
+func clientRolePath(clientID, roleName, realmName string) string {
+	return fmt.Sprintf("realms/%s/clients/%s/roles/%s", realmName, clientID, url.PathEscape(roleName))
 }
 
-func (c *Client) DeleteUser(userID, realmName string) error {
-	err := c.delete(fmt.Sprintf("realms/%s/users/%s", realmName, userID), "user", nil)
-	return err
+// GetAuthzResource returns the protected resource of the resource server of
+// the client, ErrNotFound is returned if it doesn't exist
+func (c *Client) GetAuthzResource(ctx context.Context, clientID, resourceID, realmName string) (*AuthzResource, error) {
+	result, err := c.get(ctx, authzResourcesPath(clientID, realmName)+"/"+resourceID, "authz resource", unmarshalAuthzResource)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*AuthzResource), nil
 }
 
-func (c *Client) DeleteIdentityProvider(alias string, realmName string) error {
-	err := c.delete(fmt.Sprintf("realms/%s/identity-provider/instances/%s", realmName, alias), "identity provider", nil)
-	return err
+func unmarshalAuthzResource(body []byte) (T, error) {
+	resource := &AuthzResource{}
+	err := json.Unmarshal(body, resource)
+	return resource, err
 }
 
-func (c *Client) DeleteAuthenticatorConfig(configID, realmName string) error {
-	err := c.delete(fmt.Sprintf("realms/%s/authentication/config/%s", realmName, configID), "AuthenticatorConfig", nil)
-	return err
+func authzResourcesPath(clientID, realmName string) string {
+	return fmt.Sprintf("realms/%s/clients/%s/authz/resource-server/resource", realmName, clientID)
 }
 
-// Generic list function for listing Keycloak resources
-func (c *Client) list(resourcePath, resourceName string, unMarshalListFunc func(body []byte) (T, error)) (T, error) {
-	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf("%s/auth/admin/%s", c.URL, resourcePath),
-		nil,
-	)
+// GetAuthzPolicy returns the policy of the resource server of the client,
+// ErrNotFound is returned if it doesn't exist
+func (c *Client) GetAuthzPolicy(ctx context.Context, clientID, policyID, realmName string) (*AuthzPolicy, error) {
+	result, err := c.get(ctx, authzPoliciesPath(clientID, realmName)+"/"+policyID, "authz policy", unmarshalAuthzPolicy)
 	if err != nil {
-		logrus.Errorf("error creating LIST %s request %+v", resourceName, err)
-		return nil, errors.Wrapf(err, "error creating LIST %s request", resourceName)
+		return nil, err
 	}
-
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
-	res, err := c.requester.Do(req)
-	if err != nil {
-		logrus.Errorf("error on request %+v", err)
-		return nil, errors.Wrapf(err, "error performing LIST %s request", resourceName)
+	if result == nil {
+		return nil, ErrNotFound
 	}
-	defer res.Body.Close()
+	return result.(*AuthzPolicy), nil
+}
 
-	if res.StatusCode < 200 || res.StatusCode > 299 {
-		return nil, fmt.Errorf("failed to LIST %s: (%d) %s", resourceName, res.StatusCode, res.Status)
+func unmarshalAuthzPolicy(body []byte) (T, error) {
+	policy := &AuthzPolicy{}
+	if err := json.Unmarshal(body, policy); err != nil {
+		return nil, err
 	}
+	policy.Raw = json.RawMessage(body)
+	return policy, nil
+}
 
-	body, err := ioutil.ReadAll(res.Body)
+func authzPoliciesPath(clientID, realmName string) string {
+	return fmt.Sprintf("realms/%s/clients/%s/authz/resource-server/policy", realmName, clientID)
+}
+
+func authzPermissionsPath(clientID, realmName string) string {
+	return fmt.Sprintf("realms/%s/clients/%s/authz/resource-server/permission", realmName, clientID)
+}
+
+func authzScopesPath(clientID, realmName string) string {
+	return fmt.Sprintf("realms/%s/clients/%s/authz/resource-server/scope", realmName, clientID)
+}
+
+// GetClientServiceAccountUser returns the user of the service account of the
+// client, ErrServiceAccountsDisabled is returned when the client doesn't have
+// service accounts enabled
+func (c *Client) GetClientServiceAccountUser(ctx context.Context, clientID, realmName string) (*v1alpha1.KeycloakAPIUser, error) {
+	// Depending on the version Keycloak answers with a 404 or an error body
+	// for clients without service accounts, check the client instead
+	client, err := c.GetClient(ctx, clientID, realmName)
 	if err != nil {
-		logrus.Errorf("error reading response %+v", err)
-		return nil, errors.Wrapf(err, "error reading %s LIST response", resourceName)
+		return nil, err
+	}
+	if !client.ServiceAccountsEnabled {
+		return nil, ErrServiceAccountsDisabled
+	}
+
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/clients/%s/service-account-user", realmName, clientID), "service account user", func(body []byte) (T, error) {
+		user := &v1alpha1.KeycloakAPIUser{}
+		err := json.Unmarshal(body, user)
+		return user, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*v1alpha1.KeycloakAPIUser), nil
+}
+
+// GetClientInstallationProvider returns the adapter configuration of the
+// client generated by the installation provider, e.g.
+// "keycloak-oidc-keycloak-json". The data isn't decoded since the format
+// depends on the provider, ErrNotFound is returned if the client or the
+// provider doesn't exist
+func (c *Client) GetClientInstallationProvider(ctx context.Context, clientID, realmName, providerID string) (*ClientInstallation, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/auth/admin/realms/%s/clients/%s/installation/providers/%s", c.URL, realmName, clientID, providerID),
+		nil,
+	)
+	if err != nil {
+		logrus.Errorf("error creating GET client installation request %+v", err)
+		return nil, errors.Wrap(err, "error creating GET client installation request")
+	}
+
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+	res, err := c.do(req)
+	if err != nil {
+		logrus.Errorf("error on request %+v", err)
+		return nil, errors.Wrap(err, "error performing GET client installation request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, newAPIError(res, "failed to GET client installation")
+	}
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		logrus.Errorf("error reading response %+v", err)
+		return nil, errors.Wrap(err, "error reading client installation GET response")
+	}
+	return &ClientInstallation{
+		ContentType: res.Header.Get("Content-Type"),
+		Data:        data,
+	}, nil
+}
+
+// RegenerateClientSecret replaces the secret of the client and returns the
+// new one, ErrNoSecret is returned for clients without a secret
+func (c *Client) RegenerateClientSecret(ctx context.Context, clientID, realmName string) (string, error) {
+	result, err := c.post(ctx, nil, fmt.Sprintf("realms/%s/clients/%s/client-secret", realmName, clientID), "client-secret", unmarshalClientSecret)
+	if err != nil {
+		return "", err
+	}
+	if result.(string) == "" {
+		return "", ErrNoSecret
+	}
+	return result.(string), nil
+}
+
+// GetRotatedClientSecret returns the previous secret of the client, which
+// stays valid for the grace period of the secret rotation policy.
+// ErrNotFound is returned if the client has no rotated secret
+func (c *Client) GetRotatedClientSecret(ctx context.Context, clientID, realmName string) (string, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/clients/%s/client-secret/rotated", realmName, clientID), "rotated client-secret", unmarshalClientSecret)
+	if err != nil {
+		return "", err
+	}
+	if result == nil || result.(string) == "" {
+		return "", ErrNotFound
+	}
+	return result.(string), nil
+}
+
+// InvalidateRotatedClientSecret invalidates the previous secret of the client
+// before the grace period of the secret rotation policy ends. ErrNotFound is
+// returned if the client has no rotated secret
+func (c *Client) InvalidateRotatedClientSecret(ctx context.Context, clientID, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/clients/%s/client-secret/rotated", realmName, clientID), "rotated client-secret", nil)
+}
+
+// RegenerateClientRegistrationToken issues a new registration access token for
+// the client, invalidating the previous one, and returns it
+func (c *Client) RegenerateClientRegistrationToken(ctx context.Context, clientID, realmName string) (string, error) {
+	result, err := c.post(ctx, nil, fmt.Sprintf("realms/%s/clients/%s/registration-access-token", realmName, clientID), "client registration access token", func(body []byte) (T, error) {
+		client := &struct {
+			RegistrationAccessToken string `json:"registrationAccessToken"`
+		}{}
+		if err := json.Unmarshal(body, client); err != nil {
+			return nil, err
+		}
+		return client.RegistrationAccessToken, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return result.(string), nil
+}
+
+// RegisterClientNode registers the cluster node nodeHost of the client, the
+// admin URL of the client is then invoked on each registered node
+func (c *Client) RegisterClientNode(ctx context.Context, clientID, realmName, nodeHost string) error {
+	if nodeHost == "" {
+		return errors.New("node host must be set")
+	}
+	node := map[string]string{"host": nodeHost}
+	_, err := c.create(ctx, node, fmt.Sprintf("realms/%s/clients/%s/nodes", realmName, clientID), "client node")
+	return err
+}
+
+// UnregisterClientNode removes the cluster node nodeHost from the client
+func (c *Client) UnregisterClientNode(ctx context.Context, clientID, realmName, nodeHost string) error {
+	if nodeHost == "" {
+		return errors.New("node host must be set")
+	}
+	return c.delete(ctx, fmt.Sprintf("realms/%s/clients/%s/nodes/%s", realmName, clientID, url.PathEscape(nodeHost)), "client node", nil)
+}
+
+// TestClientNodesAvailable checks that the registered cluster nodes of the
+// client are reachable, the unreachable ones are listed in the FailedRequests
+// of the result
+func (c *Client) TestClientNodesAvailable(ctx context.Context, clientID, realmName string) (*GlobalRequestResult, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/clients/%s/test-nodes-available", realmName, clientID), "client nodes availability", func(body []byte) (T, error) {
+		res := &GlobalRequestResult{}
+		err := json.Unmarshal(body, res)
+		return res, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*GlobalRequestResult), nil
+}
+
+// PushClientRevocation pushes the not-before policy of the client to its
+// registered nodes. The nodes that couldn't be reached are listed in the
+// FailedRequests of the result rather than returned as an error
+func (c *Client) PushClientRevocation(ctx context.Context, clientID, realmName string) (*GlobalRequestResult, error) {
+	result, err := c.post(ctx, nil, fmt.Sprintf("realms/%s/clients/%s/push-revocation", realmName, clientID), "client push revocation", func(body []byte) (T, error) {
+		res := &GlobalRequestResult{}
+		err := json.Unmarshal(body, res)
+		return res, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GlobalRequestResult), nil
+}
+
+func clientCertificatePath(clientID, realmName, attr string) string {
+	return fmt.Sprintf("realms/%s/clients/%s/certificates/%s", realmName, clientID, attr)
+}
+
+func unmarshalCertificate(body []byte) (T, error) {
+	certificate := &Certificate{}
+	err := json.Unmarshal(body, certificate)
+	return certificate, err
+}
+
+// GetClientCertificate returns the key pair of the client stored under the
+// attribute prefix attr, e.g. "jwt.credential" or "saml.signing". The private
+// key is never returned
+func (c *Client) GetClientCertificate(ctx context.Context, clientID, realmName, attr string) (*Certificate, error) {
+	result, err := c.get(ctx, clientCertificatePath(clientID, realmName, attr), "client certificate", unmarshalCertificate)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*Certificate), nil
+}
+
+// GenerateClientCertificate replaces the key pair of the client stored under
+// the attribute prefix attr with a new one and returns it, including the
+// private key
+func (c *Client) GenerateClientCertificate(ctx context.Context, clientID, realmName, attr string) (*Certificate, error) {
+	result, err := c.post(ctx, nil, clientCertificatePath(clientID, realmName, attr)+"/generate", "client certificate", unmarshalCertificate)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*Certificate), nil
+}
+
+// UploadClientCertificate replaces the key pair of the client stored under the
+// attribute prefix attr with the one in data. config.Format describes data,
+// the private key is imported as well for keystore formats, in which case the
+// key alias and passwords of config are required to open the keystore
+func (c *Client) UploadClientCertificate(ctx context.Context, clientID, realmName, attr string, config *KeyStoreConfig, data []byte) (*Certificate, error) {
+	if config == nil || config.Format == "" {
+		return nil, errors.New("keystore format must be set")
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fields := []struct{ name, value string }{
+		{"keystoreFormat", config.Format},
+		{"keyAlias", config.KeyAlias},
+		{"keyPassword", config.KeyPassword},
+		{"storePassword", config.StorePassword},
+	}
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+		if err := writer.WriteField(field.name, field.value); err != nil {
+			return nil, errors.Wrap(err, "error writing client certificate upload form")
+		}
+	}
+	part, err := writer.CreateFormFile("file", "certificate")
+	if err != nil {
+		return nil, errors.Wrap(err, "error writing client certificate upload form")
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, errors.Wrap(err, "error writing client certificate upload form")
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "error writing client certificate upload form")
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf("%s/auth/admin/%s/upload", c.URL, clientCertificatePath(clientID, realmName, attr)),
+		body,
+	)
+	if err != nil {
+		logrus.Errorf("error creating POST client certificate upload request %+v", err)
+		return nil, errors.Wrap(err, "error creating POST client certificate upload request")
+	}
+
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+	res, err := c.do(req)
+	if err != nil {
+		logrus.Errorf("error on request %+v", err)
+		return nil, errors.Wrap(err, "error performing POST client certificate upload request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, newAPIError(res, "failed to upload client certificate")
+	}
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		logrus.Errorf("error reading response %+v", err)
+		return nil, errors.Wrap(err, "error reading client certificate upload response")
+	}
+	result, err := unmarshalCertificate(resBody)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*Certificate), nil
+}
+
+// DownloadClientKeystore returns a keystore in config.Format containing the
+// key pair of the client stored under the attribute prefix attr
+func (c *Client) DownloadClientKeystore(ctx context.Context, clientID, realmName, attr string, config *KeyStoreConfig) ([]byte, error) {
+	if config == nil || config.Format == "" {
+		return nil, errors.New("keystore format must be set")
+	}
+	result, err := c.post(ctx, config, clientCertificatePath(clientID, realmName, attr)+"/download", "client keystore", func(body []byte) (T, error) {
+		return body, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]byte), nil
+}
+
+// TriggerLDAPSync synchronizes the users of the LDAP user federation provider
+// with the given component ID and returns the counts of synchronized users
+func (c *Client) TriggerLDAPSync(ctx context.Context, componentID, realmName string, syncType LDAPSyncType) (*LDAPSyncResult, error) {
+	query := url.Values{}
+	query.Set("action", string(syncType))
+	result, err := c.post(ctx, nil, fmt.Sprintf("realms/%s/user-storage/%s/sync?%s", realmName, componentID, query.Encode()), "LDAP sync", func(body []byte) (T, error) {
+		res := &LDAPSyncResult{}
+		err := json.Unmarshal(body, res)
+		return res, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*LDAPSyncResult), nil
+}
+
+// EvaluateAuthzPermissions evaluates the permissions of the resource server of
+// the client for the user, roles and context of the request
+func (c *Client) EvaluateAuthzPermissions(ctx context.Context, clientID, realmName string, evaluation *PolicyEvaluationRequest) (*PolicyEvaluationResponse, error) {
+	result, err := c.post(ctx, evaluation, authzPoliciesPath(clientID, realmName)+"/evaluate", "authz policy evaluation", func(body []byte) (T, error) {
+		res := &PolicyEvaluationResponse{}
+		err := json.Unmarshal(body, res)
+		return res, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PolicyEvaluationResponse), nil
+}
+
+// unmarshalClientSecret reads the value of the secret credential, it's empty
+// if the client has no secret
+func unmarshalClientSecret(body []byte) (T, error) {
+	credential := &struct {
+		Value string `json:"value"`
+	}{}
+	if err := json.Unmarshal(body, credential); err != nil {
+		return nil, err
+	}
+	return credential.Value, nil
+}
+
+// GetClientInstall returns the keycloak.json adapter configuration of the
+// client, or ErrNotFound if the realm has no such client
+func (c *Client) GetClientInstall(ctx context.Context, clientID, realmName string) ([]byte, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/clients/%s/installation/providers/keycloak-oidc-keycloak-json", realmName, clientID), "client-installation", func(body []byte) (T, error) {
+		return body, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.([]byte), nil
+}
+
+// GetUser returns the user with the given ID, or ErrNotFound if the realm has
+// no such user
+func (c *Client) GetUser(ctx context.Context, userID, realmName string) (*v1alpha1.KeycloakAPIUser, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/users/%s", realmName, userID), "user", func(body []byte) (T, error) {
+		user := &v1alpha1.KeycloakAPIUser{}
+		err := json.Unmarshal(body, user)
+		return user, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	ret := result.(*v1alpha1.KeycloakAPIUser)
+	return ret, err
+}
+
+// GetIdentityProvider returns the identity provider with the given alias, or
+// ErrNotFound if the realm has no such provider
+func (c *Client) GetIdentityProvider(ctx context.Context, alias string, realmName string) (*IdentityProvider, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/identity-provider/instances/%s", realmName, alias), "identity provider", func(body []byte) (T, error) {
+		provider := &IdentityProvider{}
+		err := json.Unmarshal(body, provider)
+		return provider, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*IdentityProvider), err
+}
+
+// GetClientScope returns the client scope with the given ID, or ErrNotFound
+// if the realm has no such scope
+func (c *Client) GetClientScope(ctx context.Context, scopeID, realmName string) (*ClientScope, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/client-scopes/%s", realmName, scopeID), "client scope", func(body []byte) (T, error) {
+		scope := &ClientScope{}
+		err := json.Unmarshal(body, scope)
+		return scope, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*ClientScope), nil
+}
+
+// GetGroupManagementPermissions returns the fine-grained permissions of the
+// group, or ErrNotFound if the realm has no such group
+func (c *Client) GetGroupManagementPermissions(ctx context.Context, groupID, realmName string) (*ManagementPermissionReference, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/groups/%s/management/permissions", realmName, groupID), "group management permissions", func(body []byte) (T, error) {
+		permissions := &ManagementPermissionReference{}
+		err := json.Unmarshal(body, permissions)
+		return permissions, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*ManagementPermissionReference), nil
+}
+
+// GetClientManagementPermissions returns the fine-grained permissions of the
+// client, or ErrNotFound if the realm has no such client
+func (c *Client) GetClientManagementPermissions(ctx context.Context, clientID, realmName string) (*ManagementPermissionReference, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/clients/%s/management/permissions", realmName, clientID), "client management permissions", func(body []byte) (T, error) {
+		permissions := &ManagementPermissionReference{}
+		err := json.Unmarshal(body, permissions)
+		return permissions, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*ManagementPermissionReference), nil
+}
+
+// GetRealmEventsConfig returns the events configuration of the realm, or
+// ErrNotFound if the realm doesn't exist
+func (c *Client) GetRealmEventsConfig(ctx context.Context, realmName string) (*RealmEventsConfig, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/events/config", realmName), "realm events config", func(body []byte) (T, error) {
+		config := &RealmEventsConfig{}
+		err := json.Unmarshal(body, config)
+		return config, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*RealmEventsConfig), nil
+}
+
+// GetAuthenticatorConfig returns the authenticator config with the given ID,
+// or ErrNotFound if the realm has no such config
+func (c *Client) GetAuthenticatorConfig(ctx context.Context, configID, realmName string) (*v1alpha1.AuthenticatorConfig, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/authentication/config/%s", realmName, configID), "AuthenticatorConfig", func(body []byte) (T, error) {
+		authenticatorConfig := &v1alpha1.AuthenticatorConfig{}
+		err := json.Unmarshal(body, authenticatorConfig)
+		return authenticatorConfig, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*v1alpha1.AuthenticatorConfig), err
+}
+
+// Generic put function for updating Keycloak resources
+func (c *Client) update(ctx context.Context, obj T, resourcePath, resourceName string) error {
+	jsonValue, err := json.Marshal(obj)
+	if err != nil {
+		logrus.Errorf("error %+v marshalling object", err)
+		return errors.Wrapf(err, "error marshalling %s", resourceName)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		fmt.Sprintf("%s/auth/admin/%s", c.URL, resourcePath),
+		bytes.NewBuffer(jsonValue),
+	)
+	if err != nil {
+		logrus.Errorf("error creating UPDATE %s request %+v", resourceName, err)
+		return errors.Wrapf(err, "error creating UPDATE %s request", resourceName)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if err := c.authorize(ctx, req); err != nil {
+		return err
+	}
+	res, err := c.do(req)
+	if err != nil {
+		logrus.Errorf("error on request %+v", err)
+		return errors.Wrapf(err, "error performing UPDATE %s request", resourceName)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		logrus.Errorf("failed to UPDATE %s %v", resourceName, res.Status)
+		return newAPIError(res, "failed to UPDATE %s", resourceName)
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateRealm(ctx context.Context, realm *v1alpha1.KeycloakRealm) error {
+	return c.update(ctx, realm, fmt.Sprintf("realms/%s", realm.Spec.Realm.ID), "realm")
+}
+
+// PatchRealm updates only the realm attributes present in patch, e.g.
+// {"displayName": "Example"}, the other attributes are left as they are.
+// Keycloak doesn't support PATCH on realms but the realm update applies only
+// the attributes set in the representation, so the patch is sent with PUT
+func (c *Client) PatchRealm(ctx context.Context, realmName string, patch map[string]interface{}) error {
+	return c.update(ctx, patch, fmt.Sprintf("realms/%s", realmName), "realm")
+}
+
+// SetRealmAttribute sets the realm attribute key to value, the other
+// attributes are left as they are
+func (c *Client) SetRealmAttribute(ctx context.Context, realmName, key, value string) error {
+	attributes, err := c.getRealmAttributes(ctx, realmName)
+	if err != nil {
+		return err
+	}
+	attributes[key] = value
+	return c.PatchRealm(ctx, realmName, map[string]interface{}{"attributes": attributes})
+}
+
+func (c *Client) UpdateRealmEventsConfig(ctx context.Context, realmName string, config *RealmEventsConfig) error {
+	return c.update(ctx, config, fmt.Sprintf("realms/%s/events/config", realmName), "realm events config")
+}
+
+func (c *Client) UpdateClient(ctx context.Context, specClient *v1alpha1.KeycloakAPIClient, realmName string) error {
+	if specClient.ID == "" {
+		return errors.New("client ID must be set")
+	}
+	return c.update(ctx, specClient, fmt.Sprintf("realms/%s/clients/%s", realmName, specClient.ID), "client")
+}
+
+func (c *Client) UpdateUser(ctx context.Context, specUser *v1alpha1.KeycloakAPIUser, realmName string) error {
+	return c.update(ctx, specUser, fmt.Sprintf("realms/%s/users/%s", realmName, specUser.ID), "user")
+}
+
+// UpdateCredentialLabel sets the label of the user credential, e.g. to tell
+// the OTP devices of the user apart
+func (c *Client) UpdateCredentialLabel(ctx context.Context, userID, credentialID, label, realmName string) error {
+	// Keycloak expects the label as plain text rather than JSON
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		fmt.Sprintf("%s/auth/admin/realms/%s/users/%s/credentials/%s/userLabel", c.URL, realmName, userID, credentialID),
+		strings.NewReader(label),
+	)
+	if err != nil {
+		logrus.Errorf("error creating UPDATE credential label request %+v", err)
+		return errors.Wrap(err, "error creating UPDATE credential label request")
+	}
+
+	req.Header.Set("Content-Type", "text/plain")
+	if err := c.authorize(ctx, req); err != nil {
+		return err
+	}
+	res, err := c.do(req)
+	if err != nil {
+		logrus.Errorf("error on request %+v", err)
+		return errors.Wrap(err, "error performing UPDATE credential label request")
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return newAPIError(res, "failed to UPDATE credential label")
+	}
+	return nil
+}
+
+// MoveCredentialToFirst gives the user credential the highest priority, making
+// it the one used by default when the user has several of the same type
+func (c *Client) MoveCredentialToFirst(ctx context.Context, userID, credentialID, realmName string) error {
+	_, err := c.post(ctx, nil, fmt.Sprintf("realms/%s/users/%s/credentials/%s/moveToFirst", realmName, userID, credentialID), "credential priority", func(body []byte) (T, error) {
+		return nil, nil
+	})
+	return err
+}
+
+// MoveCredentialAfter moves the user credential right after the credential
+// newPreviousCredentialID in the priority order of the credentials of the user
+func (c *Client) MoveCredentialAfter(ctx context.Context, userID, credentialID, newPreviousCredentialID, realmName string) error {
+	_, err := c.post(ctx, nil, fmt.Sprintf("realms/%s/users/%s/credentials/%s/moveAfter/%s", realmName, userID, credentialID, newPreviousCredentialID), "credential priority", func(body []byte) (T, error) {
+		return nil, nil
+	})
+	return err
+}
+
+// UpdateGroupManagementPermissions enables or disables the fine-grained
+// permissions of the group and returns the resulting permissions
+func (c *Client) UpdateGroupManagementPermissions(ctx context.Context, groupID, realmName string, enabled bool) (*ManagementPermissionReference, error) {
+	err := c.update(
+		ctx,
+		&ManagementPermissionReference{Enabled: enabled},
+		fmt.Sprintf("realms/%s/groups/%s/management/permissions", realmName, groupID),
+		"group management permissions",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetGroupManagementPermissions(ctx, groupID, realmName)
+}
+
+// UpdateClientManagementPermissions enables or disables the fine-grained
+// permissions of the client and returns the resulting permissions
+func (c *Client) UpdateClientManagementPermissions(ctx context.Context, clientID, realmName string, enabled bool) (*ManagementPermissionReference, error) {
+	err := c.update(
+		ctx,
+		&ManagementPermissionReference{Enabled: enabled},
+		fmt.Sprintf("realms/%s/clients/%s/management/permissions", realmName, clientID),
+		"client management permissions",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetClientManagementPermissions(ctx, clientID, realmName)
+}
+
+func (c *Client) UpdateIdentityProvider(ctx context.Context, specIdentityProvider *IdentityProvider, realmName string) error {
+	if specIdentityProvider.Alias == "" {
+		return errors.New("identity provider alias must be set")
+	}
+	return c.update(ctx, specIdentityProvider, fmt.Sprintf("realms/%s/identity-provider/instances/%s", realmName, specIdentityProvider.Alias), "identity provider")
+}
+
+func (c *Client) UpdateIdentityProviderMapper(ctx context.Context, alias, realmName string, mapper *IdentityProviderMapper) error {
+	if mapper.ID == "" {
+		return errors.New("identity provider mapper ID must be set")
+	}
+	return c.update(ctx, mapper, fmt.Sprintf("realms/%s/identity-provider/instances/%s/mappers/%s", realmName, alias, mapper.ID), "identity provider mapper")
+}
+
+func (c *Client) UpdateProtocolMapperForClient(ctx context.Context, clientID, realmName string, mapper *ProtocolMapper) error {
+	if mapper.ID == "" {
+		return errors.New("protocol mapper ID must be set")
+	}
+	return c.update(ctx, mapper, fmt.Sprintf("realms/%s/clients/%s/protocol-mappers/models/%s", realmName, clientID, mapper.ID), "client protocol mapper")
+}
+
+func (c *Client) UpdateProtocolMapperForClientScope(ctx context.Context, scopeID, realmName string, mapper *ProtocolMapper) error {
+	if mapper.ID == "" {
+		return errors.New("protocol mapper ID must be set")
+	}
+	return c.update(ctx, mapper, fmt.Sprintf("realms/%s/client-scopes/%s/protocol-mappers/models/%s", realmName, scopeID, mapper.ID), "client scope protocol mapper")
+}
+
+func (c *Client) UpdateClientRole(ctx context.Context, clientID, realmName string, role *v1alpha1.KeycloakUserRole) error {
+	if role.Name == "" {
+		return errors.New("client role name must be set")
+	}
+	return c.update(ctx, role, clientRolePath(clientID, role.Name, realmName), "client role")
+}
+
+func (c *Client) UpdateAuthzResource(ctx context.Context, clientID, realmName string, resource *AuthzResource) error {
+	if resource.ID == "" {
+		return errors.New("authz resource ID must be set")
+	}
+	return c.update(ctx, resource, authzResourcesPath(clientID, realmName)+"/"+resource.ID, "authz resource")
+}
+
+func (c *Client) UpdateAuthzScope(ctx context.Context, clientID, realmName string, scope *AuthzScope) error {
+	if scope.ID == "" {
+		return errors.New("authz scope ID must be set")
+	}
+	return c.update(ctx, scope, authzScopesPath(clientID, realmName)+"/"+scope.ID, "authz scope")
+}
+
+func (c *Client) UpdateComponent(ctx context.Context, component *Component, realmName string) error {
+	if component.ID == "" {
+		return errors.New("component ID must be set")
+	}
+	if err := validateComponent(component); err != nil {
+		return err
+	}
+	return c.update(ctx, component, fmt.Sprintf("realms/%s/components/%s", realmName, component.ID), "component")
+}
+
+// UpdateAuthzPolicy replaces the policy of the given type, policy is the JSON
+// representation for that type
+func (c *Client) UpdateAuthzPolicy(ctx context.Context, clientID, realmName, policyType, policyID string, policy json.RawMessage) error {
+	return c.update(ctx, policy, fmt.Sprintf("%s/%s/%s", authzPoliciesPath(clientID, realmName), policyType, policyID), "authz policy")
+}
+
+func (c *Client) UpdateClientScope(ctx context.Context, scope *ClientScope, realmName string) error {
+	if scope.ID == "" {
+		return errors.New("client scope ID must be set")
+	}
+	return c.update(ctx, scope, fmt.Sprintf("realms/%s/client-scopes/%s", realmName, scope.ID), "client scope")
+}
+
+func (c *Client) AssignDefaultClientScopeToClient(ctx context.Context, clientID, scopeID, realmName string) error {
+	return c.update(ctx, nil, fmt.Sprintf("realms/%s/clients/%s/default-client-scopes/%s", realmName, clientID, scopeID), "default client scope")
+}
+
+func (c *Client) AssignOptionalClientScopeToClient(ctx context.Context, clientID, scopeID, realmName string) error {
+	return c.update(ctx, nil, fmt.Sprintf("realms/%s/clients/%s/optional-client-scopes/%s", realmName, clientID, scopeID), "optional client scope")
+}
+
+// UpdateRequiredAction updates the configuration of the required action, e.g.
+// to enable it or make it a default action for new users
+func (c *Client) UpdateRequiredAction(ctx context.Context, alias, realmName string, action *RequiredAction) error {
+	return c.update(ctx, action, fmt.Sprintf("realms/%s/authentication/required-actions/%s", realmName, url.PathEscape(alias)), "required action")
+}
+
+func (c *Client) UpdateAuthenticatorConfig(ctx context.Context, authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string) error {
+	return c.update(ctx, authenticatorConfig, fmt.Sprintf("realms/%s/authentication/config/%s", realmName, authenticatorConfig.ID), "AuthenticatorConfig")
+}
+
+// Generic delete function for deleting Keycloak resources, a resource that
+// doesn't exist is considered deleted
+func (c *Client) delete(ctx context.Context, resourcePath, resourceName string, obj T) error {
+	err := c.deleteExisting(ctx, resourcePath, resourceName, obj)
+	if stderrors.Is(err, ErrNotFound) {
+		logrus.Errorf("Resource %v/%v already deleted", resourcePath, resourceName)
+		return nil
+	}
+	return err
+}
+
+// Generic delete function for deleting Keycloak resources that are expected
+// to exist, ErrNotFound is returned when the resource doesn't exist
+func (c *Client) deleteExisting(ctx context.Context, resourcePath, resourceName string, obj T) error {
+	// Some DELETE endpoints, like the role mappings, take the resources
+	// to remove in the request body
+	var body io.Reader
+	if obj != nil {
+		jsonValue, err := json.Marshal(obj)
+		if err != nil {
+			logrus.Errorf("error %+v marshalling object", err)
+			return errors.Wrapf(err, "error marshalling %s", resourceName)
+		}
+		body = bytes.NewBuffer(jsonValue)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		fmt.Sprintf("%s/auth/admin/%s", c.URL, resourcePath),
+		body,
+	)
+	if err != nil {
+		logrus.Errorf("error creating DELETE %s request %+v", resourceName, err)
+		return errors.Wrapf(err, "error creating DELETE %s request", resourceName)
+	}
+
+	if obj != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if err := c.authorize(ctx, req); err != nil {
+		return err
+	}
+	res, err := c.do(req)
+	if err != nil {
+		logrus.Errorf("error on request %+v", err)
+		return errors.Wrapf(err, "error performing DELETE %s request", resourceName)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != 204 {
+		return newAPIError(res, "failed to DELETE %s", resourceName)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteRealm(ctx context.Context, realmName string) error {
+	err := c.delete(ctx, fmt.Sprintf("realms/%s", realmName), "realm", nil)
+	return err
+}
+
+// DeleteClient removes the client, deleting a client that doesn't exist isn't
+// an error
+func (c *Client) DeleteClient(ctx context.Context, clientID, realmName string) error {
+	err := c.delete(ctx, fmt.Sprintf("realms/%s/clients/%s", realmName, clientID), "client", nil)
+	return err
+}
+
+func (c *Client) DeleteUser(ctx context.Context, userID, realmName string) error {
+	err := c.delete(ctx, fmt.Sprintf("realms/%s/users/%s", realmName, userID), "user", nil)
+	return err
+}
+
+// DeleteIdentityProvider removes the identity provider with the given alias,
+// ErrNotFound is returned if the realm has no such provider
+func (c *Client) DeleteIdentityProvider(ctx context.Context, alias string, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/identity-provider/instances/%s", realmName, alias), "identity provider", nil)
+}
+
+// DeleteIdentityProviderMapper removes the mapper from the identity provider,
+// ErrNotFound is returned if the mapper doesn't exist
+func (c *Client) DeleteIdentityProviderMapper(ctx context.Context, alias, mapperID, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/identity-provider/instances/%s/mappers/%s", realmName, alias, mapperID), "identity provider mapper", nil)
+}
+
+// DeleteClientRole removes the role of the client, ErrNotFound is returned if
+// the role doesn't exist
+func (c *Client) DeleteClientRole(ctx context.Context, clientID, roleName, realmName string) error {
+	return c.deleteExisting(ctx, clientRolePath(clientID, roleName, realmName), "client role", nil)
+}
+
+// RemoveCompositesFromClientRole removes the realm and client roles from the
+// composites of the role of the client
+func (c *Client) RemoveCompositesFromClientRole(ctx context.Context, clientID, roleName, realmName string, composites []*v1alpha1.KeycloakUserRole) error {
+	return c.deleteExisting(ctx, clientRolePath(clientID, roleName, realmName)+"/composites", "client role composites", composites)
+}
+
+// DeleteClientScopeRealmScopeMappings removes the realm roles from the roles
+// that can be included in the tokens issued with the client scope
+func (c *Client) DeleteClientScopeRealmScopeMappings(ctx context.Context, scopeID, realmName string, roles []*v1alpha1.KeycloakUserRole) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/client-scopes/%s/scope-mappings/realm", realmName, scopeID), "client scope realm scope mappings", roles)
+}
+
+// DeleteClientScopeClientScopeMappings removes the roles of the client
+// targetClientID from the roles that can be included in the tokens issued
+// with the client scope
+func (c *Client) DeleteClientScopeClientScopeMappings(ctx context.Context, scopeID, targetClientID, realmName string, roles []*v1alpha1.KeycloakUserRole) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/client-scopes/%s/scope-mappings/clients/%s", realmName, scopeID, targetClientID), "client scope client scope mappings", roles)
+}
+
+// DeleteAuthzResource removes the protected resource from the resource server
+// of the client, ErrNotFound is returned if it doesn't exist
+func (c *Client) DeleteAuthzResource(ctx context.Context, clientID, resourceID, realmName string) error {
+	return c.deleteExisting(ctx, authzResourcesPath(clientID, realmName)+"/"+resourceID, "authz resource", nil)
+}
+
+// DeleteAuthzScope removes the scope from the resource server of the client,
+// ErrNotFound is returned if it doesn't exist. Keycloak refuses to remove
+// scopes that are still used by resources, the returned APIError has the
+// reason
+func (c *Client) DeleteAuthzScope(ctx context.Context, clientID, scopeID, realmName string) error {
+	return c.deleteExisting(ctx, authzScopesPath(clientID, realmName)+"/"+scopeID, "authz scope", nil)
+}
+
+// DeleteComponent removes the component, ErrNotFound is returned if it
+// doesn't exist
+func (c *Client) DeleteComponent(ctx context.Context, componentID, realmName string) error {
+	if componentID == "" {
+		return errors.New("component ID must be set")
+	}
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/components/%s", realmName, componentID), "component", nil)
+}
+
+// DeleteAuthzPolicy removes the policy from the resource server of the client,
+// ErrNotFound is returned if it doesn't exist
+func (c *Client) DeleteAuthzPolicy(ctx context.Context, clientID, policyID, realmName string) error {
+	return c.deleteExisting(ctx, authzPoliciesPath(clientID, realmName)+"/"+policyID, "authz policy", nil)
+}
+
+// DeleteAuthzPermission removes the permission from the resource server of
+// the client, ErrNotFound is returned if it doesn't exist
+func (c *Client) DeleteAuthzPermission(ctx context.Context, clientID, permissionID, realmName string) error {
+	return c.deleteExisting(ctx, authzPermissionsPath(clientID, realmName)+"/"+permissionID, "authz permission", nil)
+}
+
+// DeleteProtocolMapperForClient removes the protocol mapper from the client,
+// ErrNotFound is returned if the mapper doesn't exist
+func (c *Client) DeleteProtocolMapperForClient(ctx context.Context, clientID, mapperID, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/clients/%s/protocol-mappers/models/%s", realmName, clientID, mapperID), "client protocol mapper", nil)
+}
+
+// DeleteProtocolMapperForClientScope removes the protocol mapper from the
+// client scope, ErrNotFound is returned if the mapper doesn't exist
+func (c *Client) DeleteProtocolMapperForClientScope(ctx context.Context, scopeID, mapperID, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/client-scopes/%s/protocol-mappers/models/%s", realmName, scopeID, mapperID), "client scope protocol mapper", nil)
+}
+
+// DeleteUserSession logs out the session, ErrNotFound is returned if the
+// session doesn't exist
+func (c *Client) DeleteUserSession(ctx context.Context, sessionID, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/sessions/%s", realmName, sessionID), "user session", nil)
+}
+
+// RevokeOfflineSession revokes the offline tokens the user was issued for the
+// client. Keycloak has no DELETE for offline sessions, revoking the consent of
+// the user for the client removes them
+func (c *Client) RevokeOfflineSession(ctx context.Context, userID, clientID, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/users/%s/consents/%s", realmName, userID, clientID), "offline session", nil)
+}
+
+// ClearBruteForceForUser clears the login failures of the user, unlocking the
+// user if brute force detection locked it out
+func (c *Client) ClearBruteForceForUser(ctx context.Context, userID, realmName string) error {
+	return c.delete(ctx, fmt.Sprintf("realms/%s/attack-detection/brute-force/users/%s", realmName, userID), "brute force status", nil)
+}
+
+// DeleteUserCredential removes the credential from the user, ErrNotFound is
+// returned if the user has no such credential
+func (c *Client) DeleteUserCredential(ctx context.Context, userID, credentialID, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/users/%s/credentials/%s", realmName, userID, credentialID), "user credential", nil)
+}
+
+// RevokeUserConsent revokes the consent of the user to the client, which also
+// revokes the offline tokens the user was issued for it. ErrNotFound is
+// returned if the user has no consent for the client
+func (c *Client) RevokeUserConsent(ctx context.Context, userID, clientID, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/users/%s/consents/%s", realmName, userID, clientID), "user consent", nil)
+}
+
+// DeleteClientScope removes the client scope, ErrNotFound is returned if the
+// scope doesn't exist
+func (c *Client) DeleteClientScope(ctx context.Context, scopeID, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/client-scopes/%s", realmName, scopeID), "client scope", nil)
+}
+
+func (c *Client) RemoveDefaultClientScopeFromClient(ctx context.Context, clientID, scopeID, realmName string) error {
+	return c.delete(ctx, fmt.Sprintf("realms/%s/clients/%s/default-client-scopes/%s", realmName, clientID, scopeID), "default client scope", nil)
+}
+
+func (c *Client) RemoveOptionalClientScopeFromClient(ctx context.Context, clientID, scopeID, realmName string) error {
+	return c.delete(ctx, fmt.Sprintf("realms/%s/clients/%s/optional-client-scopes/%s", realmName, clientID, scopeID), "optional client scope", nil)
+}
+
+// DeleteRequiredAction unregisters the required action, ErrNotFound is
+// returned if it isn't registered
+func (c *Client) DeleteRequiredAction(ctx context.Context, alias, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/authentication/required-actions/%s", realmName, url.PathEscape(alias)), "required action", nil)
+}
+
+func (c *Client) DeleteAuthenticatorConfig(ctx context.Context, configID, realmName string) error {
+	err := c.delete(ctx, fmt.Sprintf("realms/%s/authentication/config/%s", realmName, configID), "AuthenticatorConfig", nil)
+	return err
+}
+
+// Generic list function for listing Keycloak resources
+func (c *Client) list(ctx context.Context, resourcePath, resourceName string, unMarshalListFunc func(body []byte) (T, error)) (T, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf("%s/auth/admin/%s", c.URL, resourcePath),
+		nil,
+	)
+	if err != nil {
+		logrus.Errorf("error creating LIST %s request %+v", resourceName, err)
+		return nil, errors.Wrapf(err, "error creating LIST %s request", resourceName)
+	}
+
+	if err := c.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+	res, err := c.do(req)
+	if err != nil {
+		logrus.Errorf("error on request %+v", err)
+		return nil, errors.Wrapf(err, "error performing LIST %s request", resourceName)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, newAPIError(res, "failed to LIST %s", resourceName)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		logrus.Errorf("error reading response %+v", err)
+		return nil, errors.Wrapf(err, "error reading %s LIST response", resourceName)
+	}
+
+	objs, err := unMarshalListFunc(body)
+	if err != nil {
+		logrus.Error(err)
+		return nil, errors.Wrapf(err, "error unmarshalling %s LIST response", resourceName)
+	}
+
+	return objs, nil
+}
+
+func (c *Client) ListRealms(ctx context.Context) ([]*v1alpha1.KeycloakAPIRealm, error) {
+	result, err := c.list(ctx, "realms", "realm", func(body []byte) (T, error) {
+		var realms []*v1alpha1.KeycloakAPIRealm
+		err := json.Unmarshal(body, &realms)
+		return realms, err
+	})
+	resultAsRealm, ok := result.([]*v1alpha1.KeycloakAPIRealm)
+	if !ok {
+		return nil, err
+	}
+	return resultAsRealm, err
+}
+
+// ListRealmEvents returns the events of the realm matching params, params can
+// be nil to list the events without filtering
+func (c *Client) ListRealmEvents(ctx context.Context, realmName string, params *EventListParams) ([]*RealmEvent, error) {
+	query := url.Values{}
+	if params != nil {
+		for _, eventType := range params.Types {
+			query.Add("type", eventType)
+		}
+		if params.Client != "" {
+			query.Set("client", params.Client)
+		}
+		if params.User != "" {
+			query.Set("user", params.User)
+		}
+		if params.DateFrom != "" {
+			query.Set("dateFrom", params.DateFrom)
+		}
+		if params.DateTo != "" {
+			query.Set("dateTo", params.DateTo)
+		}
+		if params.First > 0 {
+			query.Set("first", strconv.Itoa(params.First))
+		}
+		if params.Max > 0 {
+			query.Set("max", strconv.Itoa(params.Max))
+		}
+	}
+
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/events?%s", realmName, query.Encode()), "realm events", func(body []byte) (T, error) {
+		var events []*RealmEvent
+		err := json.Unmarshal(body, &events)
+		return events, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*RealmEvent), nil
+}
+
+// ClearRealmEvents removes all events of the realm, ErrNotFound is returned if
+// the realm doesn't exist
+func (c *Client) ClearRealmEvents(ctx context.Context, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/events", realmName), "realm events", nil)
+}
+
+// ListAdminEvents returns the admin events of the realm matching params,
+// params can be nil to list the events without filtering
+func (c *Client) ListAdminEvents(ctx context.Context, realmName string, params *AdminEventListParams) ([]*AdminEvent, error) {
+	query := url.Values{}
+	if params != nil {
+		for _, operationType := range params.OperationTypes {
+			query.Add("operationTypes", operationType)
+		}
+		for _, resourceType := range params.ResourceTypes {
+			query.Add("resourceTypes", resourceType)
+		}
+		if params.AuthRealm != "" {
+			query.Set("authRealm", params.AuthRealm)
+		}
+		if params.AuthClient != "" {
+			query.Set("authClient", params.AuthClient)
+		}
+		if params.AuthUser != "" {
+			query.Set("authUser", params.AuthUser)
+		}
+		if params.DateFrom != "" {
+			query.Set("dateFrom", params.DateFrom)
+		}
+		if params.DateTo != "" {
+			query.Set("dateTo", params.DateTo)
+		}
+		if params.First > 0 {
+			query.Set("first", strconv.Itoa(params.First))
+		}
+		if params.Max > 0 {
+			query.Set("max", strconv.Itoa(params.Max))
+		}
+	}
+
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/admin-events?%s", realmName, query.Encode()), "admin events", func(body []byte) (T, error) {
+		var events []*AdminEvent
+		err := json.Unmarshal(body, &events)
+		return events, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*AdminEvent), nil
+}
+
+// ClearAdminEvents removes all admin events of the realm, ErrNotFound is
+// returned if the realm doesn't exist
+func (c *Client) ClearAdminEvents(ctx context.Context, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/admin-events", realmName), "admin events", nil)
+}
+
+func (c *Client) ListClients(ctx context.Context, realmName string) ([]*v1alpha1.KeycloakAPIClient, error) {
+	return c.ListClientsWithParams(ctx, realmName, nil)
+}
+
+// ListClientsWithParams returns the clients of the realm matching params,
+// params can be nil to list all clients
+func (c *Client) ListClientsWithParams(ctx context.Context, realmName string, params *ClientListParams) ([]*v1alpha1.KeycloakAPIClient, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.ClientID != "" {
+			query.Set("clientId", params.ClientID)
+		}
+		if params.ViewableOnly {
+			query.Set("viewableOnly", "true")
+		}
+	}
+
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/clients?%s", realmName, query.Encode()), "clients", func(body []byte) (T, error) {
+		var clients []*v1alpha1.KeycloakAPIClient
+		err := json.Unmarshal(body, &clients)
+		return clients, err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, ok := result.([]*v1alpha1.KeycloakAPIClient)
+
+	if !ok {
+		return nil, errors.New("error decoding list clients response")
+	}
+
+	return res, nil
+}
+
+// FindClientByClientID returns the client with the given clientId, or nil if
+// the realm has no such client
+func (c *Client) FindClientByClientID(ctx context.Context, clientID, realmName string) (*v1alpha1.KeycloakAPIClient, error) {
+	clients, err := c.ListClientsWithParams(ctx, realmName, &ClientListParams{ClientID: clientID})
+	if err != nil {
+		return nil, err
 	}
 
-	objs, err := unMarshalListFunc(body)
+	// Some Keycloak versions match the clientId filter as a prefix
+	for _, client := range clients {
+		if client.ClientID == clientID {
+			return client, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *Client) ListUsers(ctx context.Context, realmName string) ([]*v1alpha1.KeycloakAPIUser, error) {
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/users", realmName), "users", func(body []byte) (T, error) {
+		var users []*v1alpha1.KeycloakAPIUser
+		err := json.Unmarshal(body, &users)
+		return users, err
+	})
 	if err != nil {
-		logrus.Error(err)
+		return nil, err
+	}
+	return result.([]*v1alpha1.KeycloakAPIUser), err
+}
+
+func (c *Client) ListUsersInGroup(ctx context.Context, realmName, groupID string) ([]*v1alpha1.KeycloakAPIUser, error) {
+	path := fmt.Sprintf("realms/%s/groups/%s/members", realmName, groupID)
+	result, err := c.list(ctx, path, "users", func(body []byte) (T, error) {
+		var users []*v1alpha1.KeycloakAPIUser
+		err := json.Unmarshal(body, &users)
+		return users, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*v1alpha1.KeycloakAPIUser), nil
+}
+
+// GetGroupMembers returns a page of the group's members using the brief user
+// representation
+func (c *Client) GetGroupMembers(ctx context.Context, groupID, realmName string, first, max int) ([]*v1alpha1.KeycloakAPIUser, error) {
+	return c.listGroupMembers(ctx, groupID, realmName, first, max, true)
+}
+
+// GetAllGroupMembers pages through all the members of the group. Keycloak
+// returns at most 100 members per request, so this should be used instead of
+// ListUsersInGroup for large groups. Set briefRepresentation to false to get
+// the full user representations.
+func (c *Client) GetAllGroupMembers(ctx context.Context, groupID, realmName string, briefRepresentation bool) ([]*v1alpha1.KeycloakAPIUser, error) {
+	members := []*v1alpha1.KeycloakAPIUser{}
+	for first := 0; ; first += pageSize {
+		page, err := c.listGroupMembers(ctx, groupID, realmName, first, pageSize, briefRepresentation)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, page...)
+		if len(page) < pageSize {
+			return members, nil
+		}
+	}
+}
+
+// UserPager pages through a list of users without loading all of them in
+// memory, create it with NewUserPager or NewGroupMemberPager
+type UserPager struct {
+	listPage func(ctx context.Context, first, max int) ([]*v1alpha1.KeycloakAPIUser, error)
+	pageSize int
+	first    int
+	done     bool
+}
+
+// NewUserPager returns a pager over the users of the realm, a pageSize of 0 or
+// less uses the default page size of 100
+func NewUserPager(client *Client, realmName string, pageSize int) *UserPager {
+	return newUserPager(pageSize, func(ctx context.Context, first, max int) ([]*v1alpha1.KeycloakAPIUser, error) {
+		return client.listUsersPage(ctx, realmName, first, max)
+	})
+}
+
+// NewGroupMemberPager returns a pager over the members of the group using the
+// brief user representation, a pageSize of 0 or less uses the default page
+// size of 100
+func NewGroupMemberPager(client *Client, groupID, realmName string, pageSize int) *UserPager {
+	return newUserPager(pageSize, func(ctx context.Context, first, max int) ([]*v1alpha1.KeycloakAPIUser, error) {
+		return client.listGroupMembers(ctx, groupID, realmName, first, max, true)
+	})
+}
+
+// Keycloak treats a max of 0 or less as unlimited, which would load every
+// user in a single page, so those sizes fall back to the default
+func newUserPager(size int, listPage func(ctx context.Context, first, max int) ([]*v1alpha1.KeycloakAPIUser, error)) *UserPager {
+	if size <= 0 {
+		size = pageSize
+	}
+	return &UserPager{
+		listPage: listPage,
+		pageSize: size,
+	}
+}
+
+// Next returns the next page of users, an empty page is returned once all the
+// users were returned
+func (p *UserPager) Next(ctx context.Context) ([]*v1alpha1.KeycloakAPIUser, error) {
+	if p.done {
+		return nil, nil
+	}
+	page, err := p.listPage(ctx, p.first, p.pageSize)
+	if err != nil {
+		return nil, err
+	}
+	p.first += len(page)
+	p.done = len(page) < p.pageSize
+	return page, nil
+}
+
+func (c *Client) listUsersPage(ctx context.Context, realmName string, first, max int) ([]*v1alpha1.KeycloakAPIUser, error) {
+	query := url.Values{}
+	query.Set("first", strconv.Itoa(first))
+	query.Set("max", strconv.Itoa(max))
+	path := fmt.Sprintf("realms/%s/users?%s", realmName, query.Encode())
+	result, err := c.list(ctx, path, "users", func(body []byte) (T, error) {
+		var users []*v1alpha1.KeycloakAPIUser
+		err := json.Unmarshal(body, &users)
+		return users, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*v1alpha1.KeycloakAPIUser), nil
+}
+
+func (c *Client) listGroupMembers(ctx context.Context, groupID, realmName string, first, max int, briefRepresentation bool) ([]*v1alpha1.KeycloakAPIUser, error) {
+	query := url.Values{}
+	query.Set("first", strconv.Itoa(first))
+	query.Set("max", strconv.Itoa(max))
+	query.Set("briefRepresentation", strconv.FormatBool(briefRepresentation))
+	path := fmt.Sprintf("realms/%s/groups/%s/members?%s", realmName, groupID, query.Encode())
+	result, err := c.list(ctx, path, "users", func(body []byte) (T, error) {
+		var users []*v1alpha1.KeycloakAPIUser
+		err := json.Unmarshal(body, &users)
+		return users, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*v1alpha1.KeycloakAPIUser), nil
+}
+
+func (c *Client) AddUserToGroup(ctx context.Context, realmName, userID, groupID string) error {
+	add := map[string]string{
+		"userId":  userID,
+		"groupId": groupID,
+		"realm":   realmName,
+	}
+	path := fmt.Sprintf("realms/%s/users/%s/groups/%s", realmName, userID, groupID)
+
+	return c.update(ctx, add, path, "user-group")
+}
+
+func (c *Client) DeleteUserFromGroup(ctx context.Context, realmName, userID, groupID string) error {
+	path := fmt.Sprintf("realms/%s/users/%s/groups/%s", realmName, userID, groupID)
+
+	return c.delete(ctx, path, "user-group", nil)
+}
+
+func (c *Client) ListIdentityProviders(ctx context.Context, realmName string) ([]*IdentityProvider, error) {
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/identity-provider/instances", realmName), "identity providers", func(body []byte) (T, error) {
+		var providers []*IdentityProvider
+		err := json.Unmarshal(body, &providers)
+		return providers, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*IdentityProvider), err
+}
+
+func (c *Client) ListIdentityProviderMappers(ctx context.Context, alias, realmName string) ([]*IdentityProviderMapper, error) {
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/identity-provider/instances/%s/mappers", realmName, alias), "identity provider mappers", func(body []byte) (T, error) {
+		var mappers []*IdentityProviderMapper
+		err := json.Unmarshal(body, &mappers)
+		return mappers, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*IdentityProviderMapper), err
+}
+
+func (c *Client) ListClientScopes(ctx context.Context, realmName string) ([]*ClientScope, error) {
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/client-scopes", realmName), "client scopes", func(body []byte) (T, error) {
+		var scopes []*ClientScope
+		err := json.Unmarshal(body, &scopes)
+		return scopes, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*ClientScope), nil
+}
+
+// ListDefaultClientScopesForClient returns the id and name of the default
+// client scopes of the client
+func (c *Client) ListDefaultClientScopesForClient(ctx context.Context, clientID, realmName string) ([]*ClientScope, error) {
+	return c.listClientScopesForClient(ctx, fmt.Sprintf("realms/%s/clients/%s/default-client-scopes", realmName, clientID), "default client scopes")
+}
+
+// ListOptionalClientScopesForClient returns the id and name of the optional
+// client scopes of the client
+func (c *Client) ListOptionalClientScopesForClient(ctx context.Context, clientID, realmName string) ([]*ClientScope, error) {
+	return c.listClientScopesForClient(ctx, fmt.Sprintf("realms/%s/clients/%s/optional-client-scopes", realmName, clientID), "optional client scopes")
+}
+
+func (c *Client) listClientScopesForClient(ctx context.Context, resourcePath, resourceName string) ([]*ClientScope, error) {
+	result, err := c.list(ctx, resourcePath, resourceName, func(body []byte) (T, error) {
+		var scopes []*ClientScope
+		err := json.Unmarshal(body, &scopes)
+		return scopes, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*ClientScope), nil
+}
+
+func (c *Client) ListClientRoles(ctx context.Context, clientID, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	return c.listRoles(ctx, fmt.Sprintf("realms/%s/clients/%s/roles", realmName, clientID), "client roles")
+}
+
+// ListUsersInClientRole returns a page of the users that have the role of the
+// client directly, users that have it through a group or a composite role
+// aren't included
+func (c *Client) ListUsersInClientRole(ctx context.Context, clientID, roleName, realmName string, first, max int) ([]*v1alpha1.KeycloakAPIUser, error) {
+	query := url.Values{}
+	query.Set("first", strconv.Itoa(first))
+	query.Set("max", strconv.Itoa(max))
+	path := fmt.Sprintf("%s/users?%s", clientRolePath(clientID, roleName, realmName), query.Encode())
+	result, err := c.list(ctx, path, "client role users", func(body []byte) (T, error) {
+		var users []*v1alpha1.KeycloakAPIUser
+		err := json.Unmarshal(body, &users)
+		return users, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*v1alpha1.KeycloakAPIUser), nil
+}
+
+// ListClientRoleComposites returns all the realm and client roles that are
+// composites of the role of the client
+func (c *Client) ListClientRoleComposites(ctx context.Context, clientID, roleName, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	return c.listRoles(ctx, clientRolePath(clientID, roleName, realmName)+"/composites", "client role composites")
+}
+
+// ListClientRoleRealmComposites returns the realm roles that are composites
+// of the role of the client
+func (c *Client) ListClientRoleRealmComposites(ctx context.Context, clientID, roleName, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	return c.listRoles(ctx, clientRolePath(clientID, roleName, realmName)+"/composites/realm", "client role realm composites")
+}
+
+// ListClientRoleClientComposites returns the roles of otherClientID that are
+// composites of the role of the client
+func (c *Client) ListClientRoleClientComposites(ctx context.Context, clientID, roleName, otherClientID, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	return c.listRoles(ctx, clientRolePath(clientID, roleName, realmName)+"/composites/clients/"+otherClientID, "client role client composites")
+}
+
+func (c *Client) listRoles(ctx context.Context, resourcePath, resourceName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	result, err := c.list(ctx, resourcePath, resourceName, func(body []byte) (T, error) {
+		var roles []*v1alpha1.KeycloakUserRole
+		err := json.Unmarshal(body, &roles)
+		return roles, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*v1alpha1.KeycloakUserRole), nil
+}
+
+// ListAuthzResources returns the protected resources of the resource server
+// of the client matching params, params can be nil
+func (c *Client) ListAuthzResources(ctx context.Context, clientID, realmName string, params *AuthzResourceListParams) ([]*AuthzResource, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Name != "" {
+			query.Set("name", params.Name)
+		}
+		if params.URI != "" {
+			query.Set("uri", params.URI)
+		}
+		if params.Type != "" {
+			query.Set("type", params.Type)
+		}
+		if params.First > 0 {
+			query.Set("first", strconv.Itoa(params.First))
+		}
+		if params.Max > 0 {
+			query.Set("max", strconv.Itoa(params.Max))
+		}
+	}
+
+	result, err := c.list(ctx, fmt.Sprintf("%s?%s", authzResourcesPath(clientID, realmName), query.Encode()), "authz resources", func(body []byte) (T, error) {
+		var resources []*AuthzResource
+		err := json.Unmarshal(body, &resources)
+		return resources, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*AuthzResource), nil
+}
+
+// ListAuthzScopes returns the scopes of the resource server of the client
+// matching params, params can be nil
+func (c *Client) ListAuthzScopes(ctx context.Context, clientID, realmName string, params *AuthzScopeListParams) ([]*AuthzScope, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Name != "" {
+			query.Set("name", params.Name)
+		}
+		if params.First > 0 {
+			query.Set("first", strconv.Itoa(params.First))
+		}
+		if params.Max > 0 {
+			query.Set("max", strconv.Itoa(params.Max))
+		}
+	}
+
+	result, err := c.list(ctx, fmt.Sprintf("%s?%s", authzScopesPath(clientID, realmName), query.Encode()), "authz scopes", func(body []byte) (T, error) {
+		var scopes []*AuthzScope
+		err := json.Unmarshal(body, &scopes)
+		return scopes, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*AuthzScope), nil
+}
+
+// ListAuthzPolicies returns the policies of the resource server of the client
+func (c *Client) ListAuthzPolicies(ctx context.Context, clientID, realmName string) ([]*AuthzPolicy, error) {
+	return c.listAuthzPolicies(ctx, authzPoliciesPath(clientID, realmName), "authz policies")
+}
+
+func (c *Client) listAuthzPolicies(ctx context.Context, resourcePath, resourceName string) ([]*AuthzPolicy, error) {
+	result, err := c.list(ctx, resourcePath, resourceName, func(body []byte) (T, error) {
+		var raws []json.RawMessage
+		if err := json.Unmarshal(body, &raws); err != nil {
+			return nil, err
+		}
+		policies := []*AuthzPolicy{}
+		for _, raw := range raws {
+			policy, err := unmarshalAuthzPolicy(raw)
+			if err != nil {
+				return nil, err
+			}
+			policies = append(policies, policy.(*AuthzPolicy))
+		}
+		return policies, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*AuthzPolicy), nil
+}
+
+// ListAuthzPermissions returns the permissions of the resource server of the
+// client, the associated resources, scopes and policies aren't included
+func (c *Client) ListAuthzPermissions(ctx context.Context, clientID, realmName string) ([]*AuthzPermission, error) {
+	result, err := c.list(ctx, authzPermissionsPath(clientID, realmName), "authz permissions", func(body []byte) (T, error) {
+		var permissions []*AuthzPermission
+		err := json.Unmarshal(body, &permissions)
+		return permissions, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*AuthzPermission), nil
+}
+
+// ListAuthzPermissionPolicies returns the policies the permission applies
+func (c *Client) ListAuthzPermissionPolicies(ctx context.Context, clientID, permissionID, realmName string) ([]*AuthzPolicy, error) {
+	return c.listAuthzPolicies(ctx, authzPermissionsPath(clientID, realmName)+"/"+permissionID+"/associatedPolicies", "authz permission policies")
+}
+
+// ListAuthzPermissionResources returns the resources the permission protects,
+// only their ID and name are set
+func (c *Client) ListAuthzPermissionResources(ctx context.Context, clientID, permissionID, realmName string) ([]*AuthzResource, error) {
+	result, err := c.list(ctx, authzPermissionsPath(clientID, realmName)+"/"+permissionID+"/resources", "authz permission resources", func(body []byte) (T, error) {
+		var resources []*AuthzResource
+		err := json.Unmarshal(body, &resources)
+		return resources, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*AuthzResource), nil
+}
+
+// ListAuthzPermissionScopes returns the scopes the permission protects
+func (c *Client) ListAuthzPermissionScopes(ctx context.Context, clientID, permissionID, realmName string) ([]*AuthzScope, error) {
+	result, err := c.list(ctx, authzPermissionsPath(clientID, realmName)+"/"+permissionID+"/scopes", "authz permission scopes", func(body []byte) (T, error) {
+		var scopes []*AuthzScope
+		err := json.Unmarshal(body, &scopes)
+		return scopes, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*AuthzScope), nil
+}
+
+// ListComponents returns the components of the realm matching params, e.g.
+// the user federation providers, params can be nil
+func (c *Client) ListComponents(ctx context.Context, realmName string, params *ComponentListParams) ([]*Component, error) {
+	query := url.Values{}
+	if params != nil {
+		if params.Parent != "" {
+			query.Set("parent", params.Parent)
+		}
+		if params.Type != "" {
+			query.Set("type", params.Type)
+		}
+		if params.Name != "" {
+			query.Set("name", params.Name)
+		}
+	}
+
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/components?%s", realmName, query.Encode()), "components", func(body []byte) (T, error) {
+		var components []*Component
+		err := json.Unmarshal(body, &components)
+		return components, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*Component), nil
+}
+
+func (c *Client) ListProtocolMappersForClient(ctx context.Context, clientID, realmName string) ([]*ProtocolMapper, error) {
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/clients/%s/protocol-mappers/models", realmName, clientID), "protocol mappers", func(body []byte) (T, error) {
+		var mappers []*ProtocolMapper
+		err := json.Unmarshal(body, &mappers)
+		return mappers, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*ProtocolMapper), nil
+}
+
+// ListClientScopeRealmScopeMappings returns the realm roles that can be
+// included in the tokens issued with the client scope
+func (c *Client) ListClientScopeRealmScopeMappings(ctx context.Context, scopeID, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	return c.listRoles(ctx, fmt.Sprintf("realms/%s/client-scopes/%s/scope-mappings/realm", realmName, scopeID), "client scope realm scope mappings")
+}
+
+// ListAvailableClientScopeRealmScopeMappings returns the realm roles that can
+// still be added to the scope mappings of the client scope
+func (c *Client) ListAvailableClientScopeRealmScopeMappings(ctx context.Context, scopeID, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	return c.listRoles(ctx, fmt.Sprintf("realms/%s/client-scopes/%s/scope-mappings/realm/available", realmName, scopeID), "available client scope realm scope mappings")
+}
+
+// ListClientScopeClientScopeMappings returns the roles of the client
+// targetClientID that can be included in the tokens issued with the client
+// scope
+func (c *Client) ListClientScopeClientScopeMappings(ctx context.Context, scopeID, targetClientID, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	return c.listRoles(ctx, fmt.Sprintf("realms/%s/client-scopes/%s/scope-mappings/clients/%s", realmName, scopeID, targetClientID), "client scope client scope mappings")
+}
+
+// ListAvailableClientScopeClientScopeMappings returns the roles of the client
+// targetClientID that can still be added to the scope mappings of the client
+// scope
+func (c *Client) ListAvailableClientScopeClientScopeMappings(ctx context.Context, scopeID, targetClientID, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	return c.listRoles(ctx, fmt.Sprintf("realms/%s/client-scopes/%s/scope-mappings/clients/%s/available", realmName, scopeID, targetClientID), "available client scope client scope mappings")
+}
+
+func (c *Client) ListProtocolMappersForClientScope(ctx context.Context, scopeID, realmName string) ([]*ProtocolMapper, error) {
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/client-scopes/%s/protocol-mappers/models", realmName, scopeID), "client scope protocol mappers", func(body []byte) (T, error) {
+		var mappers []*ProtocolMapper
+		err := json.Unmarshal(body, &mappers)
+		return mappers, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*ProtocolMapper), nil
+}
+
+func evaluateScopesQuery(userID, scope string) string {
+	query := url.Values{}
+	if userID != "" {
+		query.Set("userId", userID)
+	}
+	if scope != "" {
+		query.Set("scope", scope)
+	}
+	if len(query) == 0 {
+		return ""
+	}
+	return "?" + query.Encode()
+}
+
+// ListEvaluatedProtocolMappers returns the protocol mappers applied to the
+// tokens of the client when the space separated scopes in scope are
+// requested, an empty scope evaluates the default client scopes only
+func (c *Client) ListEvaluatedProtocolMappers(ctx context.Context, clientID, realmName, scope string) ([]*ProtocolMapperEvaluation, error) {
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/clients/%s/evaluate-scopes/protocol-mappers%s", realmName, clientID, evaluateScopesQuery("", scope)), "evaluated protocol mappers", func(body []byte) (T, error) {
+		var mappers []*ProtocolMapperEvaluation
+		err := json.Unmarshal(body, &mappers)
+		return mappers, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*ProtocolMapperEvaluation), nil
+}
+
+// GenerateExampleAccessToken returns the JSON payload of the access token the
+// client would get for the user when the space separated scopes in scope are
+// requested. The token isn't signed and is returned as is so the claims added
+// by the protocol mappers can be asserted
+func (c *Client) GenerateExampleAccessToken(ctx context.Context, clientID, realmName, userID, scope string) (string, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/clients/%s/evaluate-scopes/generate-example-access-token%s", realmName, clientID, evaluateScopesQuery(userID, scope)), "example access token", func(body []byte) (T, error) {
+		return string(body), nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if result == nil {
+		return "", ErrNotFound
+	}
+	return result.(string), nil
+}
+
+func (c *Client) ListUserClientRoles(ctx context.Context, realmName, clientID, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
+	objects, err := c.list(ctx, "realms/"+realmName+"/users/"+userID+"/role-mappings/clients/"+clientID, "userClientRoles", func(body []byte) (t T, e error) {
+		var userClientRoles []*v1alpha1.KeycloakUserRole
+		err := json.Unmarshal(body, &userClientRoles)
+		return userClientRoles, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if objects == nil {
+		return nil, nil
 	}
-
-	return objs, nil
+	return objects.([]*v1alpha1.KeycloakUserRole), err
 }
 
-func (c *Client) ListRealms() ([]*v1alpha1.KeycloakAPIRealm, error) {
-	result, err := c.list("realms", "realm", func(body []byte) (T, error) {
-		var realms []*v1alpha1.KeycloakAPIRealm
-		err := json.Unmarshal(body, &realms)
-		return realms, err
+func (c *Client) ListAvailableUserClientRoles(ctx context.Context, realmName, clientID, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
+	objects, err := c.list(ctx, "realms/"+realmName+"/users/"+userID+"/role-mappings/clients/"+clientID+"/available", "userClientRoles", func(body []byte) (t T, e error) {
+		var userClientRoles []*v1alpha1.KeycloakUserRole
+		err := json.Unmarshal(body, &userClientRoles)
+		return userClientRoles, err
 	})
-	resultAsRealm, ok := result.([]*v1alpha1.KeycloakAPIRealm)
-	if !ok {
+	if err != nil {
 		return nil, err
 	}
-	return resultAsRealm, err
+	if objects == nil {
+		return nil, nil
+	}
+	return objects.([]*v1alpha1.KeycloakUserRole), err
 }
 
-func (c *Client) ListClients(realmName string) ([]*v1alpha1.KeycloakAPIClient, error) {
-	result, err := c.list(fmt.Sprintf("realms/%s/clients", realmName), "clients", func(body []byte) (T, error) {
-		var clients []*v1alpha1.KeycloakAPIClient
-		err := json.Unmarshal(body, &clients)
-		return clients, err
+func (c *Client) ListUserSessions(ctx context.Context, userID, realmName string) ([]*UserSession, error) {
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/users/%s/sessions", realmName, userID), "user sessions", func(body []byte) (T, error) {
+		var sessions []*UserSession
+		err := json.Unmarshal(body, &sessions)
+		return sessions, err
 	})
-
 	if err != nil {
 		return nil, err
 	}
+	return result.([]*UserSession), nil
+}
 
-	res, ok := result.([]*v1alpha1.KeycloakAPIClient)
-
-	if !ok {
-		return nil, errors.New("error decoding list clients response")
+// ListClientSessions returns the user sessions that are active for the client
+func (c *Client) ListClientSessions(ctx context.Context, clientID, realmName string) ([]*UserSession, error) {
+	sessions := []*UserSession{}
+	for first := 0; ; first += pageSize {
+		page, err := c.ListClientUserSessions(ctx, clientID, realmName, first, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, page...)
+		if len(page) < pageSize {
+			return sessions, nil
+		}
 	}
-
-	return res, nil
 }
 
-func (c *Client) ListUsers(realmName string) ([]*v1alpha1.KeycloakAPIUser, error) {
-	result, err := c.list(fmt.Sprintf("realms/%s/users", realmName), "users", func(body []byte) (T, error) {
-		var users []*v1alpha1.KeycloakAPIUser
-		err := json.Unmarshal(body, &users)
-		return users, err
+// ListClientUserSessions returns a page of the user sessions that are active
+// for the client
+func (c *Client) ListClientUserSessions(ctx context.Context, clientID, realmName string, first, max int) ([]*UserSession, error) {
+	query := url.Values{}
+	query.Set("first", strconv.Itoa(first))
+	query.Set("max", strconv.Itoa(max))
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/clients/%s/user-sessions?%s", realmName, clientID, query.Encode()), "client sessions", func(body []byte) (T, error) {
+		var sessions []*UserSession
+		err := json.Unmarshal(body, &sessions)
+		return sessions, err
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.([]*v1alpha1.KeycloakAPIUser), err
+	return result.([]*UserSession), nil
 }
 
-func (c *Client) ListUsersInGroup(realmName, groupID string) ([]*v1alpha1.KeycloakAPIUser, error) {
-	path := fmt.Sprintf("realms/%s/groups/%s/members", realmName, groupID)
-	result, err := c.list(path, "users", func(body []byte) (T, error) {
-		var users []*v1alpha1.KeycloakAPIUser
-		err := json.Unmarshal(body, &users)
-		return users, err
+// CountClientSessions returns the number of user sessions that are active for
+// the client
+func (c *Client) CountClientSessions(ctx context.Context, clientID, realmName string) (int, error) {
+	return c.getCount(ctx, fmt.Sprintf("realms/%s/clients/%s/session-count", realmName, clientID), "client session count")
+}
+
+// ListClientOfflineSessions returns a page of the offline sessions of the
+// client
+func (c *Client) ListClientOfflineSessions(ctx context.Context, clientID, realmName string, first, max int) ([]*UserSession, error) {
+	query := url.Values{}
+	query.Set("first", strconv.Itoa(first))
+	query.Set("max", strconv.Itoa(max))
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/clients/%s/offline-sessions?%s", realmName, clientID, query.Encode()), "client offline sessions", func(body []byte) (T, error) {
+		var sessions []*UserSession
+		err := json.Unmarshal(body, &sessions)
+		return sessions, err
 	})
 	if err != nil {
 		return nil, err
 	}
-
-	return result.([]*v1alpha1.KeycloakAPIUser), nil
+	return result.([]*UserSession), nil
 }
 
-func (c *Client) AddUserToGroup(realmName, userID, groupID string) error {
-	add := map[string]string{
-		"userId":  userID,
-		"groupId": groupID,
-		"realm":   realmName,
-	}
-	path := fmt.Sprintf("realms/%s/users/%s/groups/%s", realmName, userID, groupID)
-
-	return c.update(add, path, "user-group")
+// CountClientOfflineSessions returns the number of offline sessions of the
+// client
+func (c *Client) CountClientOfflineSessions(ctx context.Context, clientID, realmName string) (int, error) {
+	return c.getCount(ctx, fmt.Sprintf("realms/%s/clients/%s/offline-session-count", realmName, clientID), "client offline session count")
 }
 
-func (c *Client) DeleteUserFromGroup(realmName, userID, groupID string) error {
-	path := fmt.Sprintf("realms/%s/users/%s/groups/%s", realmName, userID, groupID)
+// ListOfflineSessionsForUser returns the offline sessions of the user across
+// all clients of the realm. Only the clients listed in the consents of the
+// user, which include the clients holding offline tokens, are checked: the
+// call costs one request for the consents plus two per listed client
+func (c *Client) ListOfflineSessionsForUser(ctx context.Context, userID, realmName string) ([]*UserSession, error) {
+	consents, err := c.ListUserConsents(ctx, userID, realmName)
+	if err != nil {
+		return nil, err
+	}
 
-	return c.delete(path, "user-group", nil)
+	sessions := []*UserSession{}
+	for _, consent := range consents {
+		client, err := c.FindClientByClientID(ctx, consent.ClientID, realmName)
+		if err != nil {
+			return nil, err
+		}
+		if client == nil {
+			// the client was deleted after the consents were listed
+			continue
+		}
+		result, err := c.list(ctx, fmt.Sprintf("realms/%s/users/%s/offline-sessions/%s", realmName, userID, client.ID), "offline sessions", func(body []byte) (T, error) {
+			var page []*UserSession
+			err := json.Unmarshal(body, &page)
+			return page, err
+		})
+		if stderrors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, result.([]*UserSession)...)
+	}
+	return sessions, nil
 }
 
-func (c *Client) ListIdentityProviders(realmName string) ([]*v1alpha1.KeycloakIdentityProvider, error) {
-	result, err := c.list(fmt.Sprintf("realms/%s/identity-provider/instances", realmName), "identity providers", func(body []byte) (T, error) {
-		var providers []*v1alpha1.KeycloakIdentityProvider
-		err := json.Unmarshal(body, &providers)
-		return providers, err
+// GetBruteForceStatus returns the brute force detection status of the user,
+// Disabled is true while the user is locked out
+func (c *Client) GetBruteForceStatus(ctx context.Context, userID, realmName string) (*BruteForceStatus, error) {
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/attack-detection/brute-force/users/%s", realmName, userID), "brute force status", func(body []byte) (T, error) {
+		status := &BruteForceStatus{}
+		err := json.Unmarshal(body, status)
+		return status, err
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.([]*v1alpha1.KeycloakIdentityProvider), err
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*BruteForceStatus), nil
 }
 
-func (c *Client) ListUserClientRoles(realmName, clientID, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
-	objects, err := c.list("realms/"+realmName+"/users/"+userID+"/role-mappings/clients/"+clientID, "userClientRoles", func(body []byte) (t T, e error) {
-		var userClientRoles []*v1alpha1.KeycloakUserRole
-		err := json.Unmarshal(body, &userClientRoles)
-		return userClientRoles, err
+// ListUserCredentials returns the credentials of the user ordered by their
+// priority
+func (c *Client) ListUserCredentials(ctx context.Context, userID, realmName string) ([]*Credential, error) {
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/users/%s/credentials", realmName, userID), "user credentials", func(body []byte) (T, error) {
+		var credentials []*Credential
+		err := json.Unmarshal(body, &credentials)
+		return credentials, err
 	})
 	if err != nil {
 		return nil, err
 	}
-	if objects == nil {
-		return nil, nil
-	}
-	return objects.([]*v1alpha1.KeycloakUserRole), err
+	return result.([]*Credential), nil
 }
 
-func (c *Client) ListAvailableUserClientRoles(realmName, clientID, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
-	objects, err := c.list("realms/"+realmName+"/users/"+userID+"/role-mappings/clients/"+clientID+"/available", "userClientRoles", func(body []byte) (t T, e error) {
-		var userClientRoles []*v1alpha1.KeycloakUserRole
-		err := json.Unmarshal(body, &userClientRoles)
-		return userClientRoles, err
+// ListUserConsents returns the consents the user granted to the clients of
+// the realm, including the clients the user has offline tokens for
+func (c *Client) ListUserConsents(ctx context.Context, userID, realmName string) ([]*UserConsent, error) {
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/users/%s/consents", realmName, userID), "user consents", func(body []byte) (T, error) {
+		var consents []*UserConsent
+		err := json.Unmarshal(body, &consents)
+		return consents, err
 	})
 	if err != nil {
 		return nil, err
 	}
-	if objects == nil {
-		return nil, nil
-	}
-	return objects.([]*v1alpha1.KeycloakUserRole), err
+	return result.([]*UserConsent), nil
 }
 
-func (c *Client) ListUserRealmRoles(realmName, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
-	objects, err := c.list("realms/"+realmName+"/users/"+userID+"/role-mappings/realm", "userRealmRoles", func(body []byte) (t T, e error) {
+func (c *Client) ListUserRealmRoles(ctx context.Context, realmName, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
+	objects, err := c.list(ctx, "realms/"+realmName+"/users/"+userID+"/role-mappings/realm", "userRealmRoles", func(body []byte) (t T, e error) {
 		var userRealmRoles []*v1alpha1.KeycloakUserRole
 		err := json.Unmarshal(body, &userRealmRoles)
 		return userRealmRoles, err
@@ -654,8 +3302,8 @@ func (c *Client) ListUserRealmRoles(realmName, userID string) ([]*v1alpha1.Keycl
 	return objects.([]*v1alpha1.KeycloakUserRole), err
 }
 
-func (c *Client) ListAvailableUserRealmRoles(realmName, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
-	objects, err := c.list("realms/"+realmName+"/users/"+userID+"/role-mappings/realm/available", "userClientRoles", func(body []byte) (t T, e error) {
+func (c *Client) ListAvailableUserRealmRoles(ctx context.Context, realmName, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
+	objects, err := c.list(ctx, "realms/"+realmName+"/users/"+userID+"/role-mappings/realm/available", "userClientRoles", func(body []byte) (t T, e error) {
 		var userRealmRoles []*v1alpha1.KeycloakUserRole
 		err := json.Unmarshal(body, &userRealmRoles)
 		return userRealmRoles, err
@@ -669,8 +3317,8 @@ func (c *Client) ListAvailableUserRealmRoles(realmName, userID string) ([]*v1alp
 	return objects.([]*v1alpha1.KeycloakUserRole), err
 }
 
-func (c *Client) ListAuthenticationExecutionsForFlow(flowAlias, realmName string) ([]*v1alpha1.AuthenticationExecutionInfo, error) {
-	result, err := c.list(fmt.Sprintf("realms/%s/authentication/flows/%s/executions", realmName, flowAlias), "AuthenticationExecution", func(body []byte) (T, error) {
+func (c *Client) ListAuthenticationExecutionsForFlow(ctx context.Context, flowAlias, realmName string) ([]*v1alpha1.AuthenticationExecutionInfo, error) {
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/authentication/flows/%s/executions", realmName, flowAlias), "AuthenticationExecution", func(body []byte) (T, error) {
 		var authenticationExecutions []*v1alpha1.AuthenticationExecutionInfo
 		err := json.Unmarshal(body, &authenticationExecutions)
 		return authenticationExecutions, err
@@ -681,8 +3329,21 @@ func (c *Client) ListAuthenticationExecutionsForFlow(flowAlias, realmName string
 	return result.([]*v1alpha1.AuthenticationExecutionInfo), err
 }
 
-func (c *Client) FindAuthenticationExecutionForFlow(flowAlias, realmName string, predicate func(*v1alpha1.AuthenticationExecutionInfo) bool) (*v1alpha1.AuthenticationExecutionInfo, error) {
-	executions, err := c.ListAuthenticationExecutionsForFlow(flowAlias, realmName)
+// ListRequiredActions returns the required actions registered in the realm
+func (c *Client) ListRequiredActions(ctx context.Context, realmName string) ([]*RequiredAction, error) {
+	result, err := c.list(ctx, fmt.Sprintf("realms/%s/authentication/required-actions", realmName), "required actions", func(body []byte) (T, error) {
+		var actions []*RequiredAction
+		err := json.Unmarshal(body, &actions)
+		return actions, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*RequiredAction), nil
+}
+
+func (c *Client) FindAuthenticationExecutionForFlow(ctx context.Context, flowAlias, realmName string, predicate func(*v1alpha1.AuthenticationExecutionInfo) bool) (*v1alpha1.AuthenticationExecutionInfo, error) {
+	executions, err := c.ListAuthenticationExecutionsForFlow(ctx, flowAlias, realmName)
 
 	if err != nil {
 		return nil, err
@@ -697,14 +3358,25 @@ func (c *Client) FindAuthenticationExecutionForFlow(flowAlias, realmName string,
 	return nil, nil
 }
 
-func (c *Client) UpdateAuthenticationExecutionForFlow(flowAlias, realmName string, execution *v1alpha1.AuthenticationExecutionInfo) error {
+func (c *Client) UpdateAuthenticationExecutionForFlow(ctx context.Context, flowAlias, realmName string, execution *v1alpha1.AuthenticationExecutionInfo) error {
 	path := fmt.Sprintf("realms/%s/authentication/flows/%s/executions", realmName, flowAlias)
-	return c.update(execution, path, "AuthenticationExecution")
+	return c.update(ctx, execution, path, "AuthenticationExecution")
 }
 
-func (c *Client) FindGroupByName(groupName string, realmName string) (*Group, error) {
-	// Get a list of the groups in the realm
-	groups, err := c.list(fmt.Sprintf("realms/%s/groups", realmName), "Group", func(body []byte) (T, error) {
+// ListGroups returns the groups in the realm matching search, or all of them
+// when search is empty. A group matches when its name or the name of any of its
+// subgroups contains search; in the latter case the parent is returned with
+// only the matching subgroups. A max of 0 or less uses the server default.
+func (c *Client) ListGroups(ctx context.Context, realmName string, search string, first, max int) ([]*Group, error) {
+	query := url.Values{}
+	if search != "" {
+		query.Set("search", search)
+	}
+	query.Set("first", strconv.Itoa(first))
+	if max > 0 {
+		query.Set("max", strconv.Itoa(max))
+	}
+	groups, err := c.list(ctx, fmt.Sprintf("realms/%s/groups?%s", realmName, query.Encode()), "Group", func(body []byte) (T, error) {
 		var groups []*Group
 		err := json.Unmarshal(body, &groups)
 		return groups, err
@@ -714,6 +3386,49 @@ func (c *Client) FindGroupByName(groupName string, realmName string) (*Group, er
 		return nil, err
 	}
 
+	return groups.([]*Group), nil
+}
+
+// CountGroups returns the number of groups in the realm matching search, only
+// top level groups are counted if topLevelOnly is set
+func (c *Client) CountGroups(ctx context.Context, realmName string, search string, topLevelOnly bool) (int, error) {
+	query := url.Values{}
+	if search != "" {
+		query.Set("search", search)
+	}
+	if topLevelOnly {
+		query.Set("top", "true")
+	}
+	return c.getCount(ctx, fmt.Sprintf("realms/%s/groups/count?%s", realmName, query.Encode()), "group count")
+}
+
+// getCount reads counts wrapped in an object, e.g. {"count": 42}, unlike the
+// users count which is a bare integer
+func (c *Client) getCount(ctx context.Context, resourcePath, resourceName string) (int, error) {
+	result, err := c.get(ctx, resourcePath, resourceName, func(body []byte) (T, error) {
+		count := &struct {
+			Count int `json:"count"`
+		}{}
+		err := json.Unmarshal(body, count)
+		return count.Count, err
+	})
+	if err != nil {
+		return 0, err
+	}
+	if result == nil {
+		return 0, ErrNotFound
+	}
+	return result.(int), nil
+}
+
+func (c *Client) FindGroupByName(ctx context.Context, groupName string, realmName string) (*Group, error) {
+	// Get the groups in the realm that could match the name
+	groups, err := c.ListGroups(ctx, realmName, groupName, 0, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
 	// Function that recursively looks for the group in the hierarchy
 	var findInList func([]*Group) *Group
 	findInList = func(groupList []*Group) *Group {
@@ -733,22 +3448,237 @@ func (c *Client) FindGroupByName(groupName string, realmName string) (*Group, er
 
 	// If the loop finishes without finding the group,
 	// return nil
-	return findInList(groups.([]*Group)), nil
+	return findInList(groups), nil
+}
+
+// FindClientScopeByName returns the client scope with the exact name, or nil
+// if the realm has no such scope
+func (c *Client) FindClientScopeByName(ctx context.Context, name, realmName string) (*ClientScope, error) {
+	scopes, err := c.ListClientScopes(ctx, realmName)
+	if err != nil {
+		return nil, err
+	}
+	for _, scope := range scopes {
+		if scope.Name == name {
+			return scope, nil
+		}
+	}
+	return nil, nil
+}
+
+// FindGroupByNameInHierarchy looks for the group in the whole group tree of
+// the realm, fetching the children of groups whose subgroups weren't returned
+// inline. Returns nil if there is no group with the name
+func (c *Client) FindGroupByNameInHierarchy(ctx context.Context, groupName, realmName string) (*Group, error) {
+	groups, err := listAllGroups(func(first int) ([]*Group, error) {
+		return c.ListGroups(ctx, realmName, "", first, pageSize)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var findInList func([]*Group) (*Group, error)
+	findInList = func(groupList []*Group) (*Group, error) {
+		for _, group := range groupList {
+			if group.Name == groupName {
+				return group, nil
+			}
+
+			children := group.SubGroups
+			if len(children) == 0 && group.SubGroupCount > 0 {
+				fetched, err := c.listAllChildGroups(ctx, group.ID, realmName, true)
+				if err != nil {
+					return nil, err
+				}
+				children = fetched
+			}
+
+			childGroup, err := findInList(children)
+			if err != nil || childGroup != nil {
+				return childGroup, err
+			}
+		}
+
+		return nil, nil
+	}
+
+	return findInList(groups)
+}
+
+func listAllGroups(listPage func(first int) ([]*Group, error)) ([]*Group, error) {
+	groups := []*Group{}
+	for first := 0; ; first += pageSize {
+		page, err := listPage(first)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, page...)
+		if len(page) < pageSize {
+			return groups, nil
+		}
+	}
+}
+
+// GetGroupHierarchy returns the full group tree of the realm. Keycloak 23+ no
+// longer returns the subgroups inline, they're fetched per group in that case
+// so SubGroups is populated regardless of the server version
+func (c *Client) GetGroupHierarchy(ctx context.Context, realmName string) ([]*Group, error) {
+	groups, err := listAllGroups(func(first int) ([]*Group, error) {
+		return c.listGroupsPage(ctx, fmt.Sprintf("realms/%s/groups", realmName), first, pageSize, false)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var populate func([]*Group) error
+	populate = func(groupList []*Group) error {
+		for _, group := range groupList {
+			if len(group.SubGroups) == 0 && group.SubGroupCount > 0 {
+				children, err := c.listAllChildGroups(ctx, group.ID, realmName, false)
+				if err != nil {
+					return err
+				}
+				group.SubGroups = children
+			}
+
+			if err := populate(group.SubGroups); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := populate(groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (c *Client) listAllChildGroups(ctx context.Context, groupID, realmName string, briefRepresentation bool) ([]*Group, error) {
+	return listAllGroups(func(first int) ([]*Group, error) {
+		return c.listGroupsPage(ctx, fmt.Sprintf("realms/%s/groups/%s/children", realmName, groupID), first, pageSize, briefRepresentation)
+	})
+}
+
+func (c *Client) listGroupsPage(ctx context.Context, resourcePath string, first, max int, briefRepresentation bool) ([]*Group, error) {
+	query := url.Values{}
+	query.Set("first", strconv.Itoa(first))
+	query.Set("max", strconv.Itoa(max))
+	query.Set("briefRepresentation", strconv.FormatBool(briefRepresentation))
+	result, err := c.list(ctx, fmt.Sprintf("%s?%s", resourcePath, query.Encode()), "groups", func(body []byte) (T, error) {
+		var groups []*Group
+		err := json.Unmarshal(body, &groups)
+		return groups, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*Group), nil
+}
+
+// GetGroupByPath returns the group at the given slash separated path, e.g.
+// "/engineering/platform/sre", or ErrNotFound if there is no group at that
+// path
+func (c *Client) GetGroupByPath(ctx context.Context, path, realmName string) (*Group, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	result, err := c.get(ctx, fmt.Sprintf("realms/%s/group-by-path/%s", realmName, strings.Join(segments, "/")), "group", func(body []byte) (T, error) {
+		group := &Group{}
+		err := json.Unmarshal(body, group)
+		return group, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*Group), nil
+}
+
+// ResolveGroupPath returns the ID of the group at the given slash separated
+// path, e.g. "/org/team/subteam". Missing groups along the path are created if
+// createMissing is set, otherwise ErrNotFound is returned
+func (c *Client) ResolveGroupPath(ctx context.Context, path string, realmName string, createMissing bool) (string, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if segments[0] == "" {
+		return "", errors.New("group path must be set")
+	}
+
+	parentID := ""
+	for i, segment := range segments {
+		segmentPath := "/" + strings.Join(segments[:i+1], "/")
+		group, err := c.GetGroupByPath(ctx, segmentPath, realmName)
+		if err == nil {
+			parentID = group.ID
+			continue
+		}
+		if !stderrors.Is(err, ErrNotFound) || !createMissing {
+			return "", err
+		}
+
+		var groupID string
+		if parentID == "" {
+			groupID, err = c.CreateGroup(ctx, segment, realmName)
+		} else {
+			groupID, err = c.CreateChildGroup(ctx, parentID, segment, realmName)
+		}
+		if stderrors.Is(err, ErrAlreadyExists) {
+			// Someone else created the group since we looked it up
+			group, err = c.GetGroupByPath(ctx, segmentPath, realmName)
+			if stderrors.Is(err, ErrNotFound) {
+				return "", errors.Errorf("group %s already exists but can't be found", segmentPath)
+			}
+			if err != nil {
+				return "", err
+			}
+			groupID = group.ID
+		} else if err != nil {
+			return "", err
+		}
+		parentID = groupID
+	}
+
+	return parentID, nil
 }
 
-func (c *Client) CreateGroup(groupName string, realmName string) (string, error) {
+func (c *Client) CreateGroup(ctx context.Context, groupName string, realmName string) (string, error) {
 	group := Group{
 		Name: groupName,
 	}
 
-	// Create the new group
-	return c.create(group, fmt.Sprintf("realms/%s/groups", realmName), "group")
+	// Create the new group, Keycloak returns its ID in the Location header
+	groupID, err := c.create(ctx, group, fmt.Sprintf("realms/%s/groups", realmName), "group")
+	if err != nil || groupID != "" {
+		return groupID, err
+	}
+
+	// Fall back to looking the group up when the header is missing
+	groups, err := c.ListGroups(ctx, realmName, groupName, 0, 0)
+	if err != nil {
+		return "", err
+	}
+	for _, existing := range groups {
+		if existing.Name == groupName {
+			return existing.ID, nil
+		}
+	}
+	return "", errors.Errorf("created group %s but can't find it", groupName)
+}
+
+// DeleteGroup removes the group and its subgroups, ErrNotFound is returned if
+// the group doesn't exist
+func (c *Client) DeleteGroup(ctx context.Context, groupID, realmName string) error {
+	return c.deleteExisting(ctx, fmt.Sprintf("realms/%s/groups/%s", realmName, groupID), "group", nil)
 }
 
-func (c *Client) MakeGroupDefault(groupID string, realmName string) error {
+func (c *Client) MakeGroupDefault(ctx context.Context, groupID string, realmName string) error {
 	// Get the existing default groups to check if the group is already
 	// default
-	defaultGroups, err := c.ListDefaultGroups(realmName)
+	defaultGroups, err := c.ListDefaultGroups(ctx, realmName)
 
 	if err != nil {
 		return err
@@ -762,11 +3692,11 @@ func (c *Client) MakeGroupDefault(groupID string, realmName string) error {
 	}
 
 	// If not, perform the update
-	return c.update(nil, fmt.Sprintf("realms/%s/default-groups/%s", realmName, groupID), "Realms")
+	return c.update(ctx, nil, fmt.Sprintf("realms/%s/default-groups/%s", realmName, groupID), "Realms")
 }
 
-func (c *Client) ListDefaultGroups(realmName string) ([]*Group, error) {
-	groups, err := c.list(fmt.Sprintf("realms/%s/default-groups", realmName), "Default group", func(body []byte) (T, error) {
+func (c *Client) ListDefaultGroups(ctx context.Context, realmName string) ([]*Group, error) {
+	groups, err := c.list(ctx, fmt.Sprintf("realms/%s/default-groups", realmName), "Default group", func(body []byte) (T, error) {
 		var groups []*Group
 		err := json.Unmarshal(body, &groups)
 		return groups, err
@@ -779,9 +3709,10 @@ func (c *Client) ListDefaultGroups(realmName string) ([]*Group, error) {
 	return groups.([]*Group), nil
 }
 
-func (c *Client) SetGroupChild(groupID, realmName string, childGroup *Group) error {
+func (c *Client) SetGroupChild(ctx context.Context, groupID, realmName string, childGroup *Group) error {
 	// Get the parent group
 	parentGroup, err := c.get(
+		ctx,
 		fmt.Sprintf("realms/%s/groups/%s", realmName, groupID),
 		"group",
 		func(body []byte) (T, error) {
@@ -805,6 +3736,7 @@ func (c *Client) SetGroupChild(groupID, realmName string, childGroup *Group) err
 
 	// Otherwise, set the child group
 	_, err = c.create(
+		ctx,
 		childGroup,
 		fmt.Sprintf("realms/%s/groups/%s/children", realmName, groupID),
 		"group-child",
@@ -812,17 +3744,38 @@ func (c *Client) SetGroupChild(groupID, realmName string, childGroup *Group) err
 	return err
 }
 
-func (c *Client) CreateGroupClientRole(role *v1alpha1.KeycloakUserRole, realmName, clientID, groupID string) (string, error) {
+// CreateChildGroup creates a new group under the given parent group and
+// returns its ID, ErrAlreadyExists is returned if the parent already has a
+// child with that name
+func (c *Client) CreateChildGroup(ctx context.Context, parentGroupID, name, realmName string) (string, error) {
+	group := Group{
+		Name: name,
+	}
+
+	return c.create(ctx, group, fmt.Sprintf("realms/%s/groups/%s/children", realmName, parentGroupID), "group-child")
+}
+
+func (c *Client) CreateGroupClientRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, clientID, groupID string) (string, error) {
 	return c.create(
+		ctx,
 		[]*v1alpha1.KeycloakUserRole{role},
 		fmt.Sprintf("realms/%s/groups/%s/role-mappings/clients/%s", realmName, groupID, clientID),
 		"group-client-role",
 	)
 }
 
-func (c *Client) ListAvailableGroupClientRoles(realmName, clientID, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
+func (c *Client) DeleteGroupClientRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, clientID, groupID string) error {
+	return c.delete(
+		ctx,
+		fmt.Sprintf("realms/%s/groups/%s/role-mappings/clients/%s", realmName, groupID, clientID),
+		"group-client-role",
+		[]*v1alpha1.KeycloakUserRole{role},
+	)
+}
+
+func (c *Client) ListAvailableGroupClientRoles(ctx context.Context, realmName, clientID, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
 	path := fmt.Sprintf("realms/%s/groups/%s/role-mappings/clients/%s/available", realmName, groupID, clientID)
-	objects, err := c.list(path, "groupRealmRoles", func(body []byte) (t T, e error) {
+	objects, err := c.list(ctx, path, "groupRealmRoles", func(body []byte) (t T, e error) {
 		var groupClientRoles []*v1alpha1.KeycloakUserRole
 		err := json.Unmarshal(body, &groupClientRoles)
 		return groupClientRoles, err
@@ -836,8 +3789,8 @@ func (c *Client) ListAvailableGroupClientRoles(realmName, clientID, groupID stri
 	return objects.([]*v1alpha1.KeycloakUserRole), err
 }
 
-func (c *Client) FindAvailableGroupClientRole(realmName, clientID, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error) {
-	availableRoles, err := c.ListAvailableGroupClientRoles(realmName, clientID, groupID)
+func (c *Client) FindAvailableGroupClientRole(ctx context.Context, realmName, clientID, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error) {
+	availableRoles, err := c.ListAvailableGroupClientRoles(ctx, realmName, clientID, groupID)
 
 	if err != nil {
 		return nil, err
@@ -852,9 +3805,9 @@ func (c *Client) FindAvailableGroupClientRole(realmName, clientID, groupID strin
 	return nil, nil
 }
 
-func (c *Client) ListGroupClientRoles(realmName, clientID, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
+func (c *Client) ListGroupClientRoles(ctx context.Context, realmName, clientID, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
 	path := fmt.Sprintf("realms/%s/groups/%s/role-mappings/clients/%s", realmName, groupID, clientID)
-	objects, err := c.list(path, "groupClientRoles", func(body []byte) (t T, e error) {
+	objects, err := c.list(ctx, path, "groupClientRoles", func(body []byte) (t T, e error) {
 		var groupClientRoles []*v1alpha1.KeycloakUserRole
 		err := json.Unmarshal(body, &groupClientRoles)
 		return groupClientRoles, err
@@ -868,8 +3821,8 @@ func (c *Client) ListGroupClientRoles(realmName, clientID, groupID string) ([]*v
 	return objects.([]*v1alpha1.KeycloakUserRole), err
 }
 
-func (c *Client) FindGroupClientRole(realmName, clientID, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error) {
-	groupRoles, err := c.ListGroupClientRoles(realmName, clientID, groupID)
+func (c *Client) FindGroupClientRole(ctx context.Context, realmName, clientID, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error) {
+	groupRoles, err := c.ListGroupClientRoles(ctx, realmName, clientID, groupID)
 
 	if err != nil {
 		return nil, err
@@ -884,17 +3837,18 @@ func (c *Client) FindGroupClientRole(realmName, clientID, groupID string, predic
 	return nil, nil
 }
 
-func (c *Client) CreateGroupRealmRole(role *v1alpha1.KeycloakUserRole, realmName, groupID string) (string, error) {
+func (c *Client) CreateGroupRealmRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, groupID string) (string, error) {
 	return c.create(
+		ctx,
 		[]*v1alpha1.KeycloakUserRole{role},
 		fmt.Sprintf("realms/%s/groups/%s/role-mappings/realm", realmName, groupID),
 		"group-realm-role",
 	)
 }
 
-func (c *Client) ListGroupRealmRoles(realmName, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
+func (c *Client) ListGroupRealmRoles(ctx context.Context, realmName, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
 	path := fmt.Sprintf("realms/%s/groups/%s/role-mappings/realm", realmName, groupID)
-	objects, err := c.list(path, "groupRealmRoles", func(body []byte) (t T, e error) {
+	objects, err := c.list(ctx, path, "groupRealmRoles", func(body []byte) (t T, e error) {
 		var groupRealmRoles []*v1alpha1.KeycloakUserRole
 		err := json.Unmarshal(body, &groupRealmRoles)
 		return groupRealmRoles, err
@@ -908,31 +3862,72 @@ func (c *Client) ListGroupRealmRoles(realmName, groupID string) ([]*v1alpha1.Key
 	return objects.([]*v1alpha1.KeycloakUserRole), err
 }
 
-func (c *Client) ListAvailableGroupRealmRoles(realmName, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
+func (c *Client) ListAvailableGroupRealmRoles(ctx context.Context, realmName, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
 	path := fmt.Sprintf("realms/%s/groups/%s/role-mappings/realm/available", realmName, groupID)
-	objects, err := c.list(path, "groupClientRoles", func(body []byte) (t T, e error) {
-		var groupRealmRoles []*v1alpha1.KeycloakUserRole
+	objects, err := c.list(ctx, path, "availableGroupRealmRoles", func(body []byte) (t T, e error) {
+		groupRealmRoles := []*v1alpha1.KeycloakUserRole{}
 		err := json.Unmarshal(body, &groupRealmRoles)
+		if groupRealmRoles == nil {
+			groupRealmRoles = []*v1alpha1.KeycloakUserRole{}
+		}
 		return groupRealmRoles, err
 	})
 	if err != nil {
 		return nil, err
 	}
-	if objects == nil {
-		return nil, nil
+	return objects.([]*v1alpha1.KeycloakUserRole), nil
+}
+
+// GetServerInfo returns the version of the Keycloak server and the themes and
+// providers installed on it
+func (c *Client) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
+	result, err := c.get(ctx, "serverinfo", "server info", func(body []byte) (T, error) {
+		info := &ServerInfo{}
+		if err := json.Unmarshal(body, info); err != nil {
+			return nil, err
+		}
+
+		providers := struct {
+			Providers map[string]struct {
+				Providers map[string]ProviderInfo `json:"providers"`
+			} `json:"providers"`
+		}{}
+		if err := json.Unmarshal(body, &providers); err != nil {
+			return nil, err
+		}
+
+		info.ProviderTypes = map[string][]ProviderInfo{}
+		for spi, spiInfo := range providers.Providers {
+			infos := []ProviderInfo{}
+			for id, provider := range spiInfo.Providers {
+				provider.ID = id
+				infos = append(infos, provider)
+			}
+			sort.Slice(infos, func(i, j int) bool {
+				return infos[i].ID < infos[j].ID
+			})
+			info.ProviderTypes[spi] = infos
+		}
+		return info, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return objects.([]*v1alpha1.KeycloakUserRole), err
+	if result == nil {
+		return nil, ErrNotFound
+	}
+	return result.(*ServerInfo), nil
 }
 
-func (c *Client) Ping() error {
+func (c *Client) Ping(ctx context.Context) error {
 	u := c.URL + "/auth/"
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		logrus.Errorf("error creating ping request %+v", err)
 		return errors.Wrap(err, "error creating ping request")
 	}
 
-	res, err := c.requester.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		logrus.Errorf("error on request %+v", err)
 		return errors.Wrapf(err, "error performing ping request")
@@ -940,22 +3935,58 @@ func (c *Client) Ping() error {
 
 	logrus.Debugf("response status: %v, %v", res.StatusCode, res.Status)
 	if res.StatusCode != 200 {
-		return fmt.Errorf("failed to ping, response status code: %v", res.StatusCode)
+		defer res.Body.Close()
+		return newAPIError(res, "failed to ping")
 	}
 	defer res.Body.Close()
 
 	return nil
 }
 
-// login requests a new auth token from Keycloak
-func (c *Client) login(user, pass string) error {
+// login requests a new auth token from Keycloak, the credentials are kept to
+// log in again when the token is about to expire
+func (c *Client) login(ctx context.Context, user, pass string) error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	c.username = user
+	c.password = pass
+	return c.requestToken(ctx)
+}
+
+// authorize sets the auth token on the request, logging in again first when
+// the token expires within the grace period
+func (c *Client) authorize(ctx context.Context, req *http.Request) error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	gracePeriod := c.tokenRefreshGracePeriod
+	if gracePeriod == 0 {
+		gracePeriod = defaultTokenRefreshGracePeriod
+	}
+	// Log in on first use, or when the token is about to expire
+	expiring := !c.tokenExpiry.IsZero() && time.Now().Add(gracePeriod).After(c.tokenExpiry)
+	if c.username != "" && (c.token == "" || expiring) {
+		if err := c.requestToken(ctx); err != nil {
+			return fmt.Errorf("error logging in: %w", err)
+		}
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	return nil
+}
+
+// requestToken requests an auth token for the stored credentials, tokenMu
+// must be held by the caller
+func (c *Client) requestToken(ctx context.Context) error {
 	form := url.Values{}
-	form.Add("username", user)
-	form.Add("password", pass)
+	form.Add("username", c.username)
+	form.Add("password", c.password)
 	form.Add("client_id", "admin-cli")
 	form.Add("grant_type", "password")
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		fmt.Sprintf("%s/%s", c.URL, authURL),
 		strings.NewReader(form.Encode()),
@@ -965,7 +3996,7 @@ func (c *Client) login(user, pass string) error {
 	}
 
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-	res, err := c.requester.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		logrus.Errorf("error on request %+v", err)
 		return errors.Wrap(err, "error performing token request")
@@ -985,111 +4016,273 @@ func (c *Client) login(user, pass string) error {
 
 	if tokenRes.Error != "" {
 		logrus.Errorf("error with request: " + tokenRes.ErrorDescription)
-		return errors.New(tokenRes.ErrorDescription)
+		return &APIError{
+			StatusCode: res.StatusCode,
+			Body:       string(body),
+			Message:    tokenRes.ErrorDescription,
+		}
 	}
 
 	c.token = tokenRes.AccessToken
+	c.refreshToken = tokenRes.RefreshToken
+	c.tokenExpiry = time.Time{}
+	if tokenRes.ExpiresIn > 0 {
+		c.tokenExpiry = time.Now().Add(time.Duration(tokenRes.ExpiresIn) * time.Second)
+	}
 
 	return nil
 }
 
-// defaultRequester returns a default client for requesting http endpoints
-func defaultRequester() Requester {
-	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // nolint
-	}
-	c := &http.Client{Transport: transport, Timeout: time.Second * 10}
-	return c
-}
-
 //go:generate moq -out keycloakClient_moq.go . KeycloakInterface
 
+// KeycloakInterface declares all the methods of Client so callers can depend
+// on it and use the generated KeycloakInterfaceMock in their tests
 type KeycloakInterface interface {
-	Ping() error
-
-	CreateRealm(realm *v1alpha1.KeycloakRealm) (string, error)
-	GetRealm(realmName string) (*v1alpha1.KeycloakRealm, error)
-	UpdateRealm(specRealm *v1alpha1.KeycloakRealm) error
-	DeleteRealm(realmName string) error
-	ListRealms() ([]*v1alpha1.KeycloakAPIRealm, error)
-
-	CreateClient(client *v1alpha1.KeycloakAPIClient, realmName string) (string, error)
-	GetClient(clientID, realmName string) (*v1alpha1.KeycloakAPIClient, error)
-	GetClientSecret(clientID, realmName string) (string, error)
-	GetClientInstall(clientID, realmName string) ([]byte, error)
-	UpdateClient(specClient *v1alpha1.KeycloakAPIClient, realmName string) error
-	DeleteClient(clientID, realmName string) error
-	ListClients(realmName string) ([]*v1alpha1.KeycloakAPIClient, error)
-
-	CreateUser(user *v1alpha1.KeycloakAPIUser, realmName string) (string, error)
-	CreateFederatedIdentity(fid v1alpha1.FederatedIdentity, userID string, realmName string) (string, error)
-	RemoveFederatedIdentity(fid v1alpha1.FederatedIdentity, userID string, realmName string) error
-	GetUserFederatedIdentities(userName string, realmName string) ([]v1alpha1.FederatedIdentity, error)
-	UpdatePassword(user *v1alpha1.KeycloakAPIUser, realmName, newPass string) error
-	FindUserByEmail(email, realm string) (*v1alpha1.KeycloakAPIUser, error)
-	FindUserByUsername(name, realm string) (*v1alpha1.KeycloakAPIUser, error)
-	GetUser(userID, realmName string) (*v1alpha1.KeycloakAPIUser, error)
-	UpdateUser(specUser *v1alpha1.KeycloakAPIUser, realmName string) error
-	DeleteUser(userID, realmName string) error
-	ListUsers(realmName string) ([]*v1alpha1.KeycloakAPIUser, error)
-	ListUsersInGroup(realmName, groupID string) ([]*v1alpha1.KeycloakAPIUser, error)
-	AddUserToGroup(realmName, userID, groupID string) error
-	DeleteUserFromGroup(realmName, userID, groupID string) error
-
-	FindGroupByName(groupName string, realmName string) (*Group, error)
-	CreateGroup(group string, realmName string) (string, error)
-	MakeGroupDefault(groupID string, realmName string) error
-	ListDefaultGroups(realmName string) ([]*Group, error)
-	SetGroupChild(groupID, realmName string, childGroup *Group) error
-
-	CreateGroupClientRole(role *v1alpha1.KeycloakUserRole, realmName, clientID, groupID string) (string, error)
-	ListGroupClientRoles(realmName, clientID, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
-	FindGroupClientRole(realmName, clientID, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error)
-	ListAvailableGroupClientRoles(realmName, clientID, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
-	FindAvailableGroupClientRole(realmName, clientID, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error)
-
-	CreateGroupRealmRole(role *v1alpha1.KeycloakUserRole, realmName, groupID string) (string, error)
-	ListGroupRealmRoles(realmName, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
-	ListAvailableGroupRealmRoles(realmName, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
-
-	CreateIdentityProvider(identityProvider *v1alpha1.KeycloakIdentityProvider, realmName string) (string, error)
-	GetIdentityProvider(alias, realmName string) (*v1alpha1.KeycloakIdentityProvider, error)
-	UpdateIdentityProvider(specIdentityProvider *v1alpha1.KeycloakIdentityProvider, realmName string) error
-	DeleteIdentityProvider(alias, realmName string) error
-	ListIdentityProviders(realmName string) ([]*v1alpha1.KeycloakIdentityProvider, error)
-
-	CreateUserClientRole(role *v1alpha1.KeycloakUserRole, realmName, clientID, userID string) (string, error)
-	ListUserClientRoles(realmName, clientID, userID string) ([]*v1alpha1.KeycloakUserRole, error)
-	ListAvailableUserClientRoles(realmName, clientID, userID string) ([]*v1alpha1.KeycloakUserRole, error)
-	DeleteUserClientRole(role *v1alpha1.KeycloakUserRole, realmName, clientID, userID string) error
-
-	CreateUserRealmRole(role *v1alpha1.KeycloakUserRole, realmName, userID string) (string, error)
-	ListUserRealmRoles(realmName, userID string) ([]*v1alpha1.KeycloakUserRole, error)
-	ListAvailableUserRealmRoles(realmName, userID string) ([]*v1alpha1.KeycloakUserRole, error)
-	DeleteUserRealmRole(role *v1alpha1.KeycloakUserRole, realmName, userID string) error
-
-	ListAuthenticationExecutionsForFlow(flowAlias, realmName string) ([]*v1alpha1.AuthenticationExecutionInfo, error)
-	FindAuthenticationExecutionForFlow(flowAlias, realmName string, predicate func(*v1alpha1.AuthenticationExecutionInfo) bool) (*v1alpha1.AuthenticationExecutionInfo, error)
-	UpdateAuthenticationExecutionForFlow(flowAlias, realmName string, execution *v1alpha1.AuthenticationExecutionInfo) error
-
-	CreateAuthenticatorConfig(authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName, executionID string) (string, error)
-	GetAuthenticatorConfig(configID, realmName string) (*v1alpha1.AuthenticatorConfig, error)
-	UpdateAuthenticatorConfig(authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string) error
-	DeleteAuthenticatorConfig(configID, realmName string) error
+	Ping(ctx context.Context) error
+	GetServerInfo(ctx context.Context) (*ServerInfo, error)
+
+	CreateRealm(ctx context.Context, realm *v1alpha1.KeycloakRealm) (string, error)
+	GetRealm(ctx context.Context, realmName string) (*v1alpha1.KeycloakRealm, error)
+	UpdateRealm(ctx context.Context, specRealm *v1alpha1.KeycloakRealm) error
+	PatchRealm(ctx context.Context, realmName string, patch map[string]interface{}) error
+	GetRealmAttribute(ctx context.Context, realmName, key string) (string, bool, error)
+	SetRealmAttribute(ctx context.Context, realmName, key, value string) error
+	ListRealmKeys(ctx context.Context, realmName string) (*RealmKeyMetadata, error)
+	GetWellKnownConfiguration(ctx context.Context, realmName string) (*OIDCConfiguration, error)
+	GetJWKS(ctx context.Context, realmName string) (*JWKS, error)
+	IntrospectToken(ctx context.Context, realmName, clientID, clientSecret, token string) (*TokenIntrospection, error)
+	ExchangeToken(ctx context.Context, realmName, subjectToken, audience, clientID, clientSecret string) (*TokenResponse, error)
+	RefreshToken(ctx context.Context, realmName, clientID, clientSecret, refreshToken string) (*TokenResponse, error)
+	RevokeToken(ctx context.Context, realmName, clientID, clientSecret, token, tokenTypeHint string) error
+	DeleteRealm(ctx context.Context, realmName string) error
+	ListRealms(ctx context.Context) ([]*v1alpha1.KeycloakAPIRealm, error)
+	ListRealmEvents(ctx context.Context, realmName string, params *EventListParams) ([]*RealmEvent, error)
+	ClearRealmEvents(ctx context.Context, realmName string) error
+	ListAdminEvents(ctx context.Context, realmName string, params *AdminEventListParams) ([]*AdminEvent, error)
+	ClearAdminEvents(ctx context.Context, realmName string) error
+	GetRealmEventsConfig(ctx context.Context, realmName string) (*RealmEventsConfig, error)
+	UpdateRealmEventsConfig(ctx context.Context, realmName string, config *RealmEventsConfig) error
+
+	CreateClient(ctx context.Context, client *v1alpha1.KeycloakAPIClient, realmName string) (string, error)
+	GetClient(ctx context.Context, clientID, realmName string) (*v1alpha1.KeycloakAPIClient, error)
+	GetClientSecret(ctx context.Context, clientID, realmName string) (string, error)
+	RegenerateClientSecret(ctx context.Context, clientID, realmName string) (string, error)
+	GetRotatedClientSecret(ctx context.Context, clientID, realmName string) (string, error)
+	InvalidateRotatedClientSecret(ctx context.Context, clientID, realmName string) error
+	RegenerateClientRegistrationToken(ctx context.Context, clientID, realmName string) (string, error)
+	PushClientRevocation(ctx context.Context, clientID, realmName string) (*GlobalRequestResult, error)
+	RegisterClientNode(ctx context.Context, clientID, realmName, nodeHost string) error
+	UnregisterClientNode(ctx context.Context, clientID, realmName, nodeHost string) error
+	TestClientNodesAvailable(ctx context.Context, clientID, realmName string) (*GlobalRequestResult, error)
+	GetClientServiceAccountUser(ctx context.Context, clientID, realmName string) (*v1alpha1.KeycloakAPIUser, error)
+	GetClientInstallationProvider(ctx context.Context, clientID, realmName, providerID string) (*ClientInstallation, error)
+	GetClientCertificate(ctx context.Context, clientID, realmName, attr string) (*Certificate, error)
+	GenerateClientCertificate(ctx context.Context, clientID, realmName, attr string) (*Certificate, error)
+	UploadClientCertificate(ctx context.Context, clientID, realmName, attr string, config *KeyStoreConfig, data []byte) (*Certificate, error)
+	DownloadClientKeystore(ctx context.Context, clientID, realmName, attr string, config *KeyStoreConfig) ([]byte, error)
+	CreateClientRole(ctx context.Context, clientID, realmName string, role *v1alpha1.KeycloakUserRole) error
+	ListClientRoles(ctx context.Context, clientID, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
+	ListUsersInClientRole(ctx context.Context, clientID, roleName, realmName string, first, max int) ([]*v1alpha1.KeycloakAPIUser, error)
+	GetClientRoleByName(ctx context.Context, clientID, roleName, realmName string) (*v1alpha1.KeycloakUserRole, error)
+	UpdateClientRole(ctx context.Context, clientID, realmName string, role *v1alpha1.KeycloakUserRole) error
+	DeleteClientRole(ctx context.Context, clientID, roleName, realmName string) error
+	AddCompositesToClientRole(ctx context.Context, clientID, roleName, realmName string, composites []*v1alpha1.KeycloakUserRole) error
+	RemoveCompositesFromClientRole(ctx context.Context, clientID, roleName, realmName string, composites []*v1alpha1.KeycloakUserRole) error
+	ListClientRoleComposites(ctx context.Context, clientID, roleName, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
+	ListClientRoleRealmComposites(ctx context.Context, clientID, roleName, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
+	ListClientRoleClientComposites(ctx context.Context, clientID, roleName, otherClientID, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
+	GetClientInstall(ctx context.Context, clientID, realmName string) ([]byte, error)
+	UpdateClient(ctx context.Context, specClient *v1alpha1.KeycloakAPIClient, realmName string) error
+	DeleteClient(ctx context.Context, clientID, realmName string) error
+	ListClients(ctx context.Context, realmName string) ([]*v1alpha1.KeycloakAPIClient, error)
+	ListClientsWithParams(ctx context.Context, realmName string, params *ClientListParams) ([]*v1alpha1.KeycloakAPIClient, error)
+	FindClientByClientID(ctx context.Context, clientID, realmName string) (*v1alpha1.KeycloakAPIClient, error)
+
+	CreateClientScope(ctx context.Context, scope *ClientScope, realmName string) error
+	GetClientScope(ctx context.Context, scopeID, realmName string) (*ClientScope, error)
+	UpdateClientScope(ctx context.Context, scope *ClientScope, realmName string) error
+	DeleteClientScope(ctx context.Context, scopeID, realmName string) error
+	AssignDefaultClientScopeToClient(ctx context.Context, clientID, scopeID, realmName string) error
+	AssignOptionalClientScopeToClient(ctx context.Context, clientID, scopeID, realmName string) error
+	RemoveDefaultClientScopeFromClient(ctx context.Context, clientID, scopeID, realmName string) error
+	RemoveOptionalClientScopeFromClient(ctx context.Context, clientID, scopeID, realmName string) error
+	ListDefaultClientScopesForClient(ctx context.Context, clientID, realmName string) ([]*ClientScope, error)
+	ListOptionalClientScopesForClient(ctx context.Context, clientID, realmName string) ([]*ClientScope, error)
+	ListClientScopes(ctx context.Context, realmName string) ([]*ClientScope, error)
+	ListProtocolMappersForClient(ctx context.Context, clientID, realmName string) ([]*ProtocolMapper, error)
+	ListEvaluatedProtocolMappers(ctx context.Context, clientID, realmName, scope string) ([]*ProtocolMapperEvaluation, error)
+	GenerateExampleAccessToken(ctx context.Context, clientID, realmName, userID, scope string) (string, error)
+	CreateProtocolMapperForClient(ctx context.Context, clientID, realmName string, mapper *ProtocolMapper) error
+	UpdateProtocolMapperForClient(ctx context.Context, clientID, realmName string, mapper *ProtocolMapper) error
+	DeleteProtocolMapperForClient(ctx context.Context, clientID, mapperID, realmName string) error
+	CreateProtocolMapperForClientScope(ctx context.Context, scopeID, realmName string, mapper *ProtocolMapper) error
+	ListProtocolMappersForClientScope(ctx context.Context, scopeID, realmName string) ([]*ProtocolMapper, error)
+	UpdateProtocolMapperForClientScope(ctx context.Context, scopeID, realmName string, mapper *ProtocolMapper) error
+	ListClientScopeRealmScopeMappings(ctx context.Context, scopeID, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
+	ListAvailableClientScopeRealmScopeMappings(ctx context.Context, scopeID, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
+	AddClientScopeRealmScopeMappings(ctx context.Context, scopeID, realmName string, roles []*v1alpha1.KeycloakUserRole) error
+	DeleteClientScopeRealmScopeMappings(ctx context.Context, scopeID, realmName string, roles []*v1alpha1.KeycloakUserRole) error
+	ListClientScopeClientScopeMappings(ctx context.Context, scopeID, targetClientID, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
+	ListAvailableClientScopeClientScopeMappings(ctx context.Context, scopeID, targetClientID, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
+	AddClientScopeClientScopeMappings(ctx context.Context, scopeID, targetClientID, realmName string, roles []*v1alpha1.KeycloakUserRole) error
+	DeleteClientScopeClientScopeMappings(ctx context.Context, scopeID, targetClientID, realmName string, roles []*v1alpha1.KeycloakUserRole) error
+	DeleteProtocolMapperForClientScope(ctx context.Context, scopeID, mapperID, realmName string) error
+
+	CreateUser(ctx context.Context, user *v1alpha1.KeycloakAPIUser, realmName string) (string, error)
+	BulkCreateUsers(ctx context.Context, users []*v1alpha1.KeycloakAPIUser, realmName string, concurrency int) []error
+	CreateFederatedIdentity(ctx context.Context, fid v1alpha1.FederatedIdentity, userID string, realmName string) (string, error)
+	RemoveFederatedIdentity(ctx context.Context, fid v1alpha1.FederatedIdentity, userID string, realmName string) error
+	GetUserFederatedIdentities(ctx context.Context, userName string, realmName string) ([]v1alpha1.FederatedIdentity, error)
+	UnlinkUserFromIdP(ctx context.Context, userID, realmName, providerAlias string) error
+	UnlinkAllUsersFromIdP(ctx context.Context, realmName, providerAlias string, concurrency int) (int, error)
+	UpdatePassword(ctx context.Context, user *v1alpha1.KeycloakAPIUser, realmName, newPass string) error
+	SetTemporaryPassword(ctx context.Context, userID, realmName, password string) error
+	FindUserByEmail(ctx context.Context, email, realm string) (*v1alpha1.KeycloakAPIUser, error)
+	FindUserByUsername(ctx context.Context, name, realm string) (*v1alpha1.KeycloakAPIUser, error)
+	GetUserByFederatedIdentity(ctx context.Context, realmName, providerAlias, externalUserID string) (*v1alpha1.KeycloakAPIUser, error)
+	GetUser(ctx context.Context, userID, realmName string) (*v1alpha1.KeycloakAPIUser, error)
+	UpdateUser(ctx context.Context, specUser *v1alpha1.KeycloakAPIUser, realmName string) error
+	DeleteUser(ctx context.Context, userID, realmName string) error
+	ListUsers(ctx context.Context, realmName string) ([]*v1alpha1.KeycloakAPIUser, error)
+	ListUsersInGroup(ctx context.Context, realmName, groupID string) ([]*v1alpha1.KeycloakAPIUser, error)
+	GetGroupMembers(ctx context.Context, groupID, realmName string, first, max int) ([]*v1alpha1.KeycloakAPIUser, error)
+	GetAllGroupMembers(ctx context.Context, groupID, realmName string, briefRepresentation bool) ([]*v1alpha1.KeycloakAPIUser, error)
+	AddUserToGroup(ctx context.Context, realmName, userID, groupID string) error
+	DeleteUserFromGroup(ctx context.Context, realmName, userID, groupID string) error
+
+	ListGroups(ctx context.Context, realmName string, search string, first, max int) ([]*Group, error)
+	CountGroups(ctx context.Context, realmName string, search string, topLevelOnly bool) (int, error)
+	FindGroupByName(ctx context.Context, groupName string, realmName string) (*Group, error)
+	FindClientScopeByName(ctx context.Context, name, realmName string) (*ClientScope, error)
+	CopyClientScope(ctx context.Context, sourceScopeID, newName, realmName string) (string, error)
+	FindGroupByNameInHierarchy(ctx context.Context, groupName, realmName string) (*Group, error)
+	GetGroupHierarchy(ctx context.Context, realmName string) ([]*Group, error)
+	GetGroupByPath(ctx context.Context, path, realmName string) (*Group, error)
+	ResolveGroupPath(ctx context.Context, path string, realmName string, createMissing bool) (string, error)
+	GetGroupManagementPermissions(ctx context.Context, groupID, realmName string) (*ManagementPermissionReference, error)
+	UpdateGroupManagementPermissions(ctx context.Context, groupID, realmName string, enabled bool) (*ManagementPermissionReference, error)
+	GetClientManagementPermissions(ctx context.Context, clientID, realmName string) (*ManagementPermissionReference, error)
+	UpdateClientManagementPermissions(ctx context.Context, clientID, realmName string, enabled bool) (*ManagementPermissionReference, error)
+	CreateGroup(ctx context.Context, group string, realmName string) (string, error)
+	DeleteGroup(ctx context.Context, groupID, realmName string) error
+	MakeGroupDefault(ctx context.Context, groupID string, realmName string) error
+	ListDefaultGroups(ctx context.Context, realmName string) ([]*Group, error)
+	SetGroupChild(ctx context.Context, groupID, realmName string, childGroup *Group) error
+	CreateChildGroup(ctx context.Context, parentGroupID, name, realmName string) (string, error)
+
+	CreateGroupClientRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, clientID, groupID string) (string, error)
+	DeleteGroupClientRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, clientID, groupID string) error
+	ListGroupClientRoles(ctx context.Context, realmName, clientID, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
+	FindGroupClientRole(ctx context.Context, realmName, clientID, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error)
+	ListAvailableGroupClientRoles(ctx context.Context, realmName, clientID, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
+	FindAvailableGroupClientRole(ctx context.Context, realmName, clientID, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error)
+
+	CreateGroupRealmRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, groupID string) (string, error)
+	ListGroupRealmRoles(ctx context.Context, realmName, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
+	ListAvailableGroupRealmRoles(ctx context.Context, realmName, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
+
+	CreateIdentityProvider(ctx context.Context, identityProvider *IdentityProvider, realmName string) error
+	GetIdentityProvider(ctx context.Context, alias, realmName string) (*IdentityProvider, error)
+	UpdateIdentityProvider(ctx context.Context, specIdentityProvider *IdentityProvider, realmName string) error
+	DeleteIdentityProvider(ctx context.Context, alias, realmName string) error
+	ListIdentityProviders(ctx context.Context, realmName string) ([]*IdentityProvider, error)
+	ListIdentityProviderMappers(ctx context.Context, alias, realmName string) ([]*IdentityProviderMapper, error)
+	CreateIdentityProviderMapper(ctx context.Context, alias, realmName string, mapper *IdentityProviderMapper) error
+	UpdateIdentityProviderMapper(ctx context.Context, alias, realmName string, mapper *IdentityProviderMapper) error
+	DeleteIdentityProviderMapper(ctx context.Context, alias, mapperID, realmName string) error
+
+	CreateUserClientRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, clientID, userID string) (string, error)
+	ListUserClientRoles(ctx context.Context, realmName, clientID, userID string) ([]*v1alpha1.KeycloakUserRole, error)
+	ListAvailableUserClientRoles(ctx context.Context, realmName, clientID, userID string) ([]*v1alpha1.KeycloakUserRole, error)
+	DeleteUserClientRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, clientID, userID string) error
+
+	CreateUserRealmRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, userID string) (string, error)
+	ListUserRealmRoles(ctx context.Context, realmName, userID string) ([]*v1alpha1.KeycloakUserRole, error)
+	ListUserSessions(ctx context.Context, userID, realmName string) ([]*UserSession, error)
+	DeleteUserSession(ctx context.Context, sessionID, realmName string) error
+	ListClientSessions(ctx context.Context, clientID, realmName string) ([]*UserSession, error)
+	ListClientUserSessions(ctx context.Context, clientID, realmName string, first, max int) ([]*UserSession, error)
+	CountClientSessions(ctx context.Context, clientID, realmName string) (int, error)
+	ListClientOfflineSessions(ctx context.Context, clientID, realmName string, first, max int) ([]*UserSession, error)
+	CountClientOfflineSessions(ctx context.Context, clientID, realmName string) (int, error)
+	ListOfflineSessionsForUser(ctx context.Context, userID, realmName string) ([]*UserSession, error)
+	RevokeOfflineSession(ctx context.Context, userID, clientID, realmName string) error
+	ListUserCredentials(ctx context.Context, userID, realmName string) ([]*Credential, error)
+	DeleteUserCredential(ctx context.Context, userID, credentialID, realmName string) error
+	UpdateCredentialLabel(ctx context.Context, userID, credentialID, label, realmName string) error
+	MoveCredentialToFirst(ctx context.Context, userID, credentialID, realmName string) error
+	MoveCredentialAfter(ctx context.Context, userID, credentialID, newPreviousCredentialID, realmName string) error
+	ListUserConsents(ctx context.Context, userID, realmName string) ([]*UserConsent, error)
+	RevokeUserConsent(ctx context.Context, userID, clientID, realmName string) error
+	GetBruteForceStatus(ctx context.Context, userID, realmName string) (*BruteForceStatus, error)
+	ClearBruteForceForUser(ctx context.Context, userID, realmName string) error
+	ListAvailableUserRealmRoles(ctx context.Context, realmName, userID string) ([]*v1alpha1.KeycloakUserRole, error)
+	DeleteUserRealmRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName, userID string) error
+
+	ListAuthenticationExecutionsForFlow(ctx context.Context, flowAlias, realmName string) ([]*v1alpha1.AuthenticationExecutionInfo, error)
+	FindAuthenticationExecutionForFlow(ctx context.Context, flowAlias, realmName string, predicate func(*v1alpha1.AuthenticationExecutionInfo) bool) (*v1alpha1.AuthenticationExecutionInfo, error)
+	UpdateAuthenticationExecutionForFlow(ctx context.Context, flowAlias, realmName string, execution *v1alpha1.AuthenticationExecutionInfo) error
+
+	CreateAuthenticatorConfig(ctx context.Context, authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName, executionID string) (string, error)
+	GetAuthenticatorConfig(ctx context.Context, configID, realmName string) (*v1alpha1.AuthenticatorConfig, error)
+	UpdateAuthenticatorConfig(ctx context.Context, authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string) error
+	DeleteAuthenticatorConfig(ctx context.Context, configID, realmName string) error
+
+	ListRequiredActions(ctx context.Context, realmName string) ([]*RequiredAction, error)
+	UpdateRequiredAction(ctx context.Context, alias, realmName string, action *RequiredAction) error
+	RegisterRequiredAction(ctx context.Context, realmName, providerID string) error
+	DeleteRequiredAction(ctx context.Context, alias, realmName string) error
+
+	CreateAuthzResource(ctx context.Context, clientID, realmName string, resource *AuthzResource) (string, error)
+	GetAuthzResource(ctx context.Context, clientID, resourceID, realmName string) (*AuthzResource, error)
+	ListAuthzResources(ctx context.Context, clientID, realmName string, params *AuthzResourceListParams) ([]*AuthzResource, error)
+	UpdateAuthzResource(ctx context.Context, clientID, realmName string, resource *AuthzResource) error
+	DeleteAuthzResource(ctx context.Context, clientID, resourceID, realmName string) error
+	CreateAuthzScope(ctx context.Context, clientID, realmName string, scope *AuthzScope) (string, error)
+	ListAuthzScopes(ctx context.Context, clientID, realmName string, params *AuthzScopeListParams) ([]*AuthzScope, error)
+	UpdateAuthzScope(ctx context.Context, clientID, realmName string, scope *AuthzScope) error
+	DeleteAuthzScope(ctx context.Context, clientID, scopeID, realmName string) error
+	CreateAuthzPolicy(ctx context.Context, clientID, realmName, policyType string, policy json.RawMessage) (*AuthzPolicy, error)
+	GetAuthzPolicy(ctx context.Context, clientID, policyID, realmName string) (*AuthzPolicy, error)
+	ListAuthzPolicies(ctx context.Context, clientID, realmName string) ([]*AuthzPolicy, error)
+	UpdateAuthzPolicy(ctx context.Context, clientID, realmName, policyType, policyID string, policy json.RawMessage) error
+	DeleteAuthzPolicy(ctx context.Context, clientID, policyID, realmName string) error
+	CreateAuthzResourcePermission(ctx context.Context, clientID, realmName string, permission *AuthzPermission) (string, error)
+	CreateAuthzScopePermission(ctx context.Context, clientID, realmName string, permission *AuthzPermission) (string, error)
+	ListAuthzPermissions(ctx context.Context, clientID, realmName string) ([]*AuthzPermission, error)
+	DeleteAuthzPermission(ctx context.Context, clientID, permissionID, realmName string) error
+	ListAuthzPermissionPolicies(ctx context.Context, clientID, permissionID, realmName string) ([]*AuthzPolicy, error)
+	ListAuthzPermissionResources(ctx context.Context, clientID, permissionID, realmName string) ([]*AuthzResource, error)
+	ListAuthzPermissionScopes(ctx context.Context, clientID, permissionID, realmName string) ([]*AuthzScope, error)
+	EvaluateAuthzPermissions(ctx context.Context, clientID, realmName string, evaluation *PolicyEvaluationRequest) (*PolicyEvaluationResponse, error)
+
+	ListComponents(ctx context.Context, realmName string, params *ComponentListParams) ([]*Component, error)
+	CreateComponent(ctx context.Context, component *Component, realmName string) error
+	UpdateComponent(ctx context.Context, component *Component, realmName string) error
+	DeleteComponent(ctx context.Context, componentID, realmName string) error
+	TriggerLDAPSync(ctx context.Context, componentID, realmName string, syncType LDAPSyncType) (*LDAPSyncResult, error)
 }
 
+// Ensure Client implements all the methods of KeycloakInterface
+var _ KeycloakInterface = &Client{}
+
 //go:generate moq -out keycloakClientFactory_moq.go . KeycloakClientFactory
 
 //KeycloakClientFactory interface
 type KeycloakClientFactory interface {
-	AuthenticatedClient(kc v1alpha1.Keycloak) (KeycloakInterface, error)
+	AuthenticatedClient(ctx context.Context, kc v1alpha1.Keycloak) (KeycloakInterface, error)
 }
 
 type LocalConfigKeycloakFactory struct {
+	// Options are applied to the clients returned by AuthenticatedClient.
+	// The clients skip TLS verification unless WithTLSConfig is given so
+	// WithHTTPClient can't be used, the credentials are read from the admin
+	// credentials secret
+	Options []ClientOption
 }
 
 // AuthenticatedClient returns an authenticated client for requesting endpoints from the Keycloak api
-func (i *LocalConfigKeycloakFactory) AuthenticatedClient(kc v1alpha1.Keycloak) (KeycloakInterface, error) {
+func (i *LocalConfigKeycloakFactory) AuthenticatedClient(ctx context.Context, kc v1alpha1.Keycloak) (KeycloakInterface, error) {
 	config, err := config2.GetConfig()
 	if err != nil {
 		return nil, err
@@ -1107,11 +4300,15 @@ func (i *LocalConfigKeycloakFactory) AuthenticatedClient(kc v1alpha1.Keycloak) (
 	user := string(adminCreds.Data[model.AdminUsernameProperty])
 	pass := string(adminCreds.Data[model.AdminPasswordProperty])
 	url := kc.Status.InternalURL
-	client := &Client{
-		URL:       url,
-		requester: defaultRequester(),
+	opts := []ClientOption{
+		WithTLSConfig(&tls.Config{InsecureSkipVerify: true}), // nolint
+	}
+	opts = append(opts, i.Options...)
+	client, err := NewClient(url, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if err := client.login(user, pass); err != nil {
+	if err := client.login(ctx, user, pass); err != nil {
 		return nil, err
 	}
 	return client, nil
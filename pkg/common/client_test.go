@@ -1,11 +1,21 @@
 package common
 
 import (
+	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 
@@ -14,18 +24,79 @@ import (
 )
 
 const (
+	ServerInfoPath                    = "/auth/admin/serverinfo"
 	RealmsGetPath                     = "/auth/admin/realms/%s"
 	RealmsCreatePath                  = "/auth/admin/realms"
 	RealmsDeletePath                  = "/auth/admin/realms/%s"
+	RealmEventsPath                   = "/auth/admin/realms/%s/events"
+	AdminEventsPath                   = "/auth/admin/realms/%s/admin-events"
+	RealmEventsConfigPath             = "/auth/admin/realms/%s/events/config"
+	RealmKeysPath                     = "/auth/admin/realms/%s/keys"
+	WellKnownConfigurationPath        = "/auth/realms/%s/.well-known/openid-configuration"
+	JWKSPath                          = "/auth/realms/%s/protocol/openid-connect/certs"
+	RealmTokenPath                    = "/auth/realms/%s/protocol/openid-connect/token"
+	TokenIntrospectionPath            = "/auth/realms/%s/protocol/openid-connect/token/introspect"
+	TokenRevocationPath               = "/auth/realms/%s/protocol/openid-connect/revoke"
 	UserCreatePath                    = "/auth/admin/realms/%s/users"
 	UserDeletePath                    = "/auth/admin/realms/%s/users/%s"
 	UserGetPath                       = "/auth/admin/realms/%s/users/%s"
+	UserSessionsPath                  = "/auth/admin/realms/%s/users/%s/sessions"
+	SessionPath                       = "/auth/admin/realms/%s/sessions/%s"
+	ClientListPath                    = "/auth/admin/realms/%s/clients"
+	ClientCreatePath                  = "/auth/admin/realms/%s/clients"
+	ClientGetPath                     = "/auth/admin/realms/%s/clients/%s"
+	ClientSecretPath                  = "/auth/admin/realms/%s/clients/%s/client-secret"
+	ClientRotatedSecretPath           = "/auth/admin/realms/%s/clients/%s/client-secret/rotated"
+	ClientServiceAccountUserPath      = "/auth/admin/realms/%s/clients/%s/service-account-user"
+	ClientRegistrationTokenPath       = "/auth/admin/realms/%s/clients/%s/registration-access-token"
+	ClientPushRevocationPath          = "/auth/admin/realms/%s/clients/%s/push-revocation"
+	ClientNodesPath                   = "/auth/admin/realms/%s/clients/%s/nodes"
+	ClientNodePath                    = "/auth/admin/realms/%s/clients/%s/nodes/%s"
+	ClientTestNodesAvailablePath      = "/auth/admin/realms/%s/clients/%s/test-nodes-available"
+	ClientInstallationProviderPath    = "/auth/admin/realms/%s/clients/%s/installation/providers/%s"
+	ClientCertificatePath             = "/auth/admin/realms/%s/clients/%s/certificates/%s"
+	RequiredActionsPath               = "/auth/admin/realms/%s/authentication/required-actions"
+	RequiredActionPath                = "/auth/admin/realms/%s/authentication/required-actions/%s"
+	RegisterRequiredActionPath        = "/auth/admin/realms/%s/authentication/register-required-action"
+	AuthzResourcesPath                = "/auth/admin/realms/%s/clients/%s/authz/resource-server/resource"
+	AuthzResourcePath                 = "/auth/admin/realms/%s/clients/%s/authz/resource-server/resource/%s"
+	AuthzScopesPath                   = "/auth/admin/realms/%s/clients/%s/authz/resource-server/scope"
+	AuthzScopePath                    = "/auth/admin/realms/%s/clients/%s/authz/resource-server/scope/%s"
+	AuthzPoliciesPath                 = "/auth/admin/realms/%s/clients/%s/authz/resource-server/policy"
+	AuthzPolicyPath                   = "/auth/admin/realms/%s/clients/%s/authz/resource-server/policy/%s"
+	AuthzPermissionsPath              = "/auth/admin/realms/%s/clients/%s/authz/resource-server/permission"
+	AuthzPermissionPath               = "/auth/admin/realms/%s/clients/%s/authz/resource-server/permission/%s"
+	ComponentsPath                    = "/auth/admin/realms/%s/components"
+	ComponentPath                     = "/auth/admin/realms/%s/components/%s"
+	UserStorageSyncPath               = "/auth/admin/realms/%s/user-storage/%s/sync"
+	ClientRolesPath                   = "/auth/admin/realms/%s/clients/%s/roles"
+	ClientRolePath                    = "/auth/admin/realms/%s/clients/%s/roles/%s"
+	ClientRoleCompositesPath          = "/auth/admin/realms/%s/clients/%s/roles/%s/composites"
+	ClientSessionsPath                = "/auth/admin/realms/%s/clients/%s/user-sessions"
+	ClientSessionCountPath            = "/auth/admin/realms/%s/clients/%s/session-count"
+	ClientOfflineSessionsPath         = "/auth/admin/realms/%s/clients/%s/offline-sessions"
+	ClientOfflineSessionCountPath     = "/auth/admin/realms/%s/clients/%s/offline-session-count"
+	UserOfflineSessionsPath           = "/auth/admin/realms/%s/users/%s/offline-sessions/%s"
+	UserCredentialsPath               = "/auth/admin/realms/%s/users/%s/credentials"
+	UserCredentialPath                = "/auth/admin/realms/%s/users/%s/credentials/%s"
+	UserConsentsPath                  = "/auth/admin/realms/%s/users/%s/consents"
+	UserBruteForcePath                = "/auth/admin/realms/%s/attack-detection/brute-force/users/%s"
+	UserConsentPath                   = "/auth/admin/realms/%s/users/%s/consents/%s"
+	UserResetPasswordPath             = "/auth/admin/realms/%s/users/%s/reset-password"
 	UserFindByUsernamePath            = "/auth/admin/realms/%s/users?username=%s&max=-1"
+	UserFindByFederatedIdentityPath   = "/auth/admin/realms/%s/users?idpAlias=%s&idpUserId=%s&max=10"
+	UserFederatedIdentitiesPath       = "/auth/admin/realms/%s/users/%s/federated-identity"
+	UserFederatedIdentityPath         = "/auth/admin/realms/%s/users/%s/federated-identity/%s"
 	UserAddToGroupPath                = "/auth/admin/realms/%s/users/%s/groups/%s"
 	UserDeleteFromGroupPath           = "/auth/admin/realms/%s/users/%s/groups/%s"
 	GroupGetUsersPath                 = "/auth/admin/realms/%s/groups/%s/members"
 	GroupGetPath                      = "/auth/admin/realms/%s/groups/%s"
 	GroupListPath                     = "/auth/admin/realms/%s/groups"
+	GroupCountPath                    = "/auth/admin/realms/%s/groups/count"
+	GroupChildrenPath                 = "/auth/admin/realms/%s/groups/%s/children"
+	GroupManagementPermissionsPath    = "/auth/admin/realms/%s/groups/%s/management/permissions"
+	ClientManagementPermissionsPath   = "/auth/admin/realms/%s/clients/%s/management/permissions"
+	GroupByPathPath                   = "/auth/admin/realms/%s/group-by-path/%s"
 	GroupCreatePath                   = "/auth/admin/realms/%s/groups"
 	GroupGetDefaults                  = "/auth/admin/realms/%s/default-groups"
 	GroupMakeDefaultPath              = "/auth/admin/realms/%s/default-groups/%s"
@@ -37,6 +108,25 @@ const (
 	GroupGetRealmRoles                = "/auth/admin/realms/%s/groups/%s/role-mappings/realm"
 	GroupGetAvailableRealmRoles       = "/auth/admin/realms/%s/groups/%s/role-mappings/realm/available"
 	AuthenticationFlowUpdateExecution = "/auth/admin/realms/%s/authentication/flows/%s/executions"
+	IdentityProviderListPath          = "/auth/admin/realms/%s/identity-provider/instances"
+	ClientScopeListPath               = "/auth/admin/realms/%s/client-scopes"
+	ClientScopeGetPath                = "/auth/admin/realms/%s/client-scopes/%s"
+	ClientDefaultClientScopePath      = "/auth/admin/realms/%s/clients/%s/default-client-scopes/%s"
+	ClientOptionalClientScopePath     = "/auth/admin/realms/%s/clients/%s/optional-client-scopes/%s"
+	ClientDefaultClientScopesPath     = "/auth/admin/realms/%s/clients/%s/default-client-scopes"
+	ClientOptionalClientScopesPath    = "/auth/admin/realms/%s/clients/%s/optional-client-scopes"
+	ClientProtocolMappersPath         = "/auth/admin/realms/%s/clients/%s/protocol-mappers/models"
+	ClientProtocolMapperPath          = "/auth/admin/realms/%s/clients/%s/protocol-mappers/models/%s"
+	ClientScopeProtocolMappersPath    = "/auth/admin/realms/%s/client-scopes/%s/protocol-mappers/models"
+	ClientScopeProtocolMapperPath     = "/auth/admin/realms/%s/client-scopes/%s/protocol-mappers/models/%s"
+	ClientScopeRealmScopeMappingsPath = "/auth/admin/realms/%s/client-scopes/%s/scope-mappings/realm"
+	ClientScopeClientMappingsPath     = "/auth/admin/realms/%s/client-scopes/%s/scope-mappings/clients/%s"
+	ClientEvaluateScopesMappersPath   = "/auth/admin/realms/%s/clients/%s/evaluate-scopes/protocol-mappers"
+	ClientExampleAccessTokenPath      = "/auth/admin/realms/%s/clients/%s/evaluate-scopes/generate-example-access-token"
+	IdentityProviderGetPath           = "/auth/admin/realms/%s/identity-provider/instances/%s"
+	IdentityProviderMappersPath       = "/auth/admin/realms/%s/identity-provider/instances/%s/mappers"
+	IdentityProviderMapperPath        = "/auth/admin/realms/%s/identity-provider/instances/%s/mappers/%s"
+	AuthenticatorConfigPath           = "/auth/admin/realms/%s/authentication/config/%s"
 	TokenPath                         = "/auth/realms/master/protocol/openid-connect/token" // nolint
 )
 
@@ -103,7 +193,7 @@ func TestClient_CreateRealm(t *testing.T) {
 	realm := getDummyRealm()
 
 	// when
-	_, err := client.CreateRealm(realm)
+	_, err := client.CreateRealm(context.TODO(), realm)
 
 	// then
 	// no error expected
@@ -129,7 +219,7 @@ func TestClient_DeleteRealmRealm(t *testing.T) {
 	}
 
 	// when
-	err := client.DeleteRealm(realm.Spec.Realm.Realm)
+	err := client.DeleteRealm(context.TODO(), realm.Spec.Realm.Realm)
 
 	// then
 	// correct path expected on httptest server
@@ -158,7 +248,7 @@ func TestClient_CreateUser(t *testing.T) {
 	}
 
 	// when
-	uid, err := client.CreateUser(user, realm.Spec.Realm.Realm)
+	uid, err := client.CreateUser(context.TODO(), user, realm.Spec.Realm.Realm)
 
 	// then
 	// correct path expected on httptest server
@@ -166,6 +256,70 @@ func TestClient_CreateUser(t *testing.T) {
 	assert.Equal(t, uid, dummyUserID)
 }
 
+func TestClient_BulkCreateUsers(t *testing.T) {
+	realm := getDummyRealm()
+	const concurrency = 2
+	var (
+		mu               sync.Mutex
+		inFlight         int
+		maxInFlight      int
+		createdUsernames []string
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(UserCreatePath, realm.Spec.Realm.Realm), req.URL.Path)
+				mu.Lock()
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				mu.Unlock()
+				time.Sleep(10 * time.Millisecond)
+
+				user := &v1alpha1.KeycloakAPIUser{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(user))
+				mu.Lock()
+				inFlight--
+				mu.Unlock()
+				if user.UserName == "taken" {
+					w.WriteHeader(409)
+					return
+				}
+				mu.Lock()
+				createdUsernames = append(createdUsernames, user.UserName)
+				mu.Unlock()
+				w.Header().Set("Location", fmt.Sprintf(UserGetPath, realm.Spec.Realm.Realm, user.UserName+"-id"))
+				w.WriteHeader(201)
+			},
+		}),
+		func(c *Client) {
+			users := []*v1alpha1.KeycloakAPIUser{
+				{UserName: "alice"},
+				{UserName: "taken"},
+				{UserName: "bob"},
+				{UserName: "carol"},
+				{UserName: "dave"},
+			}
+			errs := c.BulkCreateUsers(context.TODO(), users, realm.Spec.Realm.Realm, concurrency)
+			assert.Len(t, errs, len(users))
+			for i, err := range errs {
+				if i == 1 {
+					assert.True(t, errors.Is(err, ErrAlreadyExists))
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+			assert.Equal(t, "alice-id", users[0].ID)
+			assert.Empty(t, users[1].ID)
+			assert.Equal(t, "dave-id", users[4].ID)
+			assert.ElementsMatch(t, []string{"alice", "bob", "carol", "dave"}, createdUsernames)
+			assert.True(t, maxInFlight <= concurrency)
+		},
+	)
+}
+
 func TestClient_DeleteUser(t *testing.T) {
 	// given
 	user := getDummyUser()
@@ -185,13 +339,82 @@ func TestClient_DeleteUser(t *testing.T) {
 	}
 
 	// when
-	err := client.DeleteUser(user.ID, realm.Spec.Realm.Realm)
+	err := client.DeleteUser(context.TODO(), user.ID, realm.Spec.Realm.Realm)
 
 	// then
 	// correct path expected on httptest server
 	assert.NoError(t, err)
 }
 
+func TestClient_GetUser(t *testing.T) {
+	realm := getDummyRealm()
+	user := getExistingDummyUser()
+	expectedPath := fmt.Sprintf(UserGetPath, realm.Spec.Realm.Realm, user.ID)
+
+	testClientHTTPRequest(
+		withPathAssertionBody(t, 200, expectedPath, user),
+		func(c *Client) {
+			found, err := c.GetUser(context.TODO(), user.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, user.ID, found.ID)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, expectedPath),
+		func(c *Client) {
+			found, err := c.GetUser(context.TODO(), user.ID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+			assert.Nil(t, found)
+		},
+	)
+}
+
+func TestClient_SetTemporaryPassword(t *testing.T) {
+	realm := getDummyRealm()
+	user := getExistingDummyUser()
+	resetPath := fmt.Sprintf(UserResetPasswordPath, realm.Spec.Realm.Realm, user.ID)
+	userPath := fmt.Sprintf(UserGetPath, realm.Spec.Realm.Realm, user.ID)
+
+	handler := func(updateUserStatus int) http.HandlerFunc {
+		return withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, userPath, user),
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				switch req.URL.Path {
+				case resetPath:
+					reset := &v1alpha1.KeycloakAPIPasswordReset{}
+					assert.NoError(t, json.NewDecoder(req.Body).Decode(reset))
+					assert.True(t, reset.Temporary)
+					assert.Equal(t, "temporary", reset.Value)
+					w.WriteHeader(204)
+				case userPath:
+					updated := &v1alpha1.KeycloakAPIUser{}
+					assert.NoError(t, json.NewDecoder(req.Body).Decode(updated))
+					assert.Equal(t, []string{"UPDATE_PASSWORD"}, updated.RequiredActions)
+					w.WriteHeader(updateUserStatus)
+				default:
+					t.Errorf("unexpected request path %s", req.URL.Path)
+				}
+			},
+		})
+	}
+
+	testClientHTTPRequest(handler(204), func(c *Client) {
+		err := c.SetTemporaryPassword(context.TODO(), user.ID, realm.Spec.Realm.Realm, "temporary")
+		assert.NoError(t, err)
+	})
+
+	testClientHTTPRequest(handler(500), func(c *Client) {
+		// when the password is reset but the user update fails
+		err := c.SetTemporaryPassword(context.TODO(), user.ID, realm.Spec.Realm.Realm, "temporary")
+		// then the partial state is reported
+		assert.Error(t, err)
+		incomplete, ok := err.(*PasswordResetIncompleteError)
+		assert.True(t, ok)
+		assert.Equal(t, user.ID, incomplete.UserID)
+	})
+}
+
 func TestClient_FindUserByUsername(t *testing.T) {
 	// given
 	realm := getDummyRealm()
@@ -219,7 +442,7 @@ func TestClient_FindUserByUsername(t *testing.T) {
 	}
 
 	// when
-	userFound, err := client.FindUserByUsername(user.UserName, realm.Spec.Realm.Realm)
+	userFound, err := client.FindUserByUsername(context.TODO(), user.UserName, realm.Spec.Realm.Realm)
 
 	// then
 	// correct path expected on httptest server
@@ -229,6 +452,179 @@ func TestClient_FindUserByUsername(t *testing.T) {
 	assert.Equal(t, user, userFound)
 }
 
+func TestClient_FederatedIdentities(t *testing.T) {
+	realm := getDummyRealm()
+	user := getExistingDummyUser()
+	fid := v1alpha1.FederatedIdentity{IdentityProvider: "github", UserID: "gh-12345", UserName: "octocat"}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(UserFederatedIdentitiesPath, realm.Spec.Realm.Realm, user.ID), []v1alpha1.FederatedIdentity{fid}),
+		}),
+		func(c *Client) {
+			fids, err := c.GetUserFederatedIdentities(context.TODO(), user.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, []v1alpha1.FederatedIdentity{fid}, fids)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertion(t, 404, fmt.Sprintf(UserFederatedIdentitiesPath, realm.Spec.Realm.Realm, user.ID)),
+		}),
+		func(c *Client) {
+			_, err := c.GetUserFederatedIdentities(context.TODO(), user.ID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(UserFederatedIdentityPath, realm.Spec.Realm.Realm, user.ID, fid.IdentityProvider), req.URL.Path)
+				sent := v1alpha1.FederatedIdentity{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&sent))
+				assert.Equal(t, fid, sent)
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			_, err := c.CreateFederatedIdentity(context.TODO(), fid, user.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_GetUserByFederatedIdentity(t *testing.T) {
+	realm := getDummyRealm()
+	user := getExistingDummyUser()
+	const (
+		providerAlias  = "github"
+		externalUserID = "gh-12345"
+	)
+
+	handler := func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		switch req.URL.Path {
+		case fmt.Sprintf(UserCreatePath, realm.Spec.Realm.Realm):
+			assert.Equal(t, fmt.Sprintf(UserFindByFederatedIdentityPath, realm.Spec.Realm.Realm, providerAlias, externalUserID), req.URL.String())
+			_, err := respondWithJSON([]*v1alpha1.KeycloakAPIUser{user}, w)
+			assert.NoError(t, err)
+		case fmt.Sprintf(UserFederatedIdentitiesPath, realm.Spec.Realm.Realm, user.ID):
+			_, err := respondWithJSON([]v1alpha1.FederatedIdentity{
+				{IdentityProvider: providerAlias, UserID: externalUserID},
+			}, w)
+			assert.NoError(t, err)
+		default:
+			t.Errorf("unexpected request path %s", req.URL.Path)
+		}
+	}
+
+	testClientHTTPRequest(handler, func(c *Client) {
+		// when the federated identity is linked to the user
+		found, err := c.GetUserByFederatedIdentity(context.TODO(), realm.Spec.Realm.Realm, providerAlias, externalUserID)
+		// then return the user
+		assert.NoError(t, err)
+		assert.NotNil(t, found)
+		assert.Equal(t, user.ID, found.ID)
+	})
+
+	testClientHTTPRequest(withJSON(t, []*v1alpha1.KeycloakAPIUser{}, 200), func(c *Client) {
+		// when no user matches
+		found, err := c.GetUserByFederatedIdentity(context.TODO(), realm.Spec.Realm.Realm, providerAlias, externalUserID)
+		// then return ErrNotFound
+		assert.True(t, errors.Is(err, ErrNotFound))
+		assert.Nil(t, found)
+	})
+
+	deleted := &v1alpha1.KeycloakAPIUser{ID: "deleted-12345"}
+	handler = func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case fmt.Sprintf(UserCreatePath, realm.Spec.Realm.Realm):
+			_, err := respondWithJSON([]*v1alpha1.KeycloakAPIUser{deleted, user}, w)
+			assert.NoError(t, err)
+		case fmt.Sprintf(UserFederatedIdentitiesPath, realm.Spec.Realm.Realm, deleted.ID):
+			w.WriteHeader(404)
+		case fmt.Sprintf(UserFederatedIdentitiesPath, realm.Spec.Realm.Realm, user.ID):
+			_, err := respondWithJSON([]v1alpha1.FederatedIdentity{
+				{IdentityProvider: providerAlias, UserID: externalUserID},
+			}, w)
+			assert.NoError(t, err)
+		default:
+			t.Errorf("unexpected request path %s", req.URL.Path)
+		}
+	}
+
+	testClientHTTPRequest(handler, func(c *Client) {
+		// when a candidate is deleted during the lookup
+		found, err := c.GetUserByFederatedIdentity(context.TODO(), realm.Spec.Realm.Realm, providerAlias, externalUserID)
+		// then skip it
+		assert.NoError(t, err)
+		assert.NotNil(t, found)
+		assert.Equal(t, user.ID, found.ID)
+	})
+}
+
+func TestClient_UnlinkUserFromIdP(t *testing.T) {
+	realm := getDummyRealm()
+	user := getDummyUser()
+	const providerAlias = "github"
+	expectedPath := fmt.Sprintf(UserFederatedIdentityPath, realm.Spec.Realm.Realm, user.ID, providerAlias)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.UnlinkUserFromIdP(context.TODO(), user.ID, realm.Spec.Realm.Realm, providerAlias)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 404, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.UnlinkUserFromIdP(context.TODO(), user.ID, realm.Spec.Realm.Realm, providerAlias)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_UnlinkAllUsersFromIdP(t *testing.T) {
+	realm := getDummyRealm()
+	const providerAlias = "github"
+	users := []*v1alpha1.KeycloakAPIUser{
+		{ID: "user-1"},
+		{ID: "user-2"},
+		{ID: "already-unlinked"},
+	}
+
+	handler := withMethodSelection(t, map[string]http.HandlerFunc{
+		http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, fmt.Sprintf(UserCreatePath, realm.Spec.Realm.Realm), req.URL.Path)
+			assert.Equal(t, providerAlias, req.URL.Query().Get("idpAlias"))
+			assert.Equal(t, "0", req.URL.Query().Get("first"))
+			_, err := respondWithJSON(users, w)
+			assert.NoError(t, err)
+		},
+		http.MethodDelete: func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path == fmt.Sprintf(UserFederatedIdentityPath, realm.Spec.Realm.Realm, "already-unlinked", providerAlias) {
+				w.WriteHeader(404)
+				return
+			}
+			w.WriteHeader(204)
+		},
+	})
+
+	testClientHTTPRequest(handler, func(c *Client) {
+		removed, err := c.UnlinkAllUsersFromIdP(context.TODO(), realm.Spec.Realm.Realm, providerAlias, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, removed)
+	})
+}
+
 func TestClient_ListUsersInGroup(t *testing.T) {
 	realm := getDummyRealm()
 	groupID := "12345"
@@ -238,12 +634,135 @@ func TestClient_ListUsersInGroup(t *testing.T) {
 
 	testClientHTTPRequest(
 		withMethodSelection(t, map[string]http.HandlerFunc{
-			http.MethodGet: withPathAssertionBody(t, 200, expectedPath, &v1alpha1.KeycloakAPIUser{}),
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath, []*v1alpha1.KeycloakAPIUser{}),
+		}),
+
+		func(c *Client) {
+			_, err := c.ListUsersInGroup(context.TODO(), realm.Spec.Realm.Realm, groupID)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_GetGroupMembers(t *testing.T) {
+	realm := getDummyRealm()
+	groupID := "12345"
+	expectedPath := fmt.Sprintf(GroupGetUsersPath, realm.Spec.Realm.Realm, groupID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, expectedPath, req.URL.Path)
+				assert.Equal(t, "10", req.URL.Query().Get("first"))
+				assert.Equal(t, "5", req.URL.Query().Get("max"))
+				assert.Equal(t, "true", req.URL.Query().Get("briefRepresentation"))
+				_, err := respondWithJSON([]*v1alpha1.KeycloakAPIUser{getDummyUser()}, w)
+				assert.NoError(t, err)
+			},
 		}),
+		func(c *Client) {
+			members, err := c.GetGroupMembers(context.TODO(), groupID, realm.Spec.Realm.Realm, 10, 5)
+			assert.NoError(t, err)
+			assert.Len(t, members, 1)
+		},
+	)
+}
 
+func TestClient_GetAllGroupMembers(t *testing.T) {
+	realm := getDummyRealm()
+	groupID := "12345"
+	const total = 250
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(GroupGetUsersPath, realm.Spec.Realm.Realm, groupID), req.URL.Path)
+				assert.Equal(t, "false", req.URL.Query().Get("briefRepresentation"))
+				first, _ := strconv.Atoi(req.URL.Query().Get("first"))
+				max, _ := strconv.Atoi(req.URL.Query().Get("max"))
+				page := []*v1alpha1.KeycloakAPIUser{}
+				for i := first; i < first+max && i < total; i++ {
+					page = append(page, &v1alpha1.KeycloakAPIUser{ID: strconv.Itoa(i)})
+				}
+				_, err := respondWithJSON(page, w)
+				assert.NoError(t, err)
+			},
+		}),
 		func(c *Client) {
-			_, err := c.ListUsersInGroup(realm.Spec.Realm.Realm, groupID)
+			members, err := c.GetAllGroupMembers(context.TODO(), groupID, realm.Spec.Realm.Realm, false)
+			assert.NoError(t, err)
+			assert.Len(t, members, total)
+			assert.Equal(t, "249", members[total-1].ID)
+		},
+	)
+}
+
+func TestUserPager(t *testing.T) {
+	realm := getDummyRealm()
+	groupID := "12345"
+	const total = 5
+
+	respondWithPage := func(w http.ResponseWriter, req *http.Request) {
+		first, _ := strconv.Atoi(req.URL.Query().Get("first"))
+		max, _ := strconv.Atoi(req.URL.Query().Get("max"))
+		page := []*v1alpha1.KeycloakAPIUser{}
+		for i := first; i < first+max && i < total; i++ {
+			page = append(page, &v1alpha1.KeycloakAPIUser{ID: strconv.Itoa(i)})
+		}
+		_, err := respondWithJSON(page, w)
+		assert.NoError(t, err)
+	}
+	pageSizes := func(pager *UserPager) []int {
+		var sizes []int
+		for {
+			page, err := pager.Next(context.TODO())
 			assert.NoError(t, err)
+			if len(page) == 0 {
+				return sizes
+			}
+			sizes = append(sizes, len(page))
+		}
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(UserCreatePath, realm.Spec.Realm.Realm), req.URL.Path)
+				respondWithPage(w, req)
+			},
+		}),
+		func(c *Client) {
+			assert.Equal(t, []int{2, 2, 1}, pageSizes(NewUserPager(c, realm.Spec.Realm.Realm, 2)))
+			// a full last page takes one more request to find the end
+			assert.Equal(t, []int{5}, pageSizes(NewUserPager(c, realm.Spec.Realm.Realm, 5)))
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(GroupGetUsersPath, realm.Spec.Realm.Realm, groupID), req.URL.Path)
+				assert.Equal(t, "true", req.URL.Query().Get("briefRepresentation"))
+				respondWithPage(w, req)
+			},
+		}),
+		func(c *Client) {
+			assert.Equal(t, []int{3, 2}, pageSizes(NewGroupMemberPager(c, groupID, realm.Spec.Realm.Realm, 3)))
+		},
+	)
+
+	// a page size of 0 or less falls back to the default instead of asking
+	// Keycloak for every user at once
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, strconv.Itoa(pageSize), req.URL.Query().Get("max"))
+				respondWithPage(w, req)
+			},
+		}),
+		func(c *Client) {
+			assert.Equal(t, []int{5}, pageSizes(NewUserPager(c, realm.Spec.Realm.Realm, 0)))
+			assert.Equal(t, []int{5}, pageSizes(NewGroupMemberPager(c, groupID, realm.Spec.Realm.Realm, -1)))
 		},
 	)
 }
@@ -261,7 +780,7 @@ func TestClient_AddUserToGroup(t *testing.T) {
 		}),
 
 		func(c *Client) {
-			err := c.AddUserToGroup(realm.Spec.Realm.Realm, user.ID, groupID)
+			err := c.AddUserToGroup(context.TODO(), realm.Spec.Realm.Realm, user.ID, groupID)
 			assert.NoError(t, err)
 		},
 	)
@@ -280,7 +799,7 @@ func TestClient_DeleteUserFromGroup(t *testing.T) {
 		}),
 
 		func(c *Client) {
-			err := c.DeleteUserFromGroup(realm.Spec.Realm.Realm, user.ID, groupID)
+			err := c.DeleteUserFromGroup(context.TODO(), realm.Spec.Realm.Realm, user.ID, groupID)
 			assert.NoError(t, err)
 		},
 	)
@@ -312,7 +831,7 @@ func TestClient_GetRealm(t *testing.T) {
 	}
 
 	// when
-	newRealm, err := client.GetRealm(realm.Spec.Realm.Realm)
+	newRealm, err := client.GetRealm(context.TODO(), realm.Spec.Realm.Realm)
 
 	// then
 	// correct path expected on httptest server
@@ -322,6 +841,44 @@ func TestClient_GetRealm(t *testing.T) {
 	assert.Equal(t, realm.Spec.Realm.Realm, newRealm.Spec.Realm.Realm)
 }
 
+func TestClient_GetRealmErrors(t *testing.T) {
+	realm := getDummyRealm()
+	path := fmt.Sprintf(RealmsGetPath, realm.Spec.Realm.Realm)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 403, path),
+		func(c *Client) {
+			result, err := c.GetRealm(context.TODO(), realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrForbidden))
+			assert.Nil(t, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, path),
+		func(c *Client) {
+			result, err := c.GetRealm(context.TODO(), realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+			assert.Nil(t, result)
+		},
+	)
+}
+
+func TestClient_ListRealmsInvalidResponse(t *testing.T) {
+	testClientHTTPRequest(
+		func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, RealmsCreatePath, req.URL.Path)
+			_, err := w.Write([]byte("not json"))
+			assert.NoError(t, err)
+		},
+		func(c *Client) {
+			result, err := c.ListRealms(context.TODO())
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		},
+	)
+}
+
 func TestClient_ListRealms(t *testing.T) {
 	// given
 	realm := getDummyRealm()
@@ -350,7 +907,7 @@ func TestClient_ListRealms(t *testing.T) {
 	}
 
 	// when
-	realms, err := client.ListRealms()
+	realms, err := client.ListRealms(context.TODO())
 
 	// then
 	// correct path expected on httptest server
@@ -367,28 +924,32 @@ func TestClient_FindGroupByName(t *testing.T) {
 	)
 	realm := getDummyRealm()
 
-	handle := withPathAssertionBody(
-		t,
-		200,
-		fmt.Sprintf(GroupListPath, realm.Spec.Realm.Realm),
-		[]*Group{
-			&Group{
-				ID:   existingGroupID,
-				Name: existingGroupName,
+	handle := func(w http.ResponseWriter, req *http.Request) {
+		// the group name is used to filter the groups on the server
+		assert.NotEmpty(t, req.URL.Query().Get("search"))
+		withPathAssertionBody(
+			t,
+			200,
+			fmt.Sprintf(GroupListPath, realm.Spec.Realm.Realm),
+			[]*Group{
+				&Group{
+					ID:   existingGroupID,
+					Name: existingGroupName,
+				},
 			},
-		},
-	)
+		)(w, req)
+	}
 
 	request := func(c *Client) {
 		// when the group exists
-		foundGroup, err := c.FindGroupByName(existingGroupName, realm.Spec.Realm.Realm)
+		foundGroup, err := c.FindGroupByName(context.TODO(), existingGroupName, realm.Spec.Realm.Realm)
 		// then return the group instance
 		assert.NoError(t, err)
 		assert.NotNil(t, foundGroup)
 		assert.Equal(t, existingGroupID, foundGroup.ID)
 
 		// when the group doesn't exist
-		notFoundGroup, err := c.FindGroupByName("not-existing", "dummy")
+		notFoundGroup, err := c.FindGroupByName(context.TODO(), "not-existing", "dummy")
 		// then return `nil`
 		assert.NoError(t, err)
 		assert.Nil(t, notFoundGroup)
@@ -397,38 +958,369 @@ func TestClient_FindGroupByName(t *testing.T) {
 	testClientHTTPRequest(handle, request)
 }
 
-func TestClient_CreateGroup(t *testing.T) {
+func TestClient_FindGroupByNameInHierarchy(t *testing.T) {
 	realm := getDummyRealm()
-	const (
-		createdGroupName string = "dummy-group"
-		createdGroupID   string = "12345"
-	)
 
-	handle := withMethodSelection(t, map[string]http.HandlerFunc{
-		// When the client requests to create the group
-		http.MethodPost: withPathAssertionLocationHeader(t, 201, fmt.Sprintf(GroupCreatePath, realm.Spec.Realm.Realm), createdGroupID),
-	})
-
-	request := func(c *Client) {
-		groupID, err := c.CreateGroup(createdGroupName, realm.Spec.Realm.Realm)
-		assert.NoError(t, err)
-		assert.Equal(t, createdGroupID, groupID)
+	// the group tree as returned by Keycloak versions that include the
+	// subgroups in the response
+	tree := []*Group{
+		{
+			ID:   "1",
+			Name: "engineering",
+			SubGroups: []*Group{
+				{
+					ID:   "2",
+					Name: "platform",
+					SubGroups: []*Group{
+						{ID: "3", Name: "sre"},
+					},
+				},
+			},
+		},
 	}
 
-	testClientHTTPRequest(handle, request)
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Empty(t, req.URL.Query().Get("search"))
+				withPathAssertionBody(t, 200, fmt.Sprintf(GroupListPath, realm.Spec.Realm.Realm), tree)(w, req)
+			},
+		}),
+		func(c *Client) {
+			group, err := c.FindGroupByNameInHierarchy(context.TODO(), "sre", realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.NotNil(t, group)
+			assert.Equal(t, "3", group.ID)
+
+			group, err = c.FindGroupByNameInHierarchy(context.TODO(), "not-existing", realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Nil(t, group)
+		},
+	)
+
+	// the same tree on Keycloak versions that load the subgroups lazily
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				var groups []*Group
+				switch req.URL.Path {
+				case fmt.Sprintf(GroupListPath, realm.Spec.Realm.Realm):
+					groups = []*Group{{ID: "1", Name: "engineering", SubGroupCount: 1}}
+				case fmt.Sprintf(GroupChildrenPath, realm.Spec.Realm.Realm, "1"):
+					groups = []*Group{{ID: "2", Name: "platform", SubGroupCount: 1}}
+				case fmt.Sprintf(GroupChildrenPath, realm.Spec.Realm.Realm, "2"):
+					groups = []*Group{{ID: "3", Name: "sre"}}
+				default:
+					t.Errorf("unexpected request to %s", req.URL.Path)
+				}
+				_, err := respondWithJSON(groups, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			group, err := c.FindGroupByNameInHierarchy(context.TODO(), "sre", realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.NotNil(t, group)
+			assert.Equal(t, "3", group.ID)
+		},
+	)
 }
 
-func TestClient_MakeGroupDefault(t *testing.T) {
-	const groupID string = "12345"
+func TestClient_GetGroupHierarchy(t *testing.T) {
 	realm := getDummyRealm()
+	expected := []*Group{
+		{
+			ID:            "1",
+			Name:          "engineering",
+			SubGroupCount: 1,
+			SubGroups: []*Group{
+				{
+					ID:            "2",
+					Name:          "platform",
+					SubGroupCount: 1,
+					SubGroups: []*Group{
+						{ID: "3", Name: "sre", Attributes: map[string][]string{"on-call": {"true"}}},
+					},
+				},
+			},
+		},
+		{ID: "4", Name: "sales"},
+	}
 
-	handle := withMethodSelection(t, map[string]http.HandlerFunc{
-		http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(GroupGetDefaults, realm.Spec.Realm.Realm), []*Group{}),
-		http.MethodPut: withPathAssertion(t, 200, fmt.Sprintf(GroupMakeDefaultPath, realm.Spec.Realm.Realm, groupID)),
+	// Keycloak 23+ returns the subgroup count but no subgroups
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, "false", req.URL.Query().Get("briefRepresentation"))
+				var groups []*Group
+				switch req.URL.Path {
+				case fmt.Sprintf(GroupListPath, realm.Spec.Realm.Realm):
+					groups = []*Group{{ID: "1", Name: "engineering", SubGroupCount: 1}, {ID: "4", Name: "sales"}}
+				case fmt.Sprintf(GroupChildrenPath, realm.Spec.Realm.Realm, "1"):
+					groups = []*Group{{ID: "2", Name: "platform", SubGroupCount: 1}}
+				case fmt.Sprintf(GroupChildrenPath, realm.Spec.Realm.Realm, "2"):
+					groups = []*Group{{ID: "3", Name: "sre", Attributes: map[string][]string{"on-call": {"true"}}}}
+				default:
+					t.Errorf("unexpected request to %s", req.URL.Path)
+				}
+				_, err := respondWithJSON(groups, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			groups, err := c.GetGroupHierarchy(context.TODO(), realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, groups)
+		},
+	)
+
+	// older versions return the whole tree in one response
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(GroupListPath, realm.Spec.Realm.Realm), expected),
+		}),
+		func(c *Client) {
+			groups, err := c.GetGroupHierarchy(context.TODO(), realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, groups)
+		},
+	)
+}
+
+func TestClient_ListGroups(t *testing.T) {
+	realm := getDummyRealm()
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(GroupListPath, realm.Spec.Realm.Realm), req.URL.Path)
+				assert.Equal(t, "sre", req.URL.Query().Get("search"))
+				assert.Equal(t, "20", req.URL.Query().Get("first"))
+				assert.Equal(t, "10", req.URL.Query().Get("max"))
+				// search matches subgroups, so the parent is returned with the
+				// matching children only
+				_, err := respondWithJSON([]*Group{
+					{
+						ID:   "1",
+						Name: "engineering",
+						SubGroups: []*Group{
+							{ID: "2", Name: "sre"},
+						},
+					},
+				}, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			groups, err := c.ListGroups(context.TODO(), realm.Spec.Realm.Realm, "sre", 20, 10)
+			assert.NoError(t, err)
+			assert.Len(t, groups, 1)
+			assert.Len(t, groups[0].SubGroups, 1)
+			assert.Equal(t, "sre", groups[0].SubGroups[0].Name)
+		},
+	)
+}
+
+func TestClient_CountGroups(t *testing.T) {
+	realm := getDummyRealm()
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(GroupCountPath, realm.Spec.Realm.Realm), req.URL.Path)
+				assert.Equal(t, "sre", req.URL.Query().Get("search"))
+				assert.Equal(t, "true", req.URL.Query().Get("top"))
+				_, err := respondWithJSON(map[string]int{"count": 42}, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			count, err := c.CountGroups(context.TODO(), realm.Spec.Realm.Realm, "sre", true)
+			assert.NoError(t, err)
+			assert.Equal(t, 42, count)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				_, hasSearch := req.URL.Query()["search"]
+				_, hasTop := req.URL.Query()["top"]
+				assert.False(t, hasSearch)
+				assert.False(t, hasTop)
+				w.WriteHeader(200)
+				_, err := w.Write([]byte("12"))
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			// a bare integer isn't a valid groups count response
+			_, err := c.CountGroups(context.TODO(), realm.Spec.Realm.Realm, "", false)
+			assert.Error(t, err)
+		},
+	)
+}
+
+func TestClient_GetGroupByPath(t *testing.T) {
+	realm := getDummyRealm()
+	group := &Group{
+		ID:   "12345",
+		Name: "site reliability",
+		Path: "/engineering/platform/site reliability",
+		Attributes: map[string][]string{
+			"cost-center": {"42"},
+		},
+		SubGroups: []*Group{
+			{ID: "67890", Name: "on-call", Path: "/engineering/platform/site reliability/on-call"},
+		},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				// segments are escaped individually, slashes are kept
+				assert.Equal(t, fmt.Sprintf(GroupByPathPath, realm.Spec.Realm.Realm, "engineering/platform/site%20reliability"), req.URL.EscapedPath())
+				_, err := respondWithJSON(group, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			found, err := c.GetGroupByPath(context.TODO(), group.Path, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, group, found)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, fmt.Sprintf(GroupByPathPath, realm.Spec.Realm.Realm, "missing")),
+		func(c *Client) {
+			found, err := c.GetGroupByPath(context.TODO(), "/missing", realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+			assert.Nil(t, found)
+		},
+	)
+}
+
+func TestClient_ResolveGroupPath(t *testing.T) {
+	realm := getDummyRealm()
+	byPathPrefix := fmt.Sprintf(GroupByPathPath, realm.Spec.Realm.Realm, "")
+
+	// "/org" exists, "/org/team" is created by another reconciler while we
+	// try to create it and "/org/team/subteam" is missing
+	groups := map[string]string{"/org": "1"}
+	handle := withMethodSelection(t, map[string]http.HandlerFunc{
+		http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+			path := "/" + strings.TrimPrefix(req.URL.Path, byPathPrefix)
+			id, ok := groups[path]
+			if !ok {
+				w.WriteHeader(404)
+				return
+			}
+			_, err := respondWithJSON(&Group{ID: id, Path: path}, w)
+			assert.NoError(t, err)
+		},
+		http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case fmt.Sprintf(GroupSetChildPath, realm.Spec.Realm.Realm, "1"):
+				groups["/org/team"] = "2"
+				w.WriteHeader(409)
+			case fmt.Sprintf(GroupSetChildPath, realm.Spec.Realm.Realm, "2"):
+				groups["/org/team/subteam"] = "3"
+				withPathAssertionLocationHeader(t, 201, req.URL.Path, "3")(w, req)
+			default:
+				t.Errorf("unexpected request to %s", req.URL.Path)
+			}
+		},
+	})
+
+	testClientHTTPRequest(handle, func(c *Client) {
+		_, err := c.ResolveGroupPath(context.TODO(), "/org/team/subteam", realm.Spec.Realm.Realm, false)
+		assert.True(t, errors.Is(err, ErrNotFound))
+
+		id, err := c.ResolveGroupPath(context.TODO(), "/org/team/subteam", realm.Spec.Realm.Realm, true)
+		assert.NoError(t, err)
+		assert.Equal(t, "3", id)
+
+		id, err = c.ResolveGroupPath(context.TODO(), "/org/team", realm.Spec.Realm.Realm, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "2", id)
+	})
+}
+
+func TestClient_CreateGroup(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		createdGroupName string = "dummy-group"
+		createdGroupID   string = "12345"
+	)
+
+	handle := withMethodSelection(t, map[string]http.HandlerFunc{
+		// When the client requests to create the group
+		http.MethodPost: withPathAssertionLocationHeader(t, 201, fmt.Sprintf(GroupCreatePath, realm.Spec.Realm.Realm), createdGroupID),
+	})
+
+	request := func(c *Client) {
+		groupID, err := c.CreateGroup(context.TODO(), createdGroupName, realm.Spec.Realm.Realm)
+		assert.NoError(t, err)
+		assert.Equal(t, createdGroupID, groupID)
+	}
+
+	// no GET is expected as the ID is taken from the Location header
+	testClientHTTPRequest(handle, request)
+
+	// When the server doesn't return the Location header the group is looked
+	// up instead
+	handle = withMethodSelection(t, map[string]http.HandlerFunc{
+		http.MethodPost: withPathAssertion(t, 201, fmt.Sprintf(GroupCreatePath, realm.Spec.Realm.Realm)),
+		http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, createdGroupName, req.URL.Query().Get("search"))
+			withPathAssertionBody(t, 200, fmt.Sprintf(GroupListPath, realm.Spec.Realm.Realm), []*Group{
+				{ID: "67890", Name: createdGroupName + "-other"},
+				{ID: createdGroupID, Name: createdGroupName},
+			})(w, req)
+		},
+	})
+
+	testClientHTTPRequest(handle, request)
+}
+
+func TestClient_DeleteGroup(t *testing.T) {
+	const groupID string = "12345"
+	realm := getDummyRealm()
+	expectedPath := fmt.Sprintf(GroupGetPath, realm.Spec.Realm.Realm, groupID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.DeleteGroup(context.TODO(), groupID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 404, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.DeleteGroup(context.TODO(), groupID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_MakeGroupDefault(t *testing.T) {
+	const groupID string = "12345"
+	realm := getDummyRealm()
+
+	handle := withMethodSelection(t, map[string]http.HandlerFunc{
+		http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(GroupGetDefaults, realm.Spec.Realm.Realm), []*Group{}),
+		http.MethodPut: withPathAssertion(t, 200, fmt.Sprintf(GroupMakeDefaultPath, realm.Spec.Realm.Realm, groupID)),
 	})
 
 	request := func(c *Client) {
-		err := c.MakeGroupDefault(groupID, realm.Spec.Realm.Realm)
+		err := c.MakeGroupDefault(context.TODO(), groupID, realm.Spec.Realm.Realm)
 		assert.NoError(t, err)
 	}
 
@@ -449,7 +1341,7 @@ func TestClient_SetGroupChild(t *testing.T) {
 			http.MethodPost: withPathAssertion(t, 201, path),
 		}),
 		func(c *Client) {
-			err := c.SetGroupChild(groupID, realm.Spec.Realm.Realm, &Group{
+			err := c.SetGroupChild(context.TODO(), groupID, realm.Spec.Realm.Realm, &Group{
 				ID: "67890",
 			})
 
@@ -458,6 +1350,39 @@ func TestClient_SetGroupChild(t *testing.T) {
 	)
 }
 
+func TestClient_CreateChildGroup(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		parentGroupID string = "12345"
+		childGroupID  string = "67890"
+	)
+	path := fmt.Sprintf(GroupSetChildPath, realm.Spec.Realm.Realm, parentGroupID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				group := &Group{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(group))
+				assert.Equal(t, "platform", group.Name)
+				withPathAssertionLocationHeader(t, 201, path, childGroupID)(w, req)
+			},
+		}),
+		func(c *Client) {
+			groupID, err := c.CreateChildGroup(context.TODO(), parentGroupID, "platform", realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, childGroupID, groupID)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 409, path),
+		func(c *Client) {
+			_, err := c.CreateChildGroup(context.TODO(), parentGroupID, "platform", realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrAlreadyExists))
+		},
+	)
+}
+
 func TestClient_CreateGroupClientRole(t *testing.T) {
 	realm := getDummyRealm()
 	const (
@@ -467,13 +1392,42 @@ func TestClient_CreateGroupClientRole(t *testing.T) {
 
 	with := withPathAssertion(t, 201, fmt.Sprintf(GroupCreateClientRole, realm.Spec.Realm.Realm, groupID, clientID))
 	when := func(c *Client) {
-		_, err := c.CreateGroupClientRole(&v1alpha1.KeycloakUserRole{}, realm.Spec.Realm.Realm, clientID, groupID)
+		_, err := c.CreateGroupClientRole(context.TODO(), &v1alpha1.KeycloakUserRole{}, realm.Spec.Realm.Realm, clientID, groupID)
 		assert.NoError(t, err)
 	}
 
 	testClientHTTPRequest(with, when)
 }
 
+func TestClient_DeleteGroupClientRole(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		groupID  string = "12345"
+		clientID string = "client-12345"
+	)
+	role := &v1alpha1.KeycloakUserRole{
+		ID:   "role-12345",
+		Name: "view-profile",
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(GroupCreateClientRole, realm.Spec.Realm.Realm, groupID, clientID), req.URL.Path)
+				assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+				var roles []*v1alpha1.KeycloakUserRole
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&roles))
+				assert.Equal(t, []*v1alpha1.KeycloakUserRole{role}, roles)
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.DeleteGroupClientRole(context.TODO(), role, realm.Spec.Realm.Realm, clientID, groupID)
+			assert.NoError(t, err)
+		},
+	)
+}
+
 func TestClient_ListGroupClientRoles(t *testing.T) {
 	realm := getDummyRealm()
 	const (
@@ -482,9 +1436,10 @@ func TestClient_ListGroupClientRoles(t *testing.T) {
 	)
 
 	testClientHTTPRequest(
-		withPathAssertion(t, 200, fmt.Sprintf(GroupGetClientRoles, realm.Spec.Realm.Realm, groupID, clientID)),
+		withPathAssertionBody(t, 200, fmt.Sprintf(GroupGetClientRoles, realm.Spec.Realm.Realm, groupID, clientID), []*v1alpha1.KeycloakUserRole{}),
 		func(c *Client) {
 			_, err := c.ListGroupClientRoles(
+				context.TODO(),
 				realm.Spec.Realm.Realm, clientID, groupID)
 
 			assert.NoError(t, err)
@@ -500,9 +1455,9 @@ func TestClient_ListAvailableGroupClientRoles(t *testing.T) {
 	)
 
 	testClientHTTPRequest(
-		withPathAssertion(t, 200, fmt.Sprintf(GroupGetAvailableClientRoles, realm.Spec.Realm.Realm, clientID, groupID)),
+		withPathAssertionBody(t, 200, fmt.Sprintf(GroupGetAvailableClientRoles, realm.Spec.Realm.Realm, clientID, groupID), []*v1alpha1.KeycloakUserRole{}),
 		func(c *Client) {
-			_, err := c.ListAvailableGroupClientRoles(realm.Spec.Realm.Realm, groupID, clientID)
+			_, err := c.ListAvailableGroupClientRoles(context.TODO(), realm.Spec.Realm.Realm, groupID, clientID)
 			assert.NoError(t, err)
 		},
 	)
@@ -520,8 +1475,32 @@ func TestClient_UpdateAuthenticationExecutionForFlow(t *testing.T) {
 	testClientHTTPRequest(
 		withPathAssertion(t, 200, requestPath),
 		func(c *Client) {
-			err := c.UpdateAuthenticationExecutionForFlow(flowAlias, realm.Spec.Realm.Realm, &v1alpha1.AuthenticationExecutionInfo{})
+			err := c.UpdateAuthenticationExecutionForFlow(context.TODO(), flowAlias, realm.Spec.Realm.Realm, &v1alpha1.AuthenticationExecutionInfo{})
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_GetAuthenticatorConfig(t *testing.T) {
+	realm := getDummyRealm()
+	const configID string = "config-12345"
+	expectedPath := fmt.Sprintf(AuthenticatorConfigPath, realm.Spec.Realm.Realm, configID)
+
+	testClientHTTPRequest(
+		withPathAssertionBody(t, 200, expectedPath, &v1alpha1.AuthenticatorConfig{Alias: "otp"}),
+		func(c *Client) {
+			config, err := c.GetAuthenticatorConfig(context.TODO(), configID, realm.Spec.Realm.Realm)
 			assert.NoError(t, err)
+			assert.Equal(t, "otp", config.Alias)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, expectedPath),
+		func(c *Client) {
+			config, err := c.GetAuthenticatorConfig(context.TODO(), configID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+			assert.Nil(t, config)
 		},
 	)
 }
@@ -534,7 +1513,7 @@ func TestClient_CreateGroupRealmRole(t *testing.T) {
 	testClientHTTPRequest(
 		withPathAssertion(t, 201, expectedPath),
 		func(c *Client) {
-			_, err := c.CreateGroupRealmRole(&v1alpha1.KeycloakUserRole{}, realm.Spec.Realm.Realm, groupID)
+			_, err := c.CreateGroupRealmRole(context.TODO(), &v1alpha1.KeycloakUserRole{}, realm.Spec.Realm.Realm, groupID)
 			assert.NoError(t, err)
 		},
 	)
@@ -546,9 +1525,10 @@ func TestClient_ListGroupRealmRoles(t *testing.T) {
 	expectedPath := fmt.Sprintf(GroupGetRealmRoles, realm.Spec.Realm.Realm, groupID)
 
 	testClientHTTPRequest(
-		withPathAssertion(t, 200, expectedPath),
+		withPathAssertionBody(t, 200, expectedPath, []*v1alpha1.KeycloakUserRole{}),
 		func(c *Client) {
 			_, err := c.ListGroupRealmRoles(
+				context.TODO(),
 				realm.Spec.Realm.Realm, groupID)
 
 			assert.NoError(t, err)
@@ -562,123 +1542,3968 @@ func TestClient_ListAvailableGroupRealmRoles(t *testing.T) {
 	expectedPath := fmt.Sprintf(GroupGetAvailableRealmRoles, realm.Spec.Realm.Realm, groupID)
 
 	testClientHTTPRequest(
-		withPathAssertion(t, 200, expectedPath),
+		withPathAssertionBody(t, 200, expectedPath, []*v1alpha1.KeycloakUserRole{}),
 		func(c *Client) {
-			_, err := c.ListAvailableGroupRealmRoles(
+			roles, err := c.ListAvailableGroupRealmRoles(
+				context.TODO(),
 				realm.Spec.Realm.Realm, groupID)
 
 			assert.NoError(t, err)
+			assert.NotNil(t, roles)
+			assert.Empty(t, roles)
 		},
 	)
-}
-
-// Utility function to create a test server, register a given handler and perform
-// a client function to be tested
-func testClientHTTPRequest(
-	testHandler http.HandlerFunc,
-	testRequest func(c *Client),
-) {
-	handler := http.HandlerFunc(testHandler)
-	server := httptest.NewServer(handler)
-	defer server.Close()
 
-	client := Client{
-		requester: server.Client(),
-		URL:       server.URL,
-		token:     "not set",
+	role := &v1alpha1.KeycloakUserRole{
+		ID:          "role-12345",
+		Name:        "offline_access",
+		Composite:   true,
+		ContainerID: realm.Spec.Realm.Realm,
 	}
+	testClientHTTPRequest(
+		withPathAssertionBody(t, 200, expectedPath, []*v1alpha1.KeycloakUserRole{role}),
+		func(c *Client) {
+			roles, err := c.ListAvailableGroupRealmRoles(
+				context.TODO(),
+				realm.Spec.Realm.Realm, groupID)
 
-	testRequest(&client)
+			assert.NoError(t, err)
+			assert.Equal(t, []*v1alpha1.KeycloakUserRole{role}, roles)
+		},
+	)
 }
 
-func respondWithJSON(body interface{}, w http.ResponseWriter) (int, error) {
-	json, err := json.Marshal(body)
-	if err != nil {
-		return 0, err
-	}
+func TestClient_ListIdentityProviders(t *testing.T) {
+	realm := getDummyRealm()
+	expectedPath := fmt.Sprintf(IdentityProviderListPath, realm.Spec.Realm.Realm)
 
-	return w.Write(json)
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath, []*IdentityProvider{
+				{
+					Alias:                     "github",
+					DisplayName:               "GitHub",
+					ProviderID:                "github",
+					Enabled:                   true,
+					FirstBrokerLoginFlowAlias: "first broker login",
+					Config: map[string]string{
+						"clientId": "dummy-client",
+					},
+				},
+			}),
+		}),
+		func(c *Client) {
+			providers, err := c.ListIdentityProviders(context.TODO(), realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Len(t, providers, 1)
+			assert.Equal(t, "github", providers[0].Alias)
+			assert.Equal(t, "dummy-client", providers[0].Config["clientId"])
+		},
+	)
 }
 
-func withJSON(t *testing.T, body interface{}, status int) http.HandlerFunc {
-	return func(w http.ResponseWriter, req *http.Request) {
-		_, err := respondWithJSON(body, w)
-		w.WriteHeader(status)
-		assert.NoError(t, err)
-	}
-}
+func TestClient_CreateIdentityProvider(t *testing.T) {
+	realm := getDummyRealm()
+	expectedPath := fmt.Sprintf(IdentityProviderListPath, realm.Spec.Realm.Realm)
 
-func withPathAssertion(t *testing.T, status int, expectedPath string) func(http.ResponseWriter, *http.Request) {
-	return func(w http.ResponseWriter, req *http.Request) {
-		assert.Equal(t, expectedPath, req.URL.Path)
-		w.WriteHeader(status)
+	providers := []*IdentityProvider{
+		{
+			Alias:      "oidc",
+			ProviderID: "oidc",
+			Enabled:    true,
+			Config: map[string]string{
+				"clientId":         "dummy-client",
+				"clientSecret":     "dummy-secret",
+				"authorizationUrl": "https://idp.example.com/auth",
+			},
+		},
+		{
+			Alias:      "saml",
+			ProviderID: "saml",
+			Enabled:    true,
+			Config: map[string]string{
+				"singleSignOnServiceUrl": "https://idp.example.com/saml",
+				"nameIDPolicyFormat":     "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent",
+			},
+		},
 	}
-}
 
-func withPathAssertionLocationHeader(t *testing.T, status int, expectedPath string, uid string) func(http.ResponseWriter, *http.Request) {
-	return func(w http.ResponseWriter, req *http.Request) {
-		assert.Equal(t, expectedPath, req.URL.Path)
-		w.Header().Set("Location", fmt.Sprintf("%s/%s", req.URL.Path, uid))
-		w.WriteHeader(status)
+	for _, provider := range providers {
+		testClientHTTPRequest(
+			withMethodSelection(t, map[string]http.HandlerFunc{
+				http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+					assert.Equal(t, expectedPath, req.URL.Path)
+					received := &IdentityProvider{}
+					assert.NoError(t, json.NewDecoder(req.Body).Decode(received))
+					assert.Equal(t, provider, received)
+					w.WriteHeader(201)
+				},
+			}),
+			func(c *Client) {
+				err := c.CreateIdentityProvider(context.TODO(), provider, realm.Spec.Realm.Realm)
+				assert.NoError(t, err)
+			},
+		)
 	}
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 409, expectedPath),
+		func(c *Client) {
+			err := c.CreateIdentityProvider(context.TODO(), providers[0], realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrAlreadyExists))
+		},
+	)
 }
 
-func withMethodSelection(t *testing.T, byMethod map[string]http.HandlerFunc) http.HandlerFunc {
-	return func(w http.ResponseWriter, req *http.Request) {
-		methodFunc, ok := byMethod[req.Method]
-		assert.True(t, ok)
+func TestClient_GetIdentityProvider(t *testing.T) {
+	realm := getDummyRealm()
+	const alias = "github"
+	expectedPath := fmt.Sprintf(IdentityProviderGetPath, realm.Spec.Realm.Realm, alias)
 
-		methodFunc(w, req)
+	testClientHTTPRequest(
+		withPathAssertionBody(t, 200, expectedPath, &IdentityProvider{
+			Alias:      alias,
+			ProviderID: "github",
+			Enabled:    true,
+		}),
+		func(c *Client) {
+			provider, err := c.GetIdentityProvider(context.TODO(), alias, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, alias, provider.Alias)
+			assert.True(t, provider.Enabled)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, expectedPath),
+		func(c *Client) {
+			provider, err := c.GetIdentityProvider(context.TODO(), alias, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+			assert.Nil(t, provider)
+		},
+	)
+}
+
+func TestClient_DeleteIdentityProvider(t *testing.T) {
+	realm := getDummyRealm()
+
+	for _, alias := range []string{"github", "corp-sso", "idp.example.com", "my-idp.v2"} {
+		expectedPath := fmt.Sprintf(IdentityProviderGetPath, realm.Spec.Realm.Realm, alias)
+
+		testClientHTTPRequest(
+			withMethodSelection(t, map[string]http.HandlerFunc{
+				http.MethodDelete: withPathAssertion(t, 204, expectedPath),
+			}),
+			func(c *Client) {
+				err := c.DeleteIdentityProvider(context.TODO(), alias, realm.Spec.Realm.Realm)
+				assert.NoError(t, err)
+			},
+		)
 	}
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, fmt.Sprintf(IdentityProviderGetPath, realm.Spec.Realm.Realm, "missing")),
+		func(c *Client) {
+			err := c.DeleteIdentityProvider(context.TODO(), "missing", realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
 }
 
-func withPathAssertionBody(t *testing.T, status int, expectedPath string, body interface{}) http.HandlerFunc {
-	return func(w http.ResponseWriter, req *http.Request) {
-		assert.Equal(t, expectedPath, req.URL.Path)
-		withJSON(t, body, status)(w, req)
+func TestClient_UpdateIdentityProvider(t *testing.T) {
+	realm := getDummyRealm()
+	provider := &IdentityProvider{
+		Alias:      "github",
+		ProviderID: "github",
+		Enabled:    true,
+		Config: map[string]string{
+			"clientId":     "dummy-client",
+			"clientSecret": "dummy-secret",
+			"defaultScope": "user:email",
+		},
 	}
+	expectedPath := fmt.Sprintf(IdentityProviderGetPath, realm.Spec.Realm.Realm, provider.Alias)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, expectedPath, req.URL.Path)
+				received := &IdentityProvider{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(received))
+				assert.Equal(t, provider.Config, received.Config)
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.UpdateIdentityProvider(context.TODO(), provider, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	// when the alias is missing no request is made
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{}),
+		func(c *Client) {
+			err := c.UpdateIdentityProvider(context.TODO(), &IdentityProvider{ProviderID: "github"}, realm.Spec.Realm.Realm)
+			assert.Error(t, err)
+		},
+	)
 }
 
-func testClientMethod(t *testing.T, method func(*Client, ...[]interface{}) (T, error)) func(*Client) {
-	return func(c *Client) {
-		_, err := method(c)
-		assert.NoError(t, err)
+func TestClient_ListIdentityProviderMappers(t *testing.T) {
+	realm := getDummyRealm()
+	const alias = "github"
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(IdentityProviderMappersPath, realm.Spec.Realm.Realm, alias), []*IdentityProviderMapper{
+				{
+					ID:                     "mapper-12345",
+					Name:                   "email",
+					IdentityProviderAlias:  alias,
+					IdentityProviderMapper: "oidc-user-attribute-idp-mapper",
+					Config: map[string]string{
+						"claim":          "email",
+						"user.attribute": "email",
+					},
+				},
+			}),
+		}),
+		func(c *Client) {
+			mappers, err := c.ListIdentityProviderMappers(context.TODO(), alias, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Len(t, mappers, 1)
+			assert.Equal(t, "email", mappers[0].Config["claim"])
+		},
+	)
+}
+
+func TestClient_CreateIdentityProviderMapper(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		alias    = "github"
+		mapperID = "mapper-12345"
+	)
+	expectedPath := fmt.Sprintf(IdentityProviderMappersPath, realm.Spec.Realm.Realm, alias)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionLocationHeader(t, 201, expectedPath, mapperID),
+		}),
+		func(c *Client) {
+			mapper := &IdentityProviderMapper{
+				Name:                   "email",
+				IdentityProviderAlias:  alias,
+				IdentityProviderMapper: "oidc-user-attribute-idp-mapper",
+			}
+			err := c.CreateIdentityProviderMapper(context.TODO(), alias, realm.Spec.Realm.Realm, mapper)
+			assert.NoError(t, err)
+			assert.Equal(t, mapperID, mapper.ID)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 409, expectedPath),
+		func(c *Client) {
+			err := c.CreateIdentityProviderMapper(context.TODO(), alias, realm.Spec.Realm.Realm, &IdentityProviderMapper{Name: "email"})
+			assert.True(t, errors.Is(err, ErrAlreadyExists))
+		},
+	)
+}
+
+func TestClient_UpdateIdentityProviderMapper(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		alias    = "github"
+		mapperID = "mapper-12345"
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: withPathAssertion(t, 204, fmt.Sprintf(IdentityProviderMapperPath, realm.Spec.Realm.Realm, alias, mapperID)),
+		}),
+		func(c *Client) {
+			err := c.UpdateIdentityProviderMapper(context.TODO(), alias, realm.Spec.Realm.Realm, &IdentityProviderMapper{ID: mapperID, Name: "email"})
+			assert.NoError(t, err)
+		},
+	)
+
+	// when the mapper ID is missing no request is made
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{}),
+		func(c *Client) {
+			err := c.UpdateIdentityProviderMapper(context.TODO(), alias, realm.Spec.Realm.Realm, &IdentityProviderMapper{Name: "email"})
+			assert.Error(t, err)
+		},
+	)
+}
+
+func TestClient_DeleteIdentityProviderMapper(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		alias    = "github"
+		mapperID = "mapper-12345"
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, fmt.Sprintf(IdentityProviderMapperPath, realm.Spec.Realm.Realm, alias, mapperID)),
+		}),
+		func(c *Client) {
+			err := c.DeleteIdentityProviderMapper(context.TODO(), alias, mapperID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestIdentityProvider_ConfigRoundTrip(t *testing.T) {
+	provider := &IdentityProvider{
+		Alias:      "saml",
+		ProviderID: "saml",
+		Config: map[string]string{
+			"singleSignOnServiceUrl":  "https://idp.example.com/saml",
+			"wantAuthnRequestsSigned": "true",
+		},
 	}
+
+	data, err := json.Marshal(provider)
+	assert.NoError(t, err)
+
+	decoded := &IdentityProvider{}
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, provider.Config, decoded.Config)
 }
 
-func TestClient_login(t *testing.T) {
-	// given
-	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-		assert.Equal(t, TokenPath, req.URL.Path)
-		assert.Equal(t, req.Method, http.MethodPost)
+func getDummyClientScope() *ClientScope {
+	return &ClientScope{
+		ID:          "scope-12345",
+		Name:        "groups",
+		Description: "Group membership",
+		Protocol:    "openid-connect",
+		Attributes: map[string]string{
+			"include.in.token.scope":    "true",
+			"display.on.consent.screen": "false",
+		},
+	}
+}
 
-		response := v1alpha1.TokenResponse{
-			AccessToken: "dummy",
+func TestClient_CreateClientScope(t *testing.T) {
+	realm := getDummyRealm()
+	expectedPath := fmt.Sprintf(ClientScopeListPath, realm.Spec.Realm.Realm)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionLocationHeader(t, 201, expectedPath, "scope-12345"),
+		}),
+		func(c *Client) {
+			scope := &ClientScope{Name: "groups", Protocol: "openid-connect"}
+			err := c.CreateClientScope(context.TODO(), scope, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, "scope-12345", scope.ID)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 409, expectedPath),
+		func(c *Client) {
+			err := c.CreateClientScope(context.TODO(), &ClientScope{Name: "groups"}, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrAlreadyExists))
+		},
+	)
+}
+
+func TestClient_CopyClientScope(t *testing.T) {
+	realm := getDummyRealm()
+	source := getDummyClientScope()
+	const newScopeID string = "scope-67890"
+	audienceMapper := &ProtocolMapper{ID: "mapper-67890", Name: "audience", Protocol: "openid-connect", ProtocolMapper: "oidc-audience-mapper"}
+
+	handler := func(mapperStatus int, created *[]*ProtocolMapper) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			switch {
+			case req.Method == http.MethodGet && req.URL.Path == fmt.Sprintf(ClientScopeGetPath, realm.Spec.Realm.Realm, source.ID):
+				_, err := respondWithJSON(source, w)
+				assert.NoError(t, err)
+			case req.Method == http.MethodGet && req.URL.Path == fmt.Sprintf(ClientScopeProtocolMappersPath, realm.Spec.Realm.Realm, source.ID):
+				_, err := respondWithJSON([]*ProtocolMapper{getDummyProtocolMapper(), audienceMapper}, w)
+				assert.NoError(t, err)
+			case req.Method == http.MethodPost && req.URL.Path == fmt.Sprintf(ClientScopeListPath, realm.Spec.Realm.Realm):
+				scope := &ClientScope{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(scope))
+				assert.Empty(t, scope.ID)
+				assert.Equal(t, "groups-tenant-a", scope.Name)
+				assert.Equal(t, source.Attributes, scope.Attributes)
+				withPathAssertionLocationHeader(t, 201, req.URL.Path, newScopeID)(w, req)
+			case req.Method == http.MethodPost && req.URL.Path == fmt.Sprintf(ClientScopeProtocolMappersPath, realm.Spec.Realm.Realm, newScopeID):
+				if len(*created) > 0 && mapperStatus != 201 {
+					w.WriteHeader(mapperStatus)
+					return
+				}
+				mapper := &ProtocolMapper{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(mapper))
+				assert.Empty(t, mapper.ID)
+				*created = append(*created, mapper)
+				withPathAssertionLocationHeader(t, 201, req.URL.Path, "mapper-new")(w, req)
+			default:
+				t.Errorf("unexpected %s request to %s", req.Method, req.URL.Path)
+			}
 		}
+	}
 
-		json, err := jsoniter.Marshal(response)
-		assert.NoError(t, err)
+	var created []*ProtocolMapper
+	testClientHTTPRequest(
+		handler(201, &created),
+		func(c *Client) {
+			scopeID, err := c.CopyClientScope(context.TODO(), source.ID, "groups-tenant-a", realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, newScopeID, scopeID)
+			assert.Len(t, created, 2)
+			assert.Equal(t, "audience", created[1].Name)
+		},
+	)
 
-		size, err := w.Write(json)
-		assert.NoError(t, err)
-		assert.Equal(t, size, len(json))
+	// when the second mapper can't be created
+	created = nil
+	testClientHTTPRequest(
+		handler(500, &created),
+		func(c *Client) {
+			scopeID, err := c.CopyClientScope(context.TODO(), source.ID, "groups-tenant-a", realm.Spec.Realm.Realm)
+			// then the partially created scope is reported
+			assert.Equal(t, newScopeID, scopeID)
+			incomplete, ok := err.(*ClientScopeCopyIncompleteError)
+			assert.True(t, ok)
+			assert.Equal(t, newScopeID, incomplete.ScopeID)
+		},
+	)
+}
 
-		w.WriteHeader(204)
-	})
-	server := httptest.NewServer(handler)
-	defer server.Close()
+func TestClient_UpdateClientScope(t *testing.T) {
+	realm := getDummyRealm()
+	scope := getDummyClientScope()
 
-	client := Client{
-		requester: server.Client(),
-		URL:       server.URL,
-		token:     "not set",
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: withPathAssertion(t, 204, fmt.Sprintf(ClientScopeGetPath, realm.Spec.Realm.Realm, scope.ID)),
+		}),
+		func(c *Client) {
+			err := c.UpdateClientScope(context.TODO(), scope, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_DeleteClientScope(t *testing.T) {
+	realm := getDummyRealm()
+	scope := getDummyClientScope()
+	expectedPath := fmt.Sprintf(ClientScopeGetPath, realm.Spec.Realm.Realm, scope.ID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.DeleteClientScope(context.TODO(), scope.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, expectedPath),
+		func(c *Client) {
+			err := c.DeleteClientScope(context.TODO(), scope.ID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+
+			var apiErr *APIError
+			assert.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+		},
+	)
+}
+
+func TestClient_ListClientScopes(t *testing.T) {
+	realm := getDummyRealm()
+	scope := getDummyClientScope()
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(ClientScopeListPath, realm.Spec.Realm.Realm), []*ClientScope{scope}),
+		}),
+		func(c *Client) {
+			scopes, err := c.ListClientScopes(context.TODO(), realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, []*ClientScope{scope}, scopes)
+		},
+	)
+}
+
+func TestClient_FindClientScopeByName(t *testing.T) {
+	realm := getDummyRealm()
+	scopes := []*ClientScope{
+		{ID: "scope-12345", Name: "groups-audience"},
+		{ID: "scope-67890", Name: "groups"},
 	}
 
-	// when
-	err := client.login("dummy", "dummy")
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(ClientScopeListPath, realm.Spec.Realm.Realm), scopes),
+		}),
+		func(c *Client) {
+			// when the scope exists, only the exact name matches
+			scope, err := c.FindClientScopeByName(context.TODO(), "groups", realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, "scope-67890", scope.ID)
 
-	// then
-	// token must be set on the client now
-	assert.NoError(t, err)
-	assert.Equal(t, client.token, "dummy")
+			// when the scope doesn't exist
+			scope, err = c.FindClientScopeByName(context.TODO(), "audience", realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Nil(t, scope)
+		},
+	)
+}
+
+func TestClient_GetClientScope(t *testing.T) {
+	realm := getDummyRealm()
+	scope := getDummyClientScope()
+	expectedPath := fmt.Sprintf(ClientScopeGetPath, realm.Spec.Realm.Realm, scope.ID)
+
+	testClientHTTPRequest(
+		withPathAssertionBody(t, 200, expectedPath, scope),
+		func(c *Client) {
+			found, err := c.GetClientScope(context.TODO(), scope.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, scope, found)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, expectedPath),
+		func(c *Client) {
+			found, err := c.GetClientScope(context.TODO(), scope.ID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+			assert.Nil(t, found)
+		},
+	)
+}
+
+func TestClient_AssignClientScopeToClient(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		clientID = "client-12345"
+		scopeID  = "scope-12345"
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: withPathAssertion(t, 204, fmt.Sprintf(ClientDefaultClientScopePath, realm.Spec.Realm.Realm, clientID, scopeID)),
+		}),
+		func(c *Client) {
+			err := c.AssignDefaultClientScopeToClient(context.TODO(), clientID, scopeID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: withPathAssertion(t, 204, fmt.Sprintf(ClientOptionalClientScopePath, realm.Spec.Realm.Realm, clientID, scopeID)),
+		}),
+		func(c *Client) {
+			err := c.AssignOptionalClientScopeToClient(context.TODO(), clientID, scopeID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_RemoveClientScopeFromClient(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		clientID = "client-12345"
+		scopeID  = "scope-12345"
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, fmt.Sprintf(ClientDefaultClientScopePath, realm.Spec.Realm.Realm, clientID, scopeID)),
+		}),
+		func(c *Client) {
+			err := c.RemoveDefaultClientScopeFromClient(context.TODO(), clientID, scopeID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, fmt.Sprintf(ClientOptionalClientScopePath, realm.Spec.Realm.Realm, clientID, scopeID)),
+		}),
+		func(c *Client) {
+			err := c.RemoveOptionalClientScopeFromClient(context.TODO(), clientID, scopeID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_ListClientScopesForClient(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID = "client-12345"
+	scopes := []*ClientScope{{ID: "scope-12345", Name: "tenant"}}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(ClientDefaultClientScopesPath, realm.Spec.Realm.Realm, clientID), scopes),
+		}),
+		func(c *Client) {
+			result, err := c.ListDefaultClientScopesForClient(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, scopes, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(ClientOptionalClientScopesPath, realm.Spec.Realm.Realm, clientID), scopes),
+		}),
+		func(c *Client) {
+			result, err := c.ListOptionalClientScopesForClient(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, scopes, result)
+		},
+	)
+}
+
+// Utility function to create a test server, register a given handler and perform
+// a client function to be tested
+func testClientHTTPRequest(
+	testHandler http.HandlerFunc,
+	testRequest func(c *Client),
+) {
+	handler := http.HandlerFunc(testHandler)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := Client{
+		requester: server.Client(),
+		URL:       server.URL,
+		token:     "not set",
+	}
+
+	testRequest(&client)
+}
+
+func respondWithJSON(body interface{}, w http.ResponseWriter) (int, error) {
+	json, err := json.Marshal(body)
+	if err != nil {
+		return 0, err
+	}
+
+	return w.Write(json)
+}
+
+func withJSON(t *testing.T, body interface{}, status int) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		_, err := respondWithJSON(body, w)
+		w.WriteHeader(status)
+		assert.NoError(t, err)
+	}
+}
+
+func withPathAssertion(t *testing.T, status int, expectedPath string) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, expectedPath, req.URL.Path)
+		w.WriteHeader(status)
+	}
+}
+
+func withPathAssertionLocationHeader(t *testing.T, status int, expectedPath string, uid string) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, expectedPath, req.URL.Path)
+		w.Header().Set("Location", fmt.Sprintf("%s/%s", req.URL.Path, uid))
+		w.WriteHeader(status)
+	}
+}
+
+func withMethodSelection(t *testing.T, byMethod map[string]http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		methodFunc, ok := byMethod[req.Method]
+		assert.True(t, ok)
+
+		methodFunc(w, req)
+	}
+}
+
+func withPathAssertionBody(t *testing.T, status int, expectedPath string, body interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, expectedPath, req.URL.Path)
+		withJSON(t, body, status)(w, req)
+	}
+}
+
+func testClientMethod(t *testing.T, method func(*Client, ...[]interface{}) (T, error)) func(*Client) {
+	return func(c *Client) {
+		_, err := method(c)
+		assert.NoError(t, err)
+	}
+}
+
+func TestClient_login(t *testing.T) {
+	// given
+	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, TokenPath, req.URL.Path)
+		assert.Equal(t, req.Method, http.MethodPost)
+
+		response := v1alpha1.TokenResponse{
+			AccessToken: "dummy",
+		}
+
+		json, err := jsoniter.Marshal(response)
+		assert.NoError(t, err)
+
+		size, err := w.Write(json)
+		assert.NoError(t, err)
+		assert.Equal(t, size, len(json))
+
+		w.WriteHeader(204)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := Client{
+		requester: server.Client(),
+		URL:       server.URL,
+		token:     "not set",
+	}
+
+	// when
+	err := client.login(context.TODO(), "dummy", "dummy")
+
+	// then
+	// token must be set on the client now
+	assert.NoError(t, err)
+	assert.Equal(t, client.token, "dummy")
+}
+
+func getDummyProtocolMapper() *ProtocolMapper {
+	return &ProtocolMapper{
+		ID:             "mapper-12345",
+		Name:           "groups",
+		Protocol:       "openid-connect",
+		ProtocolMapper: "oidc-group-membership-mapper",
+		Config: map[string]string{
+			"claim.name":     "groups",
+			"full.path":      "false",
+			"id.token.claim": "true",
+		},
+	}
+}
+
+func TestClient_ListProtocolMappersForClient(t *testing.T) {
+	realm := getDummyRealm()
+	mapper := getDummyProtocolMapper()
+	const clientID string = "client-12345"
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(ClientProtocolMappersPath, realm.Spec.Realm.Realm, clientID), []*ProtocolMapper{mapper}),
+		}),
+		func(c *Client) {
+			mappers, err := c.ListProtocolMappersForClient(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, []*ProtocolMapper{mapper}, mappers)
+		},
+	)
+}
+
+func TestClient_EvaluateScopes(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	mapper := &ProtocolMapperEvaluation{
+		MapperID:       "mapper-12345",
+		MapperName:     "email",
+		ProtocolMapper: "oidc-usermodel-property-mapper",
+		ContainerID:    "scope-12345",
+		ContainerName:  "email",
+		ContainerType:  "client-scope",
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(ClientEvaluateScopesMappersPath, realm.Spec.Realm.Realm, clientID), req.URL.Path)
+				assert.Equal(t, "openid email", req.URL.Query().Get("scope"))
+				_, err := respondWithJSON([]*ProtocolMapperEvaluation{mapper}, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			mappers, err := c.ListEvaluatedProtocolMappers(context.TODO(), clientID, realm.Spec.Realm.Realm, "openid email")
+			assert.NoError(t, err)
+			assert.Equal(t, []*ProtocolMapperEvaluation{mapper}, mappers)
+		},
+	)
+
+	token := `{"sub":"user-12345","email":"dummy@example.com"}`
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(ClientExampleAccessTokenPath, realm.Spec.Realm.Realm, clientID), req.URL.Path)
+				assert.Equal(t, url.Values{"userId": {"user-12345"}, "scope": {"openid email"}}, req.URL.Query())
+				_, err := w.Write([]byte(token))
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			result, err := c.GenerateExampleAccessToken(context.TODO(), clientID, realm.Spec.Realm.Realm, "user-12345", "openid email")
+			assert.NoError(t, err)
+			assert.Equal(t, token, result)
+		},
+	)
+
+	// the optional query parameters are omitted
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(ClientExampleAccessTokenPath, realm.Spec.Realm.Realm, clientID), req.URL.Path)
+				assert.Empty(t, req.URL.RawQuery)
+				_, err := w.Write([]byte(token))
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			_, err := c.GenerateExampleAccessToken(context.TODO(), clientID, realm.Spec.Realm.Realm, "", "")
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_CreateProtocolMapperForClient(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionLocationHeader(t, 201, fmt.Sprintf(ClientProtocolMappersPath, realm.Spec.Realm.Realm, clientID), "mapper-12345"),
+		}),
+		func(c *Client) {
+			mapper := getDummyProtocolMapper()
+			mapper.ID = ""
+			err := c.CreateProtocolMapperForClient(context.TODO(), clientID, realm.Spec.Realm.Realm, mapper)
+			assert.NoError(t, err)
+			assert.Equal(t, "mapper-12345", mapper.ID)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 409, fmt.Sprintf(ClientProtocolMappersPath, realm.Spec.Realm.Realm, clientID)),
+		func(c *Client) {
+			err := c.CreateProtocolMapperForClient(context.TODO(), clientID, realm.Spec.Realm.Realm, getDummyProtocolMapper())
+			assert.True(t, errors.Is(err, ErrAlreadyExists))
+		},
+	)
+}
+
+func TestClient_UpdateProtocolMapperForClient(t *testing.T) {
+	realm := getDummyRealm()
+	mapper := getDummyProtocolMapper()
+	const clientID string = "client-12345"
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(ClientProtocolMapperPath, realm.Spec.Realm.Realm, clientID, mapper.ID), req.URL.Path)
+				sent := &ProtocolMapper{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(sent))
+				assert.Equal(t, mapper, sent)
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.UpdateProtocolMapperForClient(context.TODO(), clientID, realm.Spec.Realm.Realm, mapper)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{}),
+		func(c *Client) {
+			err := c.UpdateProtocolMapperForClient(context.TODO(), clientID, realm.Spec.Realm.Realm, &ProtocolMapper{Name: "audience"})
+			assert.Error(t, err)
+		},
+	)
+}
+
+func TestClient_DeleteProtocolMapperForClient(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		clientID = "client-12345"
+		mapperID = "mapper-12345"
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, fmt.Sprintf(ClientProtocolMapperPath, realm.Spec.Realm.Realm, clientID, mapperID)),
+		}),
+		func(c *Client) {
+			err := c.DeleteProtocolMapperForClient(context.TODO(), clientID, mapperID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_CreateProtocolMapperForClientScope(t *testing.T) {
+	realm := getDummyRealm()
+	scope := getDummyClientScope()
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionLocationHeader(t, 201, fmt.Sprintf(ClientScopeProtocolMappersPath, realm.Spec.Realm.Realm, scope.ID), "mapper-12345"),
+		}),
+		func(c *Client) {
+			mapper := getDummyProtocolMapper()
+			mapper.ID = ""
+			err := c.CreateProtocolMapperForClientScope(context.TODO(), scope.ID, realm.Spec.Realm.Realm, mapper)
+			assert.NoError(t, err)
+			assert.Equal(t, "mapper-12345", mapper.ID)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertion(t, 409, fmt.Sprintf(ClientScopeProtocolMappersPath, realm.Spec.Realm.Realm, scope.ID)),
+		}),
+		func(c *Client) {
+			err := c.CreateProtocolMapperForClientScope(context.TODO(), scope.ID, realm.Spec.Realm.Realm, getDummyProtocolMapper())
+			assert.True(t, errors.Is(err, ErrAlreadyExists))
+		},
+	)
+}
+
+func TestClient_ListProtocolMappersForClientScope(t *testing.T) {
+	realm := getDummyRealm()
+	scope := getDummyClientScope()
+	mapper := getDummyProtocolMapper()
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(ClientScopeProtocolMappersPath, realm.Spec.Realm.Realm, scope.ID), []*ProtocolMapper{mapper}),
+		}),
+		func(c *Client) {
+			mappers, err := c.ListProtocolMappersForClientScope(context.TODO(), scope.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, []*ProtocolMapper{mapper}, mappers)
+		},
+	)
+}
+
+func TestClient_UpdateProtocolMapperForClientScope(t *testing.T) {
+	realm := getDummyRealm()
+	scope := getDummyClientScope()
+	mapper := getDummyProtocolMapper()
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(ClientScopeProtocolMapperPath, realm.Spec.Realm.Realm, scope.ID, mapper.ID), req.URL.Path)
+				sent := &ProtocolMapper{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(sent))
+				assert.Equal(t, mapper, sent)
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.UpdateProtocolMapperForClientScope(context.TODO(), scope.ID, realm.Spec.Realm.Realm, mapper)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{}),
+		func(c *Client) {
+			err := c.UpdateProtocolMapperForClientScope(context.TODO(), scope.ID, realm.Spec.Realm.Realm, &ProtocolMapper{Name: "groups"})
+			assert.EqualError(t, err, "protocol mapper ID must be set")
+		},
+	)
+}
+
+func TestClient_ClientScopeRealmScopeMappings(t *testing.T) {
+	realm := getDummyRealm()
+	scope := getDummyClientScope()
+	expectedPath := fmt.Sprintf(ClientScopeRealmScopeMappingsPath, realm.Spec.Realm.Realm, scope.ID)
+	roles := []*v1alpha1.KeycloakUserRole{{ID: "role-12345", Name: "offline_access"}}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				switch req.URL.Path {
+				case expectedPath:
+					_, err := respondWithJSON(roles, w)
+					assert.NoError(t, err)
+				case expectedPath + "/available":
+					_, err := respondWithJSON([]*v1alpha1.KeycloakUserRole{{ID: "role-67890", Name: "uma_authorization"}}, w)
+					assert.NoError(t, err)
+				default:
+					t.Errorf("unexpected request to %s", req.URL.Path)
+				}
+			},
+		}),
+		func(c *Client) {
+			found, err := c.ListClientScopeRealmScopeMappings(context.TODO(), scope.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, roles, found)
+
+			available, err := c.ListAvailableClientScopeRealmScopeMappings(context.TODO(), scope.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Len(t, available, 1)
+			assert.Equal(t, "uma_authorization", available[0].Name)
+		},
+	)
+
+	for _, method := range []string{http.MethodPost, http.MethodDelete} {
+		testClientHTTPRequest(
+			withMethodSelection(t, map[string]http.HandlerFunc{
+				method: func(w http.ResponseWriter, req *http.Request) {
+					assert.Equal(t, expectedPath, req.URL.Path)
+					var sent []*v1alpha1.KeycloakUserRole
+					assert.NoError(t, json.NewDecoder(req.Body).Decode(&sent))
+					assert.Equal(t, roles, sent)
+					w.WriteHeader(204)
+				},
+			}),
+			func(c *Client) {
+				var err error
+				if method == http.MethodPost {
+					err = c.AddClientScopeRealmScopeMappings(context.TODO(), scope.ID, realm.Spec.Realm.Realm, roles)
+				} else {
+					err = c.DeleteClientScopeRealmScopeMappings(context.TODO(), scope.ID, realm.Spec.Realm.Realm, roles)
+				}
+				assert.NoError(t, err)
+			},
+		)
+	}
+}
+
+func TestClient_ClientScopeClientScopeMappings(t *testing.T) {
+	realm := getDummyRealm()
+	scope := getDummyClientScope()
+	const targetClientID string = "client-12345"
+	expectedPath := fmt.Sprintf(ClientScopeClientMappingsPath, realm.Spec.Realm.Realm, scope.ID, targetClientID)
+	roles := []*v1alpha1.KeycloakUserRole{{ID: "role-12345", Name: "reports-viewer", ClientRole: true}}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				switch req.URL.Path {
+				case expectedPath:
+					_, err := respondWithJSON(roles, w)
+					assert.NoError(t, err)
+				case expectedPath + "/available":
+					_, err := respondWithJSON([]*v1alpha1.KeycloakUserRole{{ID: "role-67890", Name: "reports-editor", ClientRole: true}}, w)
+					assert.NoError(t, err)
+				default:
+					t.Errorf("unexpected request to %s", req.URL.Path)
+				}
+			},
+		}),
+		func(c *Client) {
+			found, err := c.ListClientScopeClientScopeMappings(context.TODO(), scope.ID, targetClientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, roles, found)
+
+			available, err := c.ListAvailableClientScopeClientScopeMappings(context.TODO(), scope.ID, targetClientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Len(t, available, 1)
+			assert.Equal(t, "reports-editor", available[0].Name)
+		},
+	)
+
+	for _, method := range []string{http.MethodPost, http.MethodDelete} {
+		testClientHTTPRequest(
+			withMethodSelection(t, map[string]http.HandlerFunc{
+				method: func(w http.ResponseWriter, req *http.Request) {
+					assert.Equal(t, expectedPath, req.URL.Path)
+					var sent []*v1alpha1.KeycloakUserRole
+					assert.NoError(t, json.NewDecoder(req.Body).Decode(&sent))
+					assert.Equal(t, roles, sent)
+					w.WriteHeader(204)
+				},
+			}),
+			func(c *Client) {
+				var err error
+				if method == http.MethodPost {
+					err = c.AddClientScopeClientScopeMappings(context.TODO(), scope.ID, targetClientID, realm.Spec.Realm.Realm, roles)
+				} else {
+					err = c.DeleteClientScopeClientScopeMappings(context.TODO(), scope.ID, targetClientID, realm.Spec.Realm.Realm, roles)
+				}
+				assert.NoError(t, err)
+			},
+		)
+	}
+}
+
+func TestClient_DeleteProtocolMapperForClientScope(t *testing.T) {
+	realm := getDummyRealm()
+	scope := getDummyClientScope()
+	const mapperID string = "mapper-12345"
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 404, fmt.Sprintf(ClientScopeProtocolMapperPath, realm.Spec.Realm.Realm, scope.ID, mapperID)),
+		}),
+		func(c *Client) {
+			err := c.DeleteProtocolMapperForClientScope(context.TODO(), scope.ID, mapperID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_ListUserSessions(t *testing.T) {
+	realm := getDummyRealm()
+	user := getDummyUser()
+	session := &UserSession{
+		ID:         "session-12345",
+		Username:   user.UserName,
+		UserID:     user.ID,
+		IPAddress:  "10.0.0.1",
+		Start:      1588000000000,
+		LastAccess: 1588000360000,
+		Clients: map[string]string{
+			"client-12345": "account",
+		},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(UserSessionsPath, realm.Spec.Realm.Realm, user.ID), []*UserSession{session}),
+		}),
+		func(c *Client) {
+			sessions, err := c.ListUserSessions(context.TODO(), user.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, []*UserSession{session}, sessions)
+		},
+	)
+}
+
+func TestClient_DeleteUserSession(t *testing.T) {
+	realm := getDummyRealm()
+	const sessionID string = "session-12345"
+	expectedPath := fmt.Sprintf(SessionPath, realm.Spec.Realm.Realm, sessionID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.DeleteUserSession(context.TODO(), sessionID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 404, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.DeleteUserSession(context.TODO(), sessionID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_ListClientSessions(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(ClientSessionsPath, realm.Spec.Realm.Realm, clientID), req.URL.Path)
+				first, err := strconv.Atoi(req.URL.Query().Get("first"))
+				assert.NoError(t, err)
+				assert.Equal(t, strconv.Itoa(pageSize), req.URL.Query().Get("max"))
+
+				// a full first page followed by a partial one
+				count := pageSize
+				if first > 0 {
+					count = 1
+				}
+				sessions := []*UserSession{}
+				for i := 0; i < count; i++ {
+					sessions = append(sessions, &UserSession{ID: strconv.Itoa(first + i)})
+				}
+				_, err = respondWithJSON(sessions, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			sessions, err := c.ListClientSessions(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Len(t, sessions, pageSize+1)
+			assert.Equal(t, strconv.Itoa(pageSize), sessions[pageSize].ID)
+		},
+	)
+}
+
+func TestClient_ListClientUserSessions(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	sessions := []*UserSession{{ID: "session-12345", Username: "alice", UserID: "user-12345"}}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(ClientSessionsPath, realm.Spec.Realm.Realm, clientID), req.URL.Path)
+				assert.Equal(t, "20", req.URL.Query().Get("first"))
+				assert.Equal(t, "10", req.URL.Query().Get("max"))
+				_, err := respondWithJSON(sessions, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			result, err := c.ListClientUserSessions(context.TODO(), clientID, realm.Spec.Realm.Realm, 20, 10)
+			assert.NoError(t, err)
+			assert.Equal(t, sessions, result)
+		},
+	)
+}
+
+func TestClient_CountClientSessions(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(ClientSessionCountPath, realm.Spec.Realm.Realm, clientID), map[string]int{"count": 7}),
+		}),
+		func(c *Client) {
+			count, err := c.CountClientSessions(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, 7, count)
+		},
+	)
+}
+
+func TestClient_ClientOfflineSessions(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	sessions := []*UserSession{{ID: "session-12345", Username: "alice", UserID: "user-12345"}}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(ClientOfflineSessionsPath, realm.Spec.Realm.Realm, clientID), req.URL.Path)
+				assert.Equal(t, "200", req.URL.Query().Get("first"))
+				assert.Equal(t, "50", req.URL.Query().Get("max"))
+				_, err := respondWithJSON(sessions, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			result, err := c.ListClientOfflineSessions(context.TODO(), clientID, realm.Spec.Realm.Realm, 200, 50)
+			assert.NoError(t, err)
+			assert.Equal(t, sessions, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(ClientOfflineSessionCountPath, realm.Spec.Realm.Realm, clientID), map[string]int{"count": 12000}),
+		}),
+		func(c *Client) {
+			count, err := c.CountClientOfflineSessions(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, 12000, count)
+		},
+	)
+}
+
+func TestClient_GetGroupManagementPermissions(t *testing.T) {
+	realm := getDummyRealm()
+	const groupID string = "12345"
+	expectedPath := fmt.Sprintf(GroupManagementPermissionsPath, realm.Spec.Realm.Realm, groupID)
+	permissions := &ManagementPermissionReference{
+		Enabled:  true,
+		Resource: "resource-12345",
+		ScopePermissions: map[string]string{
+			"manage-members": "permission-12345",
+		},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath, permissions),
+		}),
+		func(c *Client) {
+			found, err := c.GetGroupManagementPermissions(context.TODO(), groupID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, permissions, found)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, expectedPath),
+		func(c *Client) {
+			_, err := c.GetGroupManagementPermissions(context.TODO(), groupID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_UpdateGroupManagementPermissions(t *testing.T) {
+	realm := getDummyRealm()
+	const groupID string = "12345"
+	expectedPath := fmt.Sprintf(GroupManagementPermissionsPath, realm.Spec.Realm.Realm, groupID)
+
+	current := &ManagementPermissionReference{}
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, expectedPath, req.URL.Path)
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(current))
+				current.ScopePermissions = map[string]string{"manage-members": "permission-12345"}
+				w.WriteHeader(200)
+			},
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath, current),
+		}),
+		func(c *Client) {
+			permissions, err := c.UpdateGroupManagementPermissions(context.TODO(), groupID, realm.Spec.Realm.Realm, true)
+			assert.NoError(t, err)
+			assert.True(t, permissions.Enabled)
+			assert.Equal(t, "permission-12345", permissions.ScopePermissions["manage-members"])
+		},
+	)
+}
+
+func TestClient_GetClientManagementPermissions(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	expectedPath := fmt.Sprintf(ClientManagementPermissionsPath, realm.Spec.Realm.Realm, clientID)
+	permissions := &ManagementPermissionReference{
+		Enabled:  true,
+		Resource: "resource-12345",
+		ScopePermissions: map[string]string{
+			"manage":         "permission-12345",
+			"token-exchange": "permission-67890",
+		},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath, permissions),
+		}),
+		func(c *Client) {
+			found, err := c.GetClientManagementPermissions(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, permissions, found)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, expectedPath),
+		func(c *Client) {
+			_, err := c.GetClientManagementPermissions(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_UpdateClientManagementPermissions(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	expectedPath := fmt.Sprintf(ClientManagementPermissionsPath, realm.Spec.Realm.Realm, clientID)
+
+	current := &ManagementPermissionReference{}
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, expectedPath, req.URL.Path)
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(current))
+				current.ScopePermissions = map[string]string{"manage": "permission-12345"}
+				w.WriteHeader(200)
+			},
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath, current),
+		}),
+		func(c *Client) {
+			permissions, err := c.UpdateClientManagementPermissions(context.TODO(), clientID, realm.Spec.Realm.Realm, true)
+			assert.NoError(t, err)
+			assert.True(t, permissions.Enabled)
+			assert.Equal(t, "permission-12345", permissions.ScopePermissions["manage"])
+		},
+	)
+}
+
+func TestClient_ListOfflineSessionsForUser(t *testing.T) {
+	realm := getDummyRealm()
+	user := getDummyUser()
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				var body interface{}
+				switch req.URL.Path {
+				case fmt.Sprintf(UserConsentsPath, realm.Spec.Realm.Realm, user.ID):
+					body = []*UserConsent{{ClientID: "account"}, {ClientID: "cli"}, {ClientID: "deleted"}}
+				case fmt.Sprintf(ClientListPath, realm.Spec.Realm.Realm):
+					// only the clients the user has consented to are looked up
+					switch req.URL.Query().Get("clientId") {
+					case "account":
+						body = []*v1alpha1.KeycloakAPIClient{{ID: "account-12345", ClientID: "account"}}
+					case "cli":
+						body = []*v1alpha1.KeycloakAPIClient{{ID: "cli-12345", ClientID: "cli"}}
+					case "deleted":
+						body = []*v1alpha1.KeycloakAPIClient{}
+					default:
+						t.Errorf("unexpected client lookup %s", req.URL.RawQuery)
+					}
+				case fmt.Sprintf(UserOfflineSessionsPath, realm.Spec.Realm.Realm, user.ID, "account-12345"):
+					body = []*UserSession{}
+				case fmt.Sprintf(UserOfflineSessionsPath, realm.Spec.Realm.Realm, user.ID, "cli-12345"):
+					body = []*UserSession{{ID: "session-12345", UserID: user.ID}}
+				default:
+					t.Errorf("unexpected request to %s", req.URL.Path)
+				}
+				_, err := respondWithJSON(body, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			sessions, err := c.ListOfflineSessionsForUser(context.TODO(), user.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, []*UserSession{{ID: "session-12345", UserID: user.ID}}, sessions)
+		},
+	)
+}
+
+func TestClient_RevokeOfflineSession(t *testing.T) {
+	realm := getDummyRealm()
+	user := getDummyUser()
+	const clientID string = "cli"
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, fmt.Sprintf(UserConsentPath, realm.Spec.Realm.Realm, user.ID, clientID)),
+		}),
+		func(c *Client) {
+			err := c.RevokeOfflineSession(context.TODO(), user.ID, clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_UserCredentials(t *testing.T) {
+	realm := getDummyRealm()
+	user := getDummyUser()
+	credentials := []*Credential{
+		{ID: "credential-12345", Type: "password", CreatedDate: 1600000000000, Priority: 10},
+		{ID: "credential-67890", Type: "otp", UserLabel: "phone", CreatedDate: 1600000300000, Priority: 20},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(UserCredentialsPath, realm.Spec.Realm.Realm, user.ID), credentials),
+		}),
+		func(c *Client) {
+			found, err := c.ListUserCredentials(context.TODO(), user.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, credentials, found)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, fmt.Sprintf(UserCredentialPath, realm.Spec.Realm.Realm, user.ID, "credential-67890")),
+		}),
+		func(c *Client) {
+			err := c.DeleteUserCredential(context.TODO(), user.ID, "credential-67890", realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 404, fmt.Sprintf(UserCredentialPath, realm.Spec.Realm.Realm, user.ID, "credential-67890")),
+		}),
+		func(c *Client) {
+			err := c.DeleteUserCredential(context.TODO(), user.ID, "credential-67890", realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_MoveCredential(t *testing.T) {
+	realm := getDummyRealm()
+	user := getDummyUser()
+	credentialPath := fmt.Sprintf(UserCredentialPath, realm.Spec.Realm.Realm, user.ID, "credential-67890")
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertion(t, 204, credentialPath+"/moveToFirst"),
+		}),
+		func(c *Client) {
+			err := c.MoveCredentialToFirst(context.TODO(), user.ID, "credential-67890", realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertion(t, 204, credentialPath+"/moveAfter/credential-12345"),
+		}),
+		func(c *Client) {
+			err := c.MoveCredentialAfter(context.TODO(), user.ID, "credential-67890", "credential-12345", realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertion(t, 404, credentialPath+"/moveToFirst"),
+		}),
+		func(c *Client) {
+			err := c.MoveCredentialToFirst(context.TODO(), user.ID, "credential-67890", realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_UpdateCredentialLabel(t *testing.T) {
+	realm := getDummyRealm()
+	user := getDummyUser()
+	expectedPath := fmt.Sprintf(UserCredentialPath, realm.Spec.Realm.Realm, user.ID, "credential-67890") + "/userLabel"
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, expectedPath, req.URL.Path)
+				assert.Equal(t, "text/plain", req.Header.Get("Content-Type"))
+				body, err := ioutil.ReadAll(req.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "work phone", string(body))
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.UpdateCredentialLabel(context.TODO(), user.ID, "credential-67890", "work phone", realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: withPathAssertion(t, 404, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.UpdateCredentialLabel(context.TODO(), user.ID, "credential-67890", "work phone", realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_UserConsents(t *testing.T) {
+	realm := getDummyRealm()
+	user := getDummyUser()
+	consents := []*UserConsent{
+		{
+			ClientID:            "cli",
+			GrantedClientScopes: []string{"profile", "email"},
+			CreatedDate:         1600000000000,
+			LastUpdatedDate:     1600000300000,
+		},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(UserConsentsPath, realm.Spec.Realm.Realm, user.ID), consents),
+		}),
+		func(c *Client) {
+			found, err := c.ListUserConsents(context.TODO(), user.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, consents, found)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, fmt.Sprintf(UserConsentPath, realm.Spec.Realm.Realm, user.ID, "cli")),
+		}),
+		func(c *Client) {
+			err := c.RevokeUserConsent(context.TODO(), user.ID, "cli", realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 404, fmt.Sprintf(UserConsentPath, realm.Spec.Realm.Realm, user.ID, "cli")),
+		}),
+		func(c *Client) {
+			err := c.RevokeUserConsent(context.TODO(), user.ID, "cli", realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_BruteForceForUser(t *testing.T) {
+	realm := getDummyRealm()
+	user := getDummyUser()
+	expectedPath := fmt.Sprintf(UserBruteForcePath, realm.Spec.Realm.Realm, user.ID)
+	status := &BruteForceStatus{
+		Disabled:      true,
+		NumFailures:   30,
+		LastFailure:   1600000000000,
+		LastIPFailure: "10.0.0.12",
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath, status),
+		}),
+		func(c *Client) {
+			found, err := c.GetBruteForceStatus(context.TODO(), user.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, status, found)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.ClearBruteForceForUser(context.TODO(), user.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_GetServerInfo(t *testing.T) {
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, ServerInfoPath, req.URL.Path)
+				w.WriteHeader(200)
+				_, err := w.Write([]byte(`{
+					"systemInfo": {"version": "9.0.3", "uptime": "1 hour", "uptimeMillis": 3600000},
+					"themes": {"login": [{"name": "keycloak", "locales": ["en", "de"]}]},
+					"providers": {
+						"authenticator": {
+							"internal": false,
+							"providers": {
+								"auth-otp-form": {"order": 0},
+								"auth-cookie": {"order": 0}
+							}
+						}
+					}
+				}`))
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			info, err := c.GetServerInfo(context.TODO())
+			assert.NoError(t, err)
+			assert.Equal(t, "9.0.3", info.SystemInfo.Version)
+			assert.Equal(t, int64(3600000), info.SystemInfo.UptimeMillis)
+			assert.Equal(t, []ThemeInfo{{Name: "keycloak", Locales: []string{"en", "de"}}}, info.Themes["login"])
+			assert.Equal(t, []ProviderInfo{{ID: "auth-cookie"}, {ID: "auth-otp-form"}}, info.ProviderTypes["authenticator"])
+		},
+	)
+}
+
+func TestClient_ListRealmEvents(t *testing.T) {
+	realm := getDummyRealm()
+	event := &RealmEvent{
+		Time:      1588000000000,
+		Type:      "LOGIN_ERROR",
+		RealmID:   realm.Spec.Realm.Realm,
+		ClientID:  "account",
+		UserID:    "dummy",
+		SessionID: "session-12345",
+		IPAddress: "10.0.0.1",
+		Details: map[string]string{
+			"error": "invalid_user_credentials",
+		},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				query := req.URL.Query()
+				assert.Equal(t, []string{"LOGIN", "LOGIN_ERROR"}, query["type"])
+				assert.Equal(t, "account", query.Get("client"))
+				assert.Equal(t, "dummy", query.Get("user"))
+				assert.Equal(t, "2020-04-01", query.Get("dateFrom"))
+				assert.Equal(t, "2020-04-30", query.Get("dateTo"))
+				assert.Equal(t, "10", query.Get("first"))
+				assert.Equal(t, "5", query.Get("max"))
+				withPathAssertionBody(t, 200, fmt.Sprintf(RealmEventsPath, realm.Spec.Realm.Realm), []*RealmEvent{event})(w, req)
+			},
+		}),
+		func(c *Client) {
+			events, err := c.ListRealmEvents(context.TODO(), realm.Spec.Realm.Realm, &EventListParams{
+				Types:    []string{"LOGIN", "LOGIN_ERROR"},
+				Client:   "account",
+				User:     "dummy",
+				DateFrom: "2020-04-01",
+				DateTo:   "2020-04-30",
+				First:    10,
+				Max:      5,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, []*RealmEvent{event}, events)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Empty(t, req.URL.RawQuery)
+				withPathAssertionBody(t, 200, fmt.Sprintf(RealmEventsPath, realm.Spec.Realm.Realm), []*RealmEvent{})(w, req)
+			},
+		}),
+		func(c *Client) {
+			events, err := c.ListRealmEvents(context.TODO(), realm.Spec.Realm.Realm, nil)
+			assert.NoError(t, err)
+			assert.Empty(t, events)
+		},
+	)
+}
+
+func TestClient_ClearRealmEvents(t *testing.T) {
+	realm := getDummyRealm()
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, fmt.Sprintf(RealmEventsPath, realm.Spec.Realm.Realm)),
+		}),
+		func(c *Client) {
+			err := c.ClearRealmEvents(context.TODO(), realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_CreateClient(t *testing.T) {
+	realm := getDummyRealm()
+	client := &v1alpha1.KeycloakAPIClient{
+		ClientID:                "dashboard",
+		Name:                    "Dashboard",
+		Enabled:                 true,
+		ClientAuthenticatorType: "client-secret",
+		Secret:                  "secret",
+		RootURL:                 "https://dashboard.example.com",
+		RedirectUris:            []string{"https://dashboard.example.com/*"},
+	}
+	expectedPath := fmt.Sprintf(ClientCreatePath, realm.Spec.Realm.Realm)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				sent := &v1alpha1.KeycloakAPIClient{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(sent))
+				assert.Equal(t, client, sent)
+				withPathAssertionLocationHeader(t, 201, expectedPath, "client-12345")(w, req)
+			},
+		}),
+		func(c *Client) {
+			clientID, err := c.CreateClient(context.TODO(), client, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, "client-12345", clientID)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 409, expectedPath),
+		func(c *Client) {
+			_, err := c.CreateClient(context.TODO(), client, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrAlreadyExists))
+
+			var apiErr *APIError
+			assert.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, http.StatusConflict, apiErr.StatusCode)
+		},
+	)
+}
+
+func TestClient_ListAdminEvents(t *testing.T) {
+	realm := getDummyRealm()
+	event := &AdminEvent{
+		Time:           1588000000000,
+		OperationType:  "UPDATE",
+		ResourceType:   "CLIENT",
+		ResourcePath:   "clients/client-12345",
+		Representation: `{"clientId":"dashboard"}`,
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				query := req.URL.Query()
+				assert.Equal(t, []string{"CREATE", "UPDATE"}, query["operationTypes"])
+				assert.Equal(t, []string{"CLIENT"}, query["resourceTypes"])
+				assert.Equal(t, "master", query.Get("authRealm"))
+				assert.Equal(t, "admin-cli", query.Get("authClient"))
+				assert.Equal(t, "admin-12345", query.Get("authUser"))
+				assert.Equal(t, "2020-04-01", query.Get("dateFrom"))
+				assert.Equal(t, "2020-04-30", query.Get("dateTo"))
+				withPathAssertionBody(t, 200, fmt.Sprintf(AdminEventsPath, realm.Spec.Realm.Realm), []*AdminEvent{event})(w, req)
+			},
+		}),
+		func(c *Client) {
+			events, err := c.ListAdminEvents(context.TODO(), realm.Spec.Realm.Realm, &AdminEventListParams{
+				OperationTypes: []string{"CREATE", "UPDATE"},
+				ResourceTypes:  []string{"CLIENT"},
+				AuthRealm:      "master",
+				AuthClient:     "admin-cli",
+				AuthUser:       "admin-12345",
+				DateFrom:       "2020-04-01",
+				DateTo:         "2020-04-30",
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, []*AdminEvent{event}, events)
+		},
+	)
+}
+
+func TestClient_ClearAdminEvents(t *testing.T) {
+	realm := getDummyRealm()
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, fmt.Sprintf(AdminEventsPath, realm.Spec.Realm.Realm)),
+		}),
+		func(c *Client) {
+			err := c.ClearAdminEvents(context.TODO(), realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_GetClient(t *testing.T) {
+	realm := getDummyRealm()
+	client := &v1alpha1.KeycloakAPIClient{
+		ID:       "client-12345",
+		ClientID: "dashboard",
+		Enabled:  true,
+	}
+	expectedPath := fmt.Sprintf(ClientGetPath, realm.Spec.Realm.Realm, client.ID)
+
+	testClientHTTPRequest(
+		withPathAssertionBody(t, 200, expectedPath, client),
+		func(c *Client) {
+			found, err := c.GetClient(context.TODO(), client.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, client, found)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, expectedPath),
+		func(c *Client) {
+			_, err := c.GetClient(context.TODO(), client.ID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_ListClients(t *testing.T) {
+	realm := getDummyRealm()
+	clients := []*v1alpha1.KeycloakAPIClient{
+		{ID: "client-12345", ClientID: "dashboard"},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Empty(t, req.URL.RawQuery)
+				withPathAssertionBody(t, 200, fmt.Sprintf(ClientListPath, realm.Spec.Realm.Realm), clients)(w, req)
+			},
+		}),
+		func(c *Client) {
+			found, err := c.ListClients(context.TODO(), realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, clients, found)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, "dashboard", req.URL.Query().Get("clientId"))
+				assert.Equal(t, "true", req.URL.Query().Get("viewableOnly"))
+				withPathAssertionBody(t, 200, fmt.Sprintf(ClientListPath, realm.Spec.Realm.Realm), clients)(w, req)
+			},
+		}),
+		func(c *Client) {
+			found, err := c.ListClientsWithParams(context.TODO(), realm.Spec.Realm.Realm, &ClientListParams{
+				ClientID:     "dashboard",
+				ViewableOnly: true,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, clients, found)
+		},
+	)
+}
+
+func TestClient_GetRealmEventsConfig(t *testing.T) {
+	realm := getDummyRealm()
+	config := &RealmEventsConfig{
+		AdminEventsEnabled: true,
+		EventsEnabled:      true,
+		EventsExpiration:   86400,
+		EventsListeners:    []string{"jboss-logging"},
+		EnabledEventTypes:  []string{"LOGIN", "LOGIN_ERROR"},
+	}
+	expectedPath := fmt.Sprintf(RealmEventsConfigPath, realm.Spec.Realm.Realm)
+
+	testClientHTTPRequest(
+		withPathAssertionBody(t, 200, expectedPath, config),
+		func(c *Client) {
+			found, err := c.GetRealmEventsConfig(context.TODO(), realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, config, found)
+		},
+	)
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, expectedPath),
+		func(c *Client) {
+			_, err := c.GetRealmEventsConfig(context.TODO(), realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_ListRealmKeys(t *testing.T) {
+	realm := getDummyRealm()
+	keys := &RealmKeyMetadata{
+		Active: map[string]string{"RS256": "kid-12345"},
+		Keys: []*KeyMetadata{{
+			Kid:         "kid-12345",
+			Use:         "SIG",
+			Type:        "RSA",
+			Algorithm:   "RS256",
+			Status:      "ACTIVE",
+			Certificate: "MIICmzCCAYMCBgF",
+			Provider:    "component-12345",
+		}},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(RealmKeysPath, realm.Spec.Realm.Realm), keys),
+		}),
+		func(c *Client) {
+			result, err := c.ListRealmKeys(context.TODO(), realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, keys, result)
+		},
+	)
+}
+
+func TestClient_GetWellKnownConfiguration(t *testing.T) {
+	realm := getDummyRealm()
+	issuer := "https://keycloak.example.com/auth/realms/" + realm.Spec.Realm.Realm
+	config := &OIDCConfiguration{
+		Issuer:                issuer,
+		AuthorizationEndpoint: issuer + "/protocol/openid-connect/auth",
+		TokenEndpoint:         issuer + "/protocol/openid-connect/token",
+		JWKSUri:               issuer + "/protocol/openid-connect/certs",
+		SupportedGrantTypes:   []string{"authorization_code", "refresh_token"},
+		SupportedScopes:       []string{"openid", "profile"},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(WellKnownConfigurationPath, realm.Spec.Realm.Realm), req.URL.Path)
+				// the discovery document is public
+				assert.Empty(t, req.Header.Get("Authorization"))
+				_, err := respondWithJSON(config, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			result, err := c.GetWellKnownConfiguration(context.TODO(), realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, config, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertion(t, 404, fmt.Sprintf(WellKnownConfigurationPath, realm.Spec.Realm.Realm)),
+		}),
+		func(c *Client) {
+			_, err := c.GetWellKnownConfiguration(context.TODO(), realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_GetJWKS(t *testing.T) {
+	realm := getDummyRealm()
+	jwks := &JWKS{
+		Keys: []*JWK{
+			{Kid: "kid-12345", Kty: "RSA", Alg: "RS256", Use: "sig", N: "qjx0vFQ", E: "AQAB", X5c: []string{"MIICnTCCAYUCBgF"}},
+		},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(JWKSPath, realm.Spec.Realm.Realm), jwks),
+		}),
+		func(c *Client) {
+			result, err := c.GetJWKS(context.TODO(), realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, jwks, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertion(t, 404, fmt.Sprintf(JWKSPath, realm.Spec.Realm.Realm)),
+		}),
+		func(c *Client) {
+			_, err := c.GetJWKS(context.TODO(), realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_IntrospectToken(t *testing.T) {
+	realm := getDummyRealm()
+	introspection := &TokenIntrospection{
+		Active:   true,
+		Sub:      "user-12345",
+		Scope:    "openid profile",
+		ClientID: "gateway",
+		Username: "dummy",
+		Exp:      1600000300,
+		Iat:      1600000000,
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(TokenIntrospectionPath, realm.Spec.Realm.Realm), req.URL.Path)
+				username, password, ok := req.BasicAuth()
+				assert.True(t, ok)
+				assert.Equal(t, "gateway", username)
+				assert.Equal(t, "gateway-secret", password)
+				assert.NoError(t, req.ParseForm())
+				assert.Equal(t, "access-token", req.PostForm.Get("token"))
+				_, err := respondWithJSON(introspection, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			result, err := c.IntrospectToken(context.TODO(), realm.Spec.Realm.Realm, "gateway", "gateway-secret", "access-token")
+			assert.NoError(t, err)
+			assert.Equal(t, introspection, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionBody(t, 200, fmt.Sprintf(TokenIntrospectionPath, realm.Spec.Realm.Realm), map[string]bool{"active": false}),
+		}),
+		func(c *Client) {
+			result, err := c.IntrospectToken(context.TODO(), realm.Spec.Realm.Realm, "gateway", "gateway-secret", "expired-token")
+			assert.NoError(t, err)
+			assert.Equal(t, &TokenIntrospection{}, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertion(t, 401, fmt.Sprintf(TokenIntrospectionPath, realm.Spec.Realm.Realm)),
+		}),
+		func(c *Client) {
+			_, err := c.IntrospectToken(context.TODO(), realm.Spec.Realm.Realm, "gateway", "wrong-secret", "access-token")
+			assert.True(t, errors.Is(err, ErrUnauthorized))
+		},
+	)
+}
+
+func TestClient_ExchangeToken(t *testing.T) {
+	realm := getDummyRealm()
+	tokenRes := &TokenResponse{
+		TokenResponse: v1alpha1.TokenResponse{
+			AccessToken: "exchanged-token",
+			ExpiresIn:   300,
+			TokenType:   "bearer",
+		},
+		IssuedTokenType: "urn:ietf:params:oauth:token-type:access_token",
+		Scope:           "profile email",
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(RealmTokenPath, realm.Spec.Realm.Realm), req.URL.Path)
+				assert.NoError(t, req.ParseForm())
+				assert.Equal(t, "urn:ietf:params:oauth:grant-type:token-exchange", req.PostForm.Get("grant_type"))
+				assert.Equal(t, "user-token", req.PostForm.Get("subject_token"))
+				assert.Equal(t, "backend", req.PostForm.Get("audience"))
+				assert.Equal(t, "gateway", req.PostForm.Get("client_id"))
+				assert.Equal(t, "gateway-secret", req.PostForm.Get("client_secret"))
+				_, err := respondWithJSON(tokenRes, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			result, err := c.ExchangeToken(context.TODO(), realm.Spec.Realm.Realm, "user-token", "backend", "gateway", "gateway-secret")
+			assert.NoError(t, err)
+			assert.Equal(t, tokenRes, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(403)
+				_, err := respondWithJSON(map[string]string{
+					"error":             "access_denied",
+					"error_description": "Client not allowed to exchange",
+				}, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			_, err := c.ExchangeToken(context.TODO(), realm.Spec.Realm.Realm, "user-token", "backend", "gateway", "gateway-secret")
+			assert.True(t, errors.Is(err, ErrForbidden))
+			assert.Contains(t, err.Error(), "Client not allowed to exchange")
+		},
+	)
+}
+
+func TestClient_RefreshToken(t *testing.T) {
+	realm := getDummyRealm()
+	handler := func(expectedRefreshToken string, tokenRes *TokenResponse) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case fmt.Sprintf(RealmTokenPath, realm.Spec.Realm.Realm):
+				assert.NoError(t, req.ParseForm())
+				assert.Equal(t, "refresh_token", req.PostForm.Get("grant_type"))
+				assert.Equal(t, expectedRefreshToken, req.PostForm.Get("refresh_token"))
+				assert.Equal(t, "operator", req.PostForm.Get("client_id"))
+				assert.Equal(t, "operator-secret", req.PostForm.Get("client_secret"))
+				_, err := respondWithJSON(tokenRes, w)
+				assert.NoError(t, err)
+			case fmt.Sprintf(RealmsGetPath, realm.Spec.Realm.Realm):
+				// the refreshed access token is used for subsequent requests
+				assert.Equal(t, "Bearer "+tokenRes.AccessToken, req.Header.Get("Authorization"))
+				_, err := respondWithJSON(realm.Spec.Realm, w)
+				assert.NoError(t, err)
+			default:
+				t.Errorf("unexpected request to %s", req.URL.Path)
+			}
+		}
+	}
+	tokenRes := &TokenResponse{
+		TokenResponse: v1alpha1.TokenResponse{
+			AccessToken:  "new-access-token",
+			RefreshToken: "new-refresh-token",
+			ExpiresIn:    300,
+		},
+	}
+
+	testClientHTTPRequest(
+		handler("refresh-token", tokenRes),
+		func(c *Client) {
+			result, err := c.RefreshToken(context.TODO(), realm.Spec.Realm.Realm, "operator", "operator-secret", "refresh-token")
+			assert.NoError(t, err)
+			assert.Equal(t, tokenRes, result)
+			assert.Equal(t, "new-refresh-token", c.refreshToken)
+			assert.False(t, c.tokenExpiry.IsZero())
+
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s"+RealmsGetPath, c.URL, realm.Spec.Realm.Realm), nil)
+			assert.NoError(t, err)
+			assert.NoError(t, c.authorize(context.TODO(), req))
+			res, err := c.do(req)
+			assert.NoError(t, err)
+			res.Body.Close()
+		},
+	)
+
+	// the stored refresh token is used when none is given
+	testClientHTTPRequest(
+		handler("stored-refresh-token", tokenRes),
+		func(c *Client) {
+			c.refreshToken = "stored-refresh-token"
+			_, err := c.RefreshToken(context.TODO(), realm.Spec.Realm.Realm, "operator", "operator-secret", "")
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{}),
+		func(c *Client) {
+			_, err := c.RefreshToken(context.TODO(), realm.Spec.Realm.Realm, "operator", "operator-secret", "")
+			assert.EqualError(t, err, "refresh token must be set")
+		},
+	)
+}
+
+func TestClient_RevokeToken(t *testing.T) {
+	realm := getDummyRealm()
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(TokenRevocationPath, realm.Spec.Realm.Realm), req.URL.Path)
+				assert.NoError(t, req.ParseForm())
+				assert.Equal(t, "refresh-token", req.PostForm.Get("token"))
+				assert.Equal(t, "refresh_token", req.PostForm.Get("token_type_hint"))
+				assert.Equal(t, "operator", req.PostForm.Get("client_id"))
+				assert.Equal(t, "operator-secret", req.PostForm.Get("client_secret"))
+				w.WriteHeader(200)
+			},
+		}),
+		func(c *Client) {
+			err := c.RevokeToken(context.TODO(), realm.Spec.Realm.Realm, "operator", "operator-secret", "refresh-token", "refresh_token")
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertion(t, 401, fmt.Sprintf(TokenRevocationPath, realm.Spec.Realm.Realm)),
+		}),
+		func(c *Client) {
+			err := c.RevokeToken(context.TODO(), realm.Spec.Realm.Realm, "operator", "wrong-secret", "refresh-token", "")
+			assert.True(t, errors.Is(err, ErrUnauthorized))
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{}),
+		func(c *Client) {
+			err := c.RevokeToken(context.TODO(), realm.Spec.Realm.Realm, "operator", "operator-secret", "id-token", "id_token")
+			assert.EqualError(t, err, `unsupported token type hint "id_token"`)
+		},
+	)
+}
+
+func TestClient_PatchRealm(t *testing.T) {
+	realm := getDummyRealm()
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(RealmsGetPath, realm.Spec.Realm.Realm), req.URL.Path)
+				// only the patched attributes are sent
+				var body map[string]interface{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+				assert.Equal(t, map[string]interface{}{"displayName": "Example", "bruteForceProtected": true}, body)
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.PatchRealm(context.TODO(), realm.Spec.Realm.Realm, map[string]interface{}{
+				"displayName":         "Example",
+				"bruteForceProtected": true,
+			})
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_RealmAttributes(t *testing.T) {
+	realm := getDummyRealm()
+	representation := map[string]interface{}{
+		"realm": realm.Spec.Realm.Realm,
+		"attributes": map[string]string{
+			"frontendUrl":                        "https://sso.example.com/auth",
+			"actionTokenGeneratedByUserLifespan": "300",
+		},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(RealmsGetPath, realm.Spec.Realm.Realm), representation),
+		}),
+		func(c *Client) {
+			value, ok, err := c.GetRealmAttribute(context.TODO(), realm.Spec.Realm.Realm, "frontendUrl")
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, "https://sso.example.com/auth", value)
+
+			_, ok, err = c.GetRealmAttribute(context.TODO(), realm.Spec.Realm.Realm, "userProfileEnabled")
+			assert.NoError(t, err)
+			assert.False(t, ok)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertion(t, 404, fmt.Sprintf(RealmsGetPath, realm.Spec.Realm.Realm)),
+		}),
+		func(c *Client) {
+			_, _, err := c.GetRealmAttribute(context.TODO(), realm.Spec.Realm.Realm, "frontendUrl")
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(RealmsGetPath, realm.Spec.Realm.Realm), representation),
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(RealmsGetPath, realm.Spec.Realm.Realm), req.URL.Path)
+				// the existing attributes are kept
+				var body map[string]map[string]string
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+				assert.Equal(t, map[string]map[string]string{
+					"attributes": {
+						"frontendUrl":                        "https://sso.example.com/auth",
+						"actionTokenGeneratedByUserLifespan": "600",
+					},
+				}, body)
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.SetRealmAttribute(context.TODO(), realm.Spec.Realm.Realm, "actionTokenGeneratedByUserLifespan", "600")
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_UpdateRealmEventsConfig(t *testing.T) {
+	realm := getDummyRealm()
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(RealmEventsConfigPath, realm.Spec.Realm.Realm), req.URL.Path)
+				// disabled flags are sent so they can be turned off
+				sent := map[string]interface{}{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&sent))
+				assert.Equal(t, false, sent["adminEventsEnabled"])
+				assert.Equal(t, true, sent["eventsEnabled"])
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.UpdateRealmEventsConfig(context.TODO(), realm.Spec.Realm.Realm, &RealmEventsConfig{EventsEnabled: true})
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_UpdateClient(t *testing.T) {
+	realm := getDummyRealm()
+	client := &v1alpha1.KeycloakAPIClient{
+		ID:           "client-12345",
+		ClientID:     "dashboard",
+		Enabled:      true,
+		RedirectUris: []string{"https://dashboard.example.com/*", "http://localhost:8080/*"},
+		WebOrigins:   []string{"https://dashboard.example.com", "+"},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(ClientGetPath, realm.Spec.Realm.Realm, client.ID), req.URL.Path)
+				sent := &v1alpha1.KeycloakAPIClient{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(sent))
+				assert.Equal(t, client.RedirectUris, sent.RedirectUris)
+				assert.Equal(t, client.WebOrigins, sent.WebOrigins)
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.UpdateClient(context.TODO(), client, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{}),
+		func(c *Client) {
+			err := c.UpdateClient(context.TODO(), &v1alpha1.KeycloakAPIClient{ClientID: "dashboard"}, realm.Spec.Realm.Realm)
+			assert.Error(t, err)
+		},
+	)
+}
+
+func TestClient_DeleteClient(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	expectedPath := fmt.Sprintf(ClientGetPath, realm.Spec.Realm.Realm, clientID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.DeleteClient(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 404, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.DeleteClient(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_CancelledContext(t *testing.T) {
+	realm := getDummyRealm()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testClientHTTPRequest(
+		func(w http.ResponseWriter, req *http.Request) {
+			t.Errorf("unexpected request to %s", req.URL.Path)
+		},
+		func(c *Client) {
+			_, err := c.GetClient(ctx, "client-12345", realm.Spec.Realm.Realm)
+			assert.Error(t, err)
+		},
+	)
+}
+
+func TestClient_FindClientByClientID(t *testing.T) {
+	realm := getDummyRealm()
+
+	handle := withMethodSelection(t, map[string]http.HandlerFunc{
+		http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+			assert.NotEmpty(t, req.URL.Query().Get("clientId"))
+			// prefix matches are returned by some versions
+			withPathAssertionBody(t, 200, fmt.Sprintf(ClientListPath, realm.Spec.Realm.Realm), []*v1alpha1.KeycloakAPIClient{
+				{ID: "client-67890", ClientID: "dashboard-admin"},
+				{ID: "client-12345", ClientID: "dashboard"},
+			})(w, req)
+		},
+	})
+
+	testClientHTTPRequest(handle, func(c *Client) {
+		client, err := c.FindClientByClientID(context.TODO(), "dashboard", realm.Spec.Realm.Realm)
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+		assert.Equal(t, "client-12345", client.ID)
+
+		client, err = c.FindClientByClientID(context.TODO(), "dash", realm.Spec.Realm.Realm)
+		assert.NoError(t, err)
+		assert.Nil(t, client)
+	})
+}
+
+func TestClient_APIError(t *testing.T) {
+	realm := getDummyRealm()
+	user := getDummyUser()
+	expectedPath := fmt.Sprintf(UserGetPath, realm.Spec.Realm.Realm, user.ID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, expectedPath, req.URL.Path)
+				w.WriteHeader(http.StatusForbidden)
+				_, err := w.Write([]byte(`{"error":"HTTP 403 Forbidden"}`))
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			err := c.UpdateUser(context.TODO(), user, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrForbidden))
+			assert.False(t, errors.Is(err, ErrNotFound))
+
+			var apiErr *APIError
+			assert.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+			assert.Equal(t, `{"error":"HTTP 403 Forbidden"}`, apiErr.Body)
+			assert.Equal(t, "failed to UPDATE user: (403) 403 Forbidden: HTTP 403 Forbidden", apiErr.Error())
+		},
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, TokenPath, req.URL.Path)
+		w.WriteHeader(http.StatusUnauthorized)
+		_, err := respondWithJSON(map[string]string{
+			"error":             "invalid_grant",
+			"error_description": "Invalid user credentials",
+		}, w)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithCredentials("admin", "wrong"))
+	assert.NoError(t, err)
+	_, err = c.GetUser(context.TODO(), user.ID, realm.Spec.Realm.Realm)
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+	assert.Contains(t, err.Error(), "Invalid user credentials")
+}
+
+func TestClient_ListRequiredActions(t *testing.T) {
+	realm := getDummyRealm()
+	actions := []*RequiredAction{
+		{Alias: "VERIFY_EMAIL", Name: "Verify Email", ProviderID: "VERIFY_EMAIL", Enabled: true, Priority: 50},
+		{Alias: "CONFIGURE_TOTP", Name: "Configure OTP", ProviderID: "CONFIGURE_TOTP", Enabled: true, DefaultAction: true, Priority: 10},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(RequiredActionsPath, realm.Spec.Realm.Realm), actions),
+		}),
+		func(c *Client) {
+			result, err := c.ListRequiredActions(context.TODO(), realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, actions, result)
+		},
+	)
+}
+
+func TestClient_UpdateRequiredAction(t *testing.T) {
+	realm := getDummyRealm()
+	action := &RequiredAction{Alias: "VERIFY_EMAIL", Name: "Verify Email", ProviderID: "VERIFY_EMAIL", Priority: 50}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(RequiredActionPath, realm.Spec.Realm.Realm, action.Alias), req.URL.Path)
+				// disabling the action sends enabled explicitly
+				var body map[string]interface{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+				assert.Equal(t, false, body["enabled"])
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.UpdateRequiredAction(context.TODO(), action.Alias, realm.Spec.Realm.Realm, action)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_RegisterRequiredAction(t *testing.T) {
+	realm := getDummyRealm()
+	const providerID = "terms_and_conditions"
+	expectedPath := fmt.Sprintf(RegisterRequiredActionPath, realm.Spec.Realm.Realm)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, expectedPath, req.URL.Path)
+				var body map[string]string
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+				assert.Equal(t, map[string]string{"providerId": providerID}, body)
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.RegisterRequiredAction(context.TODO(), realm.Spec.Realm.Realm, providerID)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertion(t, 409, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.RegisterRequiredAction(context.TODO(), realm.Spec.Realm.Realm, providerID)
+			assert.True(t, errors.Is(err, ErrAlreadyExists))
+		},
+	)
+}
+
+func TestClient_DeleteRequiredAction(t *testing.T) {
+	realm := getDummyRealm()
+	const alias = "terms_and_conditions"
+	expectedPath := fmt.Sprintf(RequiredActionPath, realm.Spec.Realm.Realm, alias)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.DeleteRequiredAction(context.TODO(), alias, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 404, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.DeleteRequiredAction(context.TODO(), alias, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_AuthzResources(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	resource := &AuthzResource{
+		ID:     "resource-12345",
+		Name:   "invoices",
+		URIs:   []string{"/invoices/*"},
+		Type:   "urn:billing:resources:invoice",
+		Scopes: []*AuthzScope{{Name: "view"}, {Name: "edit"}},
+		Attributes: map[string][]string{
+			"department": {"finance"},
+		},
+	}
+	listPath := fmt.Sprintf(AuthzResourcesPath, realm.Spec.Realm.Realm, clientID)
+	resourcePath := fmt.Sprintf(AuthzResourcePath, realm.Spec.Realm.Realm, clientID, resource.ID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionBody(t, 201, listPath, resource),
+		}),
+		func(c *Client) {
+			id, err := c.CreateAuthzResource(context.TODO(), clientID, realm.Spec.Realm.Realm, &AuthzResource{Name: resource.Name})
+			assert.NoError(t, err)
+			assert.Equal(t, resource.ID, id)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertion(t, 409, listPath),
+		}),
+		func(c *Client) {
+			_, err := c.CreateAuthzResource(context.TODO(), clientID, realm.Spec.Realm.Realm, resource)
+			assert.True(t, errors.Is(err, ErrAlreadyExists))
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, resourcePath, resource),
+		}),
+		func(c *Client) {
+			result, err := c.GetAuthzResource(context.TODO(), clientID, resource.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, resource, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertion(t, 404, resourcePath),
+		}),
+		func(c *Client) {
+			_, err := c.GetAuthzResource(context.TODO(), clientID, resource.ID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, listPath, req.URL.Path)
+				assert.Equal(t, url.Values{
+					"name":  {"invoices"},
+					"uri":   {"/invoices/1"},
+					"type":  {"urn:billing:resources:invoice"},
+					"first": {"100"},
+					"max":   {"50"},
+				}, req.URL.Query())
+				_, err := respondWithJSON([]*AuthzResource{resource}, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			result, err := c.ListAuthzResources(context.TODO(), clientID, realm.Spec.Realm.Realm, &AuthzResourceListParams{
+				Name:  "invoices",
+				URI:   "/invoices/1",
+				Type:  "urn:billing:resources:invoice",
+				First: 100,
+				Max:   50,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, []*AuthzResource{resource}, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: withPathAssertion(t, 204, resourcePath),
+		}),
+		func(c *Client) {
+			err := c.UpdateAuthzResource(context.TODO(), clientID, realm.Spec.Realm.Realm, resource)
+			assert.NoError(t, err)
+
+			err = c.UpdateAuthzResource(context.TODO(), clientID, realm.Spec.Realm.Realm, &AuthzResource{Name: "invoices"})
+			assert.Error(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, resourcePath),
+		}),
+		func(c *Client) {
+			err := c.DeleteAuthzResource(context.TODO(), clientID, resource.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_AuthzScopes(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	scope := &AuthzScope{ID: "scope-12345", Name: "view", DisplayName: "View"}
+	listPath := fmt.Sprintf(AuthzScopesPath, realm.Spec.Realm.Realm, clientID)
+	scopePath := fmt.Sprintf(AuthzScopePath, realm.Spec.Realm.Realm, clientID, scope.ID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionBody(t, 201, listPath, scope),
+		}),
+		func(c *Client) {
+			id, err := c.CreateAuthzScope(context.TODO(), clientID, realm.Spec.Realm.Realm, &AuthzScope{Name: scope.Name})
+			assert.NoError(t, err)
+			assert.Equal(t, scope.ID, id)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, listPath, req.URL.Path)
+				assert.Equal(t, url.Values{"name": {"view"}}, req.URL.Query())
+				_, err := respondWithJSON([]*AuthzScope{scope}, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			result, err := c.ListAuthzScopes(context.TODO(), clientID, realm.Spec.Realm.Realm, &AuthzScopeListParams{Name: "view"})
+			assert.NoError(t, err)
+			assert.Equal(t, []*AuthzScope{scope}, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: withPathAssertion(t, 204, scopePath),
+		}),
+		func(c *Client) {
+			err := c.UpdateAuthzScope(context.TODO(), clientID, realm.Spec.Realm.Realm, scope)
+			assert.NoError(t, err)
+
+			err = c.UpdateAuthzScope(context.TODO(), clientID, realm.Spec.Realm.Realm, &AuthzScope{Name: "view"})
+			assert.Error(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, scopePath),
+		}),
+		func(c *Client) {
+			err := c.DeleteAuthzScope(context.TODO(), clientID, scope.ID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	// the reason a scope can't be removed is part of the error
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, scopePath, req.URL.Path)
+				w.WriteHeader(400)
+				_, err := respondWithJSON(map[string]string{
+					"error":             "invalid_request",
+					"error_description": "Scopes can not be removed while associated with resources.",
+				}, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			err := c.DeleteAuthzScope(context.TODO(), clientID, scope.ID, realm.Spec.Realm.Realm)
+			var apiErr *APIError
+			assert.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, 400, apiErr.StatusCode)
+			assert.Contains(t, apiErr.Error(), "Scopes can not be removed while associated with resources.")
+		},
+	)
+}
+
+func TestClient_AuthzPolicies(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		clientID = "client-12345"
+		policyID = "policy-12345"
+	)
+	rolePolicy := json.RawMessage(`{"id":"policy-12345","name":"admins","type":"role","logic":"POSITIVE","roles":[{"id":"role-12345","required":true}]}`)
+	expected := &AuthzPolicy{ID: policyID, Name: "admins", Type: "role", Logic: "POSITIVE", Raw: rolePolicy}
+	listPath := fmt.Sprintf(AuthzPoliciesPath, realm.Spec.Realm.Realm, clientID)
+	policyPath := fmt.Sprintf(AuthzPolicyPath, realm.Spec.Realm.Realm, clientID, policyID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, listPath+"/role", req.URL.Path)
+				// the representation is sent as is
+				body, err := ioutil.ReadAll(req.Body)
+				assert.NoError(t, err)
+				assert.JSONEq(t, `{"name":"admins","roles":[{"id":"role-12345","required":true}]}`, string(body))
+				w.WriteHeader(201)
+				_, err = w.Write(rolePolicy)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			policy, err := c.CreateAuthzPolicy(context.TODO(), clientID, realm.Spec.Realm.Realm, "role", json.RawMessage(`{"name":"admins","roles":[{"id":"role-12345","required":true}]}`))
+			assert.NoError(t, err)
+			assert.Equal(t, expected, policy)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, policyPath, req.URL.Path)
+				_, err := w.Write(rolePolicy)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			policy, err := c.GetAuthzPolicy(context.TODO(), clientID, policyID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, policy)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertion(t, 404, policyPath),
+		}),
+		func(c *Client) {
+			_, err := c.GetAuthzPolicy(context.TODO(), clientID, policyID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, listPath, req.URL.Path)
+				_, err := w.Write([]byte("[" + string(rolePolicy) + "]"))
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			policies, err := c.ListAuthzPolicies(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, []*AuthzPolicy{expected}, policies)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: withPathAssertion(t, 201, listPath+"/role/"+policyID),
+		}),
+		func(c *Client) {
+			err := c.UpdateAuthzPolicy(context.TODO(), clientID, realm.Spec.Realm.Realm, "role", policyID, rolePolicy)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, policyPath),
+		}),
+		func(c *Client) {
+			err := c.DeleteAuthzPolicy(context.TODO(), clientID, policyID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_AuthzPermissions(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		clientID     = "client-12345"
+		permissionID = "permission-12345"
+	)
+	permission := &AuthzPermission{
+		Name:      "view invoices",
+		Resources: []string{"resource-12345"},
+		Scopes:    []string{"scope-12345"},
+		Policies:  []string{"policy-12345"},
+	}
+	listPath := fmt.Sprintf(AuthzPermissionsPath, realm.Spec.Realm.Realm, clientID)
+	permissionPath := fmt.Sprintf(AuthzPermissionPath, realm.Spec.Realm.Realm, clientID, permissionID)
+	created := &AuthzPermission{ID: permissionID, Name: permission.Name}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionBody(t, 201, listPath+"/resource", created),
+		}),
+		func(c *Client) {
+			id, err := c.CreateAuthzResourcePermission(context.TODO(), clientID, realm.Spec.Realm.Realm, permission)
+			assert.NoError(t, err)
+			assert.Equal(t, permissionID, id)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionBody(t, 201, listPath+"/scope", created),
+		}),
+		func(c *Client) {
+			id, err := c.CreateAuthzScopePermission(context.TODO(), clientID, realm.Spec.Realm.Realm, permission)
+			assert.NoError(t, err)
+			assert.Equal(t, permissionID, id)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, listPath, []*AuthzPermission{created}),
+		}),
+		func(c *Client) {
+			permissions, err := c.ListAuthzPermissions(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, []*AuthzPermission{created}, permissions)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, permissionPath),
+		}),
+		func(c *Client) {
+			err := c.DeleteAuthzPermission(context.TODO(), clientID, permissionID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				switch req.URL.Path {
+				case permissionPath + "/associatedPolicies":
+					_, err := w.Write([]byte(`[{"id":"policy-12345","name":"admins","type":"role"}]`))
+					assert.NoError(t, err)
+				case permissionPath + "/resources":
+					_, err := w.Write([]byte(`[{"_id":"resource-12345","name":"invoices"}]`))
+					assert.NoError(t, err)
+				case permissionPath + "/scopes":
+					_, err := w.Write([]byte(`[{"id":"scope-12345","name":"view"}]`))
+					assert.NoError(t, err)
+				default:
+					t.Errorf("unexpected request to %s", req.URL.Path)
+				}
+			},
+		}),
+		func(c *Client) {
+			policies, err := c.ListAuthzPermissionPolicies(context.TODO(), clientID, permissionID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Len(t, policies, 1)
+			assert.Equal(t, "policy-12345", policies[0].ID)
+
+			resources, err := c.ListAuthzPermissionResources(context.TODO(), clientID, permissionID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, []*AuthzResource{{ID: "resource-12345", Name: "invoices"}}, resources)
+
+			scopes, err := c.ListAuthzPermissionScopes(context.TODO(), clientID, permissionID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, []*AuthzScope{{ID: "scope-12345", Name: "view"}}, scopes)
+		},
+	)
+}
+
+func TestClient_EvaluateAuthzPermissions(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	evaluation := &PolicyEvaluationRequest{
+		ClientID: clientID,
+		UserID:   "user-12345",
+		RoleIDs:  []string{"finance"},
+		Context: map[string]map[string]string{
+			"attributes": {"kc.client.network.ip_address": "127.0.0.1"},
+		},
+		Resources: []*AuthzResource{{ID: "resource-12345", Name: "invoices"}},
+	}
+	response := &PolicyEvaluationResponse{
+		Status: "PERMIT",
+		Results: []*PolicyEvaluationResult{{
+			Resource:      &AuthzResource{ID: "resource-12345", Name: "invoices"},
+			AllowedScopes: []*AuthzScope{{ID: "scope-12345", Name: "view"}},
+			Status:        "PERMIT",
+		}},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(AuthzPoliciesPath, realm.Spec.Realm.Realm, clientID)+"/evaluate", req.URL.Path)
+				body := &PolicyEvaluationRequest{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(body))
+				assert.Equal(t, evaluation, body)
+				_, err := respondWithJSON(response, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			result, err := c.EvaluateAuthzPermissions(context.TODO(), clientID, realm.Spec.Realm.Realm, evaluation)
+			assert.NoError(t, err)
+			assert.Equal(t, response, result)
+		},
+	)
+}
+
+func TestClient_ListComponents(t *testing.T) {
+	realm := getDummyRealm()
+	components := []*Component{{
+		ID:           "component-12345",
+		Name:         "ldap",
+		ProviderID:   "ldap",
+		ProviderType: "org.keycloak.storage.UserStorageProvider",
+		ParentID:     realm.Spec.Realm.ID,
+		Config: map[string][]string{
+			"connectionUrl": {"ldaps://ldap.example.com"},
+		},
+	}}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(ComponentsPath, realm.Spec.Realm.Realm), req.URL.Path)
+				assert.Equal(t, url.Values{
+					"parent": {realm.Spec.Realm.ID},
+					"type":   {"org.keycloak.storage.UserStorageProvider"},
+				}, req.URL.Query())
+				_, err := respondWithJSON(components, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			result, err := c.ListComponents(context.TODO(), realm.Spec.Realm.Realm, &ComponentListParams{
+				Parent: realm.Spec.Realm.ID,
+				Type:   "org.keycloak.storage.UserStorageProvider",
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, components, result)
+		},
+	)
+}
+
+func TestClient_ComponentCRUD(t *testing.T) {
+	realm := getDummyRealm()
+	component := &Component{
+		Name:         "ldap",
+		ProviderID:   "ldap",
+		ProviderType: "org.keycloak.storage.UserStorageProvider",
+		ParentID:     realm.Spec.Realm.ID,
+	}
+	const componentID = "component-12345"
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionLocationHeader(t, 201, fmt.Sprintf(ComponentsPath, realm.Spec.Realm.Realm), componentID),
+		}),
+		func(c *Client) {
+			err := c.CreateComponent(context.TODO(), component, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, componentID, component.ID)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: withPathAssertion(t, 204, fmt.Sprintf(ComponentPath, realm.Spec.Realm.Realm, componentID)),
+		}),
+		func(c *Client) {
+			err := c.UpdateComponent(context.TODO(), component, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, fmt.Sprintf(ComponentPath, realm.Spec.Realm.Realm, componentID)),
+		}),
+		func(c *Client) {
+			err := c.DeleteComponent(context.TODO(), componentID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	// the required fields are checked before any request is made
+	testClientHTTPRequest(
+		func(w http.ResponseWriter, req *http.Request) {
+			t.Errorf("unexpected request to %s", req.URL.Path)
+		},
+		func(c *Client) {
+			err := c.CreateComponent(context.TODO(), &Component{Name: "ldap", ProviderType: component.ProviderType}, realm.Spec.Realm.Realm)
+			assert.Error(t, err)
+			err = c.UpdateComponent(context.TODO(), &Component{ID: componentID, ProviderID: "ldap"}, realm.Spec.Realm.Realm)
+			assert.Error(t, err)
+			err = c.UpdateComponent(context.TODO(), &Component{ProviderID: "ldap", ProviderType: component.ProviderType}, realm.Spec.Realm.Realm)
+			assert.Error(t, err)
+			err = c.DeleteComponent(context.TODO(), "", realm.Spec.Realm.Realm)
+			assert.Error(t, err)
+		},
+	)
+}
+
+func TestClient_TriggerLDAPSync(t *testing.T) {
+	realm := getDummyRealm()
+	const componentID = "component-12345"
+
+	for syncType, action := range map[LDAPSyncType]string{
+		SyncTypeFull:         "triggerFullSync",
+		SyncTypeChangedUsers: "triggerChangedUsersSync",
+	} {
+		testClientHTTPRequest(
+			withMethodSelection(t, map[string]http.HandlerFunc{
+				http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+					assert.Equal(t, fmt.Sprintf(UserStorageSyncPath, realm.Spec.Realm.Realm, componentID), req.URL.Path)
+					assert.Equal(t, action, req.URL.Query().Get("action"))
+					_, err := respondWithJSON(map[string]interface{}{
+						"ignored": false,
+						"added":   3,
+						"updated": 2,
+						"removed": 1,
+						"failed":  0,
+						"status":  "3 imported users, 2 updated users, 1 removed users",
+					}, w)
+					assert.NoError(t, err)
+				},
+			}),
+			func(c *Client) {
+				result, err := c.TriggerLDAPSync(context.TODO(), componentID, realm.Spec.Realm.Realm, syncType)
+				assert.NoError(t, err)
+				assert.Equal(t, &LDAPSyncResult{
+					Added:   3,
+					Updated: 2,
+					Removed: 1,
+					Status:  "3 imported users, 2 updated users, 1 removed users",
+				}, result)
+			},
+		)
+	}
+}
+
+func TestClient_TokenRefresh(t *testing.T) {
+	realm := getDummyRealm()
+	logins := 0
+
+	handle := func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case TokenPath:
+			assert.NoError(t, req.ParseForm())
+			assert.Equal(t, "admin", req.PostForm.Get("username"))
+			logins++
+			_, err := respondWithJSON(map[string]interface{}{
+				"access_token": fmt.Sprintf("token-%d", logins),
+				"expires_in":   60,
+			}, w)
+			assert.NoError(t, err)
+		case fmt.Sprintf(RealmEventsConfigPath, realm.Spec.Realm.Realm):
+			assert.Equal(t, fmt.Sprintf("Bearer token-%d", logins), req.Header.Get("Authorization"))
+			_, err := respondWithJSON(&RealmEventsConfig{}, w)
+			assert.NoError(t, err)
+		default:
+			t.Errorf("unexpected request to %s", req.URL.Path)
+		}
+	}
+
+	testClientHTTPRequest(handle, func(c *Client) {
+		assert.NoError(t, c.login(context.TODO(), "admin", "password"))
+
+		// the token is valid for longer than the default grace period
+		_, err := c.GetRealmEventsConfig(context.TODO(), realm.Spec.Realm.Realm)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, logins)
+
+		// the token now expires within the grace period
+		c.tokenRefreshGracePeriod = 2 * time.Minute
+		_, err = c.GetRealmEventsConfig(context.TODO(), realm.Spec.Realm.Realm)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, logins)
+	})
+}
+
+func TestClient_GetClientSecret(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	expectedPath := fmt.Sprintf(ClientSecretPath, realm.Spec.Realm.Realm, clientID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath, map[string]interface{}{
+				"type":      "secret",
+				"value":     "s3cr3t",
+				"temporary": false,
+			}),
+		}),
+		func(c *Client) {
+			secret, err := c.GetClientSecret(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, "s3cr3t", secret)
+		},
+	)
+
+	// public clients have no secret
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath, map[string]interface{}{
+				"type": "secret",
+			}),
+		}),
+		func(c *Client) {
+			secret, err := c.GetClientSecret(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.Equal(t, ErrNoSecret, err)
+			assert.Empty(t, secret)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertion(t, 404, expectedPath),
+		}),
+		func(c *Client) {
+			secret, err := c.GetClientSecret(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+			assert.Empty(t, secret)
+		},
+	)
+}
+
+func TestClient_RegenerateClientSecret(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	expectedPath := fmt.Sprintf(ClientSecretPath, realm.Spec.Realm.Realm, clientID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionBody(t, 200, expectedPath, map[string]interface{}{
+				"type":  "secret",
+				"value": "n3w-s3cr3t",
+			}),
+		}),
+		func(c *Client) {
+			secret, err := c.RegenerateClientSecret(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, "n3w-s3cr3t", secret)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionBody(t, 200, expectedPath, map[string]interface{}{
+				"type": "secret",
+			}),
+		}),
+		func(c *Client) {
+			_, err := c.RegenerateClientSecret(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.Equal(t, ErrNoSecret, err)
+		},
+	)
+}
+
+func TestClient_RotatedClientSecret(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	expectedPath := fmt.Sprintf(ClientRotatedSecretPath, realm.Spec.Realm.Realm, clientID)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath, map[string]interface{}{
+				"type":  "secret",
+				"value": "0ld-s3cr3t",
+			}),
+		}),
+		func(c *Client) {
+			secret, err := c.GetRotatedClientSecret(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, "0ld-s3cr3t", secret)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertion(t, 404, expectedPath),
+		}),
+		func(c *Client) {
+			_, err := c.GetRotatedClientSecret(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.InvalidateRotatedClientSecret(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 404, expectedPath),
+		}),
+		func(c *Client) {
+			err := c.InvalidateRotatedClientSecret(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_RegenerateClientRegistrationToken(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionBody(t, 200, fmt.Sprintf(ClientRegistrationTokenPath, realm.Spec.Realm.Realm, clientID), map[string]interface{}{
+				"id":                      clientID,
+				"clientId":                "dynamic-client",
+				"registrationAccessToken": "n3w-t0k3n",
+			}),
+		}),
+		func(c *Client) {
+			token, err := c.RegenerateClientRegistrationToken(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, "n3w-t0k3n", token)
+		},
+	)
+}
+
+func TestClient_PushClientRevocation(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	result := &GlobalRequestResult{
+		SuccessRequests: []string{"http://node-1:8080/app"},
+		FailedRequests:  []string{"http://node-2:8080/app"},
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionBody(t, 200, fmt.Sprintf(ClientPushRevocationPath, realm.Spec.Realm.Realm, clientID), result),
+		}),
+		func(c *Client) {
+			res, err := c.PushClientRevocation(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, result, res)
+		},
+	)
+}
+
+func TestClient_ClientNodes(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	const nodeHost string = "app-1.app.svc.cluster.local"
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, fmt.Sprintf(ClientNodesPath, realm.Spec.Realm.Realm, clientID), req.URL.Path)
+				node := map[string]string{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&node))
+				assert.Equal(t, map[string]string{"host": nodeHost}, node)
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.RegisterClientNode(context.TODO(), clientID, realm.Spec.Realm.Realm, nodeHost)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withPathAssertion(t, 204, fmt.Sprintf(ClientNodePath, realm.Spec.Realm.Realm, clientID, nodeHost)),
+		}),
+		func(c *Client) {
+			err := c.UnregisterClientNode(context.TODO(), clientID, realm.Spec.Realm.Realm, nodeHost)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{}),
+		func(c *Client) {
+			err := c.UnregisterClientNode(context.TODO(), clientID, realm.Spec.Realm.Realm, "")
+			assert.EqualError(t, err, "node host must be set")
+		},
+	)
+
+	result := &GlobalRequestResult{
+		SuccessRequests: []string{"http://app-1.app.svc.cluster.local:8080/app"},
+	}
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(ClientTestNodesAvailablePath, realm.Spec.Realm.Realm, clientID), result),
+		}),
+		func(c *Client) {
+			res, err := c.TestClientNodesAvailable(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, result, res)
+		},
+	)
+}
+
+func TestClient_GetClientServiceAccountUser(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	user := &v1alpha1.KeycloakAPIUser{ID: "user-12345", UserName: "service-account-client"}
+
+	serviceAccountUserHandler := func(serviceAccountsEnabled bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			switch req.URL.Path {
+			case fmt.Sprintf(ClientGetPath, realm.Spec.Realm.Realm, clientID):
+				_, err := respondWithJSON(&v1alpha1.KeycloakAPIClient{ID: clientID, ServiceAccountsEnabled: serviceAccountsEnabled}, w)
+				assert.NoError(t, err)
+			case fmt.Sprintf(ClientServiceAccountUserPath, realm.Spec.Realm.Realm, clientID):
+				_, err := respondWithJSON(user, w)
+				assert.NoError(t, err)
+			default:
+				t.Errorf("unexpected request to %s", req.URL.Path)
+			}
+		}
+	}
+
+	testClientHTTPRequest(
+		serviceAccountUserHandler(true),
+		func(c *Client) {
+			result, err := c.GetClientServiceAccountUser(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, user, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		serviceAccountUserHandler(false),
+		func(c *Client) {
+			_, err := c.GetClientServiceAccountUser(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.Equal(t, ErrServiceAccountsDisabled, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertion(t, 404, fmt.Sprintf(ClientGetPath, realm.Spec.Realm.Realm, clientID)),
+		}),
+		func(c *Client) {
+			_, err := c.GetClientServiceAccountUser(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_Retry(t *testing.T) {
+	realm := getDummyRealm()
+	attempts := 0
+	failures := 2
+	status := http.StatusServiceUnavailable
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		// the body is sent again with each attempt
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), "tenant")
+		if attempts <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithRetry(3, time.Millisecond))
+	assert.NoError(t, err)
+	scope := &ClientScope{ID: "scope-12345", Name: "tenant"}
+
+	err = c.UpdateClientScope(context.TODO(), scope, realm.Spec.Realm.Realm)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	// the last response is returned when all the attempts fail
+	attempts, failures = 0, 3
+	err = c.UpdateClientScope(context.TODO(), scope, realm.Spec.Realm.Realm)
+	assert.Error(t, err)
+	assert.Equal(t, 3, attempts)
+
+	// 4xx responses aren't retried
+	attempts, status = 0, http.StatusBadRequest
+	err = c.UpdateClientScope(context.TODO(), scope, realm.Spec.Realm.Realm)
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+
+	// Keycloak may have applied a POST that failed with a 5xx response, so
+	// it isn't retried
+	attempts, failures, status = 0, 1, http.StatusBadGateway
+	err = c.CreateClientScope(context.TODO(), scope, realm.Spec.Realm.Realm)
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+
+	// the retries stop when the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	c, err = NewClient(server.URL, WithRetry(3, time.Minute))
+	assert.NoError(t, err)
+	attempts, status = 0, http.StatusServiceUnavailable
+	err = c.UpdateClientScope(ctx, scope, realm.Spec.Realm.Realm)
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+type requesterFunc func(req *http.Request) (*http.Response, error)
+
+func (f requesterFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_RetryPostNetworkError(t *testing.T) {
+	realm := getDummyRealm()
+	scope := &ClientScope{Name: "tenant"}
+	dialErr := &url.Error{Op: "Post", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+	resetErr := &url.Error{Op: "Post", Err: &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}}
+
+	attempts := 0
+	newClient := func(firstErr error) *Client {
+		attempts = 0
+		return &Client{
+			requester: &retryRequester{
+				requester: requesterFunc(func(req *http.Request) (*http.Response, error) {
+					attempts++
+					if attempts == 1 {
+						return nil, firstErr
+					}
+					return &http.Response{
+						StatusCode: http.StatusCreated,
+						Header:     http.Header{"Location": {req.URL.Path + "/scope-12345"}},
+						Body:       ioutil.NopCloser(strings.NewReader("")),
+					}, nil
+				}),
+				maxAttempts:  3,
+				initialDelay: time.Millisecond,
+			},
+			URL:   "http://keycloak.example.com",
+			token: "dummy",
+		}
+	}
+
+	// the request wasn't sent when the connection couldn't be established
+	err := newClient(dialErr).CreateClientScope(context.TODO(), scope, realm.Spec.Realm.Realm)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+
+	// the request may have been applied when the connection broke afterwards
+	err = newClient(resetErr).CreateClientScope(context.TODO(), scope, realm.Spec.Realm.Realm)
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestClient_GetClientInstall(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	expectedPath := fmt.Sprintf(ClientInstallationProviderPath, realm.Spec.Realm.Realm, clientID, "keycloak-oidc-keycloak-json")
+
+	testClientHTTPRequest(
+		withPathAssertion(t, 404, expectedPath),
+		func(c *Client) {
+			install, err := c.GetClientInstall(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+			assert.Nil(t, install)
+		},
+	)
+}
+
+func TestClient_GetClientInstallationProvider(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		clientID   = "client-12345"
+		providerID = "saml-idp-descriptor"
+	)
+	expectedPath := fmt.Sprintf(ClientInstallationProviderPath, realm.Spec.Realm.Realm, clientID, providerID)
+	descriptor := `<EntityDescriptor entityID="https://keycloak.example.com"/>`
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, expectedPath, req.URL.Path)
+				w.Header().Set("Content-Type", "application/xml")
+				_, err := w.Write([]byte(descriptor))
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			installation, err := c.GetClientInstallationProvider(context.TODO(), clientID, realm.Spec.Realm.Realm, providerID)
+			assert.NoError(t, err)
+			assert.Equal(t, "application/xml", installation.ContentType)
+			assert.Equal(t, descriptor, string(installation.Data))
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertion(t, 404, expectedPath),
+		}),
+		func(c *Client) {
+			_, err := c.GetClientInstallationProvider(context.TODO(), clientID, realm.Spec.Realm.Realm, providerID)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+}
+
+func TestClient_ClientCertificates(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	const attr string = "saml.signing"
+	certificatePath := fmt.Sprintf(ClientCertificatePath, realm.Spec.Realm.Realm, clientID, attr)
+	certificate := &Certificate{Kid: "kid-12345", Certificate: "MIICnTCCAYUCBgF", PrivateKey: "MIIEvQIBADANBgk"}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, certificatePath, &Certificate{Kid: certificate.Kid, Certificate: certificate.Certificate}),
+		}),
+		func(c *Client) {
+			result, err := c.GetClientCertificate(context.TODO(), clientID, realm.Spec.Realm.Realm, attr)
+			assert.NoError(t, err)
+			assert.Equal(t, certificate.Certificate, result.Certificate)
+			assert.Empty(t, result.PrivateKey)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertion(t, 404, certificatePath),
+		}),
+		func(c *Client) {
+			_, err := c.GetClientCertificate(context.TODO(), clientID, realm.Spec.Realm.Realm, attr)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertionBody(t, 200, certificatePath+"/generate", certificate),
+		}),
+		func(c *Client) {
+			result, err := c.GenerateClientCertificate(context.TODO(), clientID, realm.Spec.Realm.Realm, attr)
+			assert.NoError(t, err)
+			assert.Equal(t, certificate, result)
+		},
+	)
+
+	pem := []byte("-----BEGIN CERTIFICATE-----\nMIICnTCCAYUCBgF\n-----END CERTIFICATE-----\n")
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, certificatePath+"/upload", req.URL.Path)
+				assert.NoError(t, req.ParseMultipartForm(1<<20))
+				assert.Equal(t, "Certificate PEM", req.FormValue("keystoreFormat"))
+				_, ok := req.MultipartForm.Value["keyAlias"]
+				assert.False(t, ok)
+				file, _, err := req.FormFile("file")
+				assert.NoError(t, err)
+				data, err := ioutil.ReadAll(file)
+				assert.NoError(t, err)
+				assert.Equal(t, pem, data)
+				_, err = respondWithJSON(&Certificate{Certificate: certificate.Certificate}, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			result, err := c.UploadClientCertificate(context.TODO(), clientID, realm.Spec.Realm.Realm, attr, &KeyStoreConfig{Format: "Certificate PEM"}, pem)
+			assert.NoError(t, err)
+			assert.Equal(t, certificate.Certificate, result.Certificate)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{}),
+		func(c *Client) {
+			_, err := c.UploadClientCertificate(context.TODO(), clientID, realm.Spec.Realm.Realm, attr, &KeyStoreConfig{}, pem)
+			assert.EqualError(t, err, "keystore format must be set")
+		},
+	)
+
+	keystore := []byte{0xfe, 0xed, 0xfe, 0xed}
+	config := &KeyStoreConfig{Format: "JKS", KeyAlias: "client", KeyPassword: "key-secret", StorePassword: "store-secret"}
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, certificatePath+"/download", req.URL.Path)
+				received := &KeyStoreConfig{}
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(received))
+				assert.Equal(t, config, received)
+				w.Header().Set("Content-Type", "application/octet-stream")
+				_, err := w.Write(keystore)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			result, err := c.DownloadClientKeystore(context.TODO(), clientID, realm.Spec.Realm.Realm, attr, config)
+			assert.NoError(t, err)
+			assert.Equal(t, keystore, result)
+		},
+	)
+}
+
+func TestClient_ClientRoles(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	role := &v1alpha1.KeycloakUserRole{ID: "role-12345", Name: "view reports/finance", ClientRole: true}
+	// the name is escaped, a slash would otherwise add a path segment
+	escapedRolePath := fmt.Sprintf(ClientRolePath, realm.Spec.Realm.Realm, clientID, "view%20reports%2Ffinance")
+	withEscapedPathAssertion := func(status int, body interface{}) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, escapedRolePath, req.URL.EscapedPath())
+			if body == nil {
+				w.WriteHeader(status)
+				return
+			}
+			withJSON(t, body, status)(w, req)
+		}
+	}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertion(t, 201, fmt.Sprintf(ClientRolesPath, realm.Spec.Realm.Realm, clientID)),
+		}),
+		func(c *Client) {
+			err := c.CreateClientRole(context.TODO(), clientID, realm.Spec.Realm.Realm, role)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: withPathAssertion(t, 409, fmt.Sprintf(ClientRolesPath, realm.Spec.Realm.Realm, clientID)),
+		}),
+		func(c *Client) {
+			err := c.CreateClientRole(context.TODO(), clientID, realm.Spec.Realm.Realm, role)
+			assert.True(t, errors.Is(err, ErrAlreadyExists))
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, fmt.Sprintf(ClientRolesPath, realm.Spec.Realm.Realm, clientID), []*v1alpha1.KeycloakUserRole{role}),
+		}),
+		func(c *Client) {
+			roles, err := c.ListClientRoles(context.TODO(), clientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, []*v1alpha1.KeycloakUserRole{role}, roles)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withEscapedPathAssertion(200, role),
+		}),
+		func(c *Client) {
+			result, err := c.GetClientRoleByName(context.TODO(), clientID, role.Name, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, role, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withEscapedPathAssertion(404, nil),
+		}),
+		func(c *Client) {
+			_, err := c.GetClientRoleByName(context.TODO(), clientID, role.Name, realm.Spec.Realm.Realm)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPut: withEscapedPathAssertion(204, nil),
+		}),
+		func(c *Client) {
+			err := c.UpdateClientRole(context.TODO(), clientID, realm.Spec.Realm.Realm, role)
+			assert.NoError(t, err)
+
+			err = c.UpdateClientRole(context.TODO(), clientID, realm.Spec.Realm.Realm, &v1alpha1.KeycloakUserRole{})
+			assert.Error(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: withEscapedPathAssertion(204, nil),
+		}),
+		func(c *Client) {
+			err := c.DeleteClientRole(context.TODO(), clientID, role.Name, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+		},
+	)
+}
+
+func TestClient_ListUsersInClientRole(t *testing.T) {
+	realm := getDummyRealm()
+	const clientID string = "client-12345"
+	users := []*v1alpha1.KeycloakAPIUser{{ID: "user-12345", UserName: "dummy"}}
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, req *http.Request) {
+				// the name is escaped, a slash would otherwise add a path segment
+				assert.Equal(t, fmt.Sprintf(ClientRolePath, realm.Spec.Realm.Realm, clientID, "app%2Fadmin")+"/users", req.URL.EscapedPath())
+				assert.Equal(t, "100", req.URL.Query().Get("first"))
+				assert.Equal(t, "50", req.URL.Query().Get("max"))
+				_, err := respondWithJSON(users, w)
+				assert.NoError(t, err)
+			},
+		}),
+		func(c *Client) {
+			found, err := c.ListUsersInClientRole(context.TODO(), clientID, "app/admin", realm.Spec.Realm.Realm, 100, 50)
+			assert.NoError(t, err)
+			assert.Equal(t, users, found)
+		},
+	)
+}
+
+func TestClient_ClientRoleComposites(t *testing.T) {
+	realm := getDummyRealm()
+	const (
+		clientID      = "client-12345"
+		otherClientID = "client-67890"
+		roleName      = "admin"
+	)
+	composites := []*v1alpha1.KeycloakUserRole{{ID: "role-12345", Name: "view-reports"}}
+	expectedPath := fmt.Sprintf(ClientRoleCompositesPath, realm.Spec.Realm.Realm, clientID, roleName)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodPost: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, expectedPath, req.URL.Path)
+				var body []*v1alpha1.KeycloakUserRole
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+				assert.Equal(t, composites, body)
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.AddCompositesToClientRole(context.TODO(), clientID, roleName, realm.Spec.Realm.Realm, composites)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodDelete: func(w http.ResponseWriter, req *http.Request) {
+				assert.Equal(t, expectedPath, req.URL.Path)
+				var body []*v1alpha1.KeycloakUserRole
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+				assert.Equal(t, composites, body)
+				w.WriteHeader(204)
+			},
+		}),
+		func(c *Client) {
+			err := c.RemoveCompositesFromClientRole(context.TODO(), clientID, roleName, realm.Spec.Realm.Realm, composites)
+			assert.NoError(t, err)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath, composites),
+		}),
+		func(c *Client) {
+			result, err := c.ListClientRoleComposites(context.TODO(), clientID, roleName, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, composites, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath+"/realm", composites),
+		}),
+		func(c *Client) {
+			result, err := c.ListClientRoleRealmComposites(context.TODO(), clientID, roleName, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, composites, result)
+		},
+	)
+
+	testClientHTTPRequest(
+		withMethodSelection(t, map[string]http.HandlerFunc{
+			http.MethodGet: withPathAssertionBody(t, 200, expectedPath+"/clients/"+otherClientID, composites),
+		}),
+		func(c *Client) {
+			result, err := c.ListClientRoleClientComposites(context.TODO(), clientID, roleName, otherClientID, realm.Spec.Realm.Realm)
+			assert.NoError(t, err)
+			assert.Equal(t, composites, result)
+		},
+	)
+}
+
+func TestNewClient(t *testing.T) {
+	realm := getDummyRealm()
+	logins := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case TokenPath:
+			logins++
+			_, err := respondWithJSON(map[string]interface{}{
+				"access_token": "token",
+				"expires_in":   60,
+			}, w)
+			assert.NoError(t, err)
+		case fmt.Sprintf(RealmEventsConfigPath, realm.Spec.Realm.Realm):
+			assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+			_, err := respondWithJSON(&RealmEventsConfig{}, w)
+			assert.NoError(t, err)
+		default:
+			t.Errorf("unexpected request to %s", req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient(server.URL+"/", WithCredentials("admin", "password"), WithTimeout(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, server.URL, c.URL)
+
+	// the client logs in on first use only
+	assert.Equal(t, 0, logins)
+	_, err = c.GetRealmEventsConfig(context.TODO(), realm.Spec.Realm.Realm)
+	assert.NoError(t, err)
+	_, err = c.GetRealmEventsConfig(context.TODO(), realm.Spec.Realm.Realm)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, logins)
+
+	_, err = NewClient("keycloak.example.com")
+	assert.Error(t, err)
+
+	_, err = NewClient(server.URL, WithHTTPClient(server.Client()), WithTimeout(time.Second))
+	assert.Error(t, err)
+
+	_, err = NewClient(server.URL, WithHTTPClient(server.Client()), WithInsecureSkipVerify())
+	assert.Error(t, err)
+
+	// WithInsecureSkipVerify doesn't modify the config given to WithTLSConfig
+	tlsConfig := &tls.Config{ServerName: "keycloak.example.com"}
+	c, err = NewClient(server.URL, WithTLSConfig(tlsConfig), WithInsecureSkipVerify())
+	assert.NoError(t, err)
+	transport := c.requester.(*http.Client).Transport.(*http.Transport)
+	assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+	assert.Equal(t, "keycloak.example.com", transport.TLSClientConfig.ServerName)
+	assert.False(t, tlsConfig.InsecureSkipVerify)
+
+	// the proxy is used for all the requests, the TLS options are kept
+	proxyURL, err := url.Parse("http://proxy.example.com:3128")
+	assert.NoError(t, err)
+	c, err = NewClient(server.URL, WithProxy(proxyURL), WithTLSConfig(tlsConfig))
+	assert.NoError(t, err)
+	transport = c.requester.(*http.Client).Transport.(*http.Transport)
+	assert.Equal(t, tlsConfig, transport.TLSClientConfig)
+	proxy, err := transport.Proxy(httptest.NewRequest(http.MethodGet, server.URL, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, proxyURL, proxy)
+
+	_, err = NewClient(server.URL, WithHTTPClient(server.Client()), WithProxy(proxyURL))
+	assert.Error(t, err)
 }
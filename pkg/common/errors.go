@@ -0,0 +1,92 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNotFound is returned when the requested resource doesn't exist in Keycloak,
+// it's usually wrapped in an *APIError so check for it with errors.Is
+var ErrNotFound = errors.New("resource not found")
+
+// ErrAlreadyExists is returned when Keycloak rejects a create request because
+// the resource already exists, it's usually wrapped in an *APIError so check
+// for it with errors.Is
+var ErrAlreadyExists = errors.New("resource already exists")
+
+// ErrUnauthorized is returned when Keycloak rejects the credentials or the
+// auth token
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrForbidden is returned when the user isn't allowed to perform the request
+var ErrForbidden = errors.New("forbidden")
+
+// ErrNoSecret is returned when the client has no secret, e.g. because it's a
+// public client
+var ErrNoSecret = errors.New("client has no secret")
+
+// ErrServiceAccountsDisabled is returned when the service account user of a
+// client without service accounts enabled is requested
+var ErrServiceAccountsDisabled = errors.New("client has service accounts disabled")
+
+// APIError is returned when Keycloak responds with an unexpected status code,
+// use errors.Is with ErrNotFound, ErrAlreadyExists, ErrUnauthorized and
+// ErrForbidden to classify it
+type APIError struct {
+	StatusCode int
+	// Body is the response body, Keycloak usually describes the error in it
+	Body    string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return e.Message
+}
+
+// Is reports whether the status code of the error matches the target error
+func (e *APIError) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusConflict:
+		return target == ErrAlreadyExists
+	}
+	return false
+}
+
+// PasswordResetIncompleteError is returned by SetTemporaryPassword when the
+// password was reset but the UPDATE_PASSWORD required action couldn't be set
+// on the user
+type PasswordResetIncompleteError struct {
+	UserID string
+	Err    error
+}
+
+func (e *PasswordResetIncompleteError) Error() string {
+	return fmt.Sprintf("password for user %s was reset but the required action couldn't be set: %v", e.UserID, e.Err)
+}
+
+func (e *PasswordResetIncompleteError) Unwrap() error {
+	return e.Err
+}
+
+// ClientScopeCopyIncompleteError is returned by CopyClientScope when the new
+// client scope was created but not all the protocol mappers could be copied to
+// it
+type ClientScopeCopyIncompleteError struct {
+	ScopeID string
+	Err     error
+}
+
+func (e *ClientScopeCopyIncompleteError) Error() string {
+	return fmt.Sprintf("client scope %s was created but its protocol mappers couldn't be copied: %v", e.ScopeID, e.Err)
+}
+
+func (e *ClientScopeCopyIncompleteError) Unwrap() error {
+	return e.Err
+}
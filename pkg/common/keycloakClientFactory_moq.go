@@ -4,6 +4,7 @@
 package common
 
 import (
+	"context"
 	"github.com/keycloak/keycloak-operator/pkg/apis/keycloak/v1alpha1"
 	"sync"
 )
@@ -22,7 +23,7 @@ var _ KeycloakClientFactory = &KeycloakClientFactoryMock{}
 //
 //         // make and configure a mocked KeycloakClientFactory
 //         mockedKeycloakClientFactory := &KeycloakClientFactoryMock{
-//             AuthenticatedClientFunc: func(kc v1alpha1.Keycloak) (KeycloakInterface, error) {
+//             AuthenticatedClientFunc: func(ctx context.Context, kc v1alpha1.Keycloak) (KeycloakInterface, error) {
 // 	               panic("mock out the AuthenticatedClient method")
 //             },
 //         }
@@ -33,12 +34,14 @@ var _ KeycloakClientFactory = &KeycloakClientFactoryMock{}
 //     }
 type KeycloakClientFactoryMock struct {
 	// AuthenticatedClientFunc mocks the AuthenticatedClient method.
-	AuthenticatedClientFunc func(kc v1alpha1.Keycloak) (KeycloakInterface, error)
+	AuthenticatedClientFunc func(ctx context.Context, kc v1alpha1.Keycloak) (KeycloakInterface, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
 		// AuthenticatedClient holds details about calls to the AuthenticatedClient method.
 		AuthenticatedClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Kc is the kc argument value.
 			Kc v1alpha1.Keycloak
 		}
@@ -46,29 +49,33 @@ type KeycloakClientFactoryMock struct {
 }
 
 // AuthenticatedClient calls AuthenticatedClientFunc.
-func (mock *KeycloakClientFactoryMock) AuthenticatedClient(kc v1alpha1.Keycloak) (KeycloakInterface, error) {
+func (mock *KeycloakClientFactoryMock) AuthenticatedClient(ctx context.Context, kc v1alpha1.Keycloak) (KeycloakInterface, error) {
 	if mock.AuthenticatedClientFunc == nil {
 		panic("KeycloakClientFactoryMock.AuthenticatedClientFunc: method is nil but KeycloakClientFactory.AuthenticatedClient was just called")
 	}
 	callInfo := struct {
-		Kc v1alpha1.Keycloak
+		Ctx context.Context
+		Kc  v1alpha1.Keycloak
 	}{
-		Kc: kc,
+		Ctx: ctx,
+		Kc:  kc,
 	}
 	lockKeycloakClientFactoryMockAuthenticatedClient.Lock()
 	mock.calls.AuthenticatedClient = append(mock.calls.AuthenticatedClient, callInfo)
 	lockKeycloakClientFactoryMockAuthenticatedClient.Unlock()
-	return mock.AuthenticatedClientFunc(kc)
+	return mock.AuthenticatedClientFunc(ctx, kc)
 }
 
 // AuthenticatedClientCalls gets all the calls that were made to AuthenticatedClient.
 // Check the length with:
 //     len(mockedKeycloakClientFactory.AuthenticatedClientCalls())
 func (mock *KeycloakClientFactoryMock) AuthenticatedClientCalls() []struct {
-	Kc v1alpha1.Keycloak
+	Ctx context.Context
+	Kc  v1alpha1.Keycloak
 } {
 	var calls []struct {
-		Kc v1alpha1.Keycloak
+		Ctx context.Context
+		Kc  v1alpha1.Keycloak
 	}
 	lockKeycloakClientFactoryMockAuthenticatedClient.RLock()
 	calls = mock.calls.AuthenticatedClient
@@ -4,71 +4,221 @@
 package common
 
 import (
+	"context"
+	"encoding/json"
 	"github.com/keycloak/keycloak-operator/pkg/apis/keycloak/v1alpha1"
 	"sync"
 )
 
 var (
-	lockKeycloakInterfaceMockAddUserToGroup                       sync.RWMutex
-	lockKeycloakInterfaceMockCreateAuthenticatorConfig            sync.RWMutex
-	lockKeycloakInterfaceMockCreateClient                         sync.RWMutex
-	lockKeycloakInterfaceMockCreateFederatedIdentity              sync.RWMutex
-	lockKeycloakInterfaceMockCreateGroup                          sync.RWMutex
-	lockKeycloakInterfaceMockCreateGroupClientRole                sync.RWMutex
-	lockKeycloakInterfaceMockCreateGroupRealmRole                 sync.RWMutex
-	lockKeycloakInterfaceMockCreateIdentityProvider               sync.RWMutex
-	lockKeycloakInterfaceMockCreateRealm                          sync.RWMutex
-	lockKeycloakInterfaceMockCreateUser                           sync.RWMutex
-	lockKeycloakInterfaceMockCreateUserClientRole                 sync.RWMutex
-	lockKeycloakInterfaceMockCreateUserRealmRole                  sync.RWMutex
-	lockKeycloakInterfaceMockDeleteAuthenticatorConfig            sync.RWMutex
-	lockKeycloakInterfaceMockDeleteClient                         sync.RWMutex
-	lockKeycloakInterfaceMockDeleteIdentityProvider               sync.RWMutex
-	lockKeycloakInterfaceMockDeleteRealm                          sync.RWMutex
-	lockKeycloakInterfaceMockDeleteUser                           sync.RWMutex
-	lockKeycloakInterfaceMockDeleteUserClientRole                 sync.RWMutex
-	lockKeycloakInterfaceMockDeleteUserFromGroup                  sync.RWMutex
-	lockKeycloakInterfaceMockDeleteUserRealmRole                  sync.RWMutex
-	lockKeycloakInterfaceMockFindAuthenticationExecutionForFlow   sync.RWMutex
-	lockKeycloakInterfaceMockFindAvailableGroupClientRole         sync.RWMutex
-	lockKeycloakInterfaceMockFindGroupByName                      sync.RWMutex
-	lockKeycloakInterfaceMockFindGroupClientRole                  sync.RWMutex
-	lockKeycloakInterfaceMockFindUserByEmail                      sync.RWMutex
-	lockKeycloakInterfaceMockFindUserByUsername                   sync.RWMutex
-	lockKeycloakInterfaceMockGetAuthenticatorConfig               sync.RWMutex
-	lockKeycloakInterfaceMockGetClient                            sync.RWMutex
-	lockKeycloakInterfaceMockGetClientInstall                     sync.RWMutex
-	lockKeycloakInterfaceMockGetClientSecret                      sync.RWMutex
-	lockKeycloakInterfaceMockGetIdentityProvider                  sync.RWMutex
-	lockKeycloakInterfaceMockGetRealm                             sync.RWMutex
-	lockKeycloakInterfaceMockGetUser                              sync.RWMutex
-	lockKeycloakInterfaceMockGetUserFederatedIdentities           sync.RWMutex
-	lockKeycloakInterfaceMockListAuthenticationExecutionsForFlow  sync.RWMutex
-	lockKeycloakInterfaceMockListAvailableGroupClientRoles        sync.RWMutex
-	lockKeycloakInterfaceMockListAvailableGroupRealmRoles         sync.RWMutex
-	lockKeycloakInterfaceMockListAvailableUserClientRoles         sync.RWMutex
-	lockKeycloakInterfaceMockListAvailableUserRealmRoles          sync.RWMutex
-	lockKeycloakInterfaceMockListClients                          sync.RWMutex
-	lockKeycloakInterfaceMockListDefaultGroups                    sync.RWMutex
-	lockKeycloakInterfaceMockListGroupClientRoles                 sync.RWMutex
-	lockKeycloakInterfaceMockListGroupRealmRoles                  sync.RWMutex
-	lockKeycloakInterfaceMockListIdentityProviders                sync.RWMutex
-	lockKeycloakInterfaceMockListRealms                           sync.RWMutex
-	lockKeycloakInterfaceMockListUserClientRoles                  sync.RWMutex
-	lockKeycloakInterfaceMockListUserRealmRoles                   sync.RWMutex
-	lockKeycloakInterfaceMockListUsers                            sync.RWMutex
-	lockKeycloakInterfaceMockListUsersInGroup                     sync.RWMutex
-	lockKeycloakInterfaceMockMakeGroupDefault                     sync.RWMutex
-	lockKeycloakInterfaceMockPing                                 sync.RWMutex
-	lockKeycloakInterfaceMockRemoveFederatedIdentity              sync.RWMutex
-	lockKeycloakInterfaceMockSetGroupChild                        sync.RWMutex
-	lockKeycloakInterfaceMockUpdateAuthenticationExecutionForFlow sync.RWMutex
-	lockKeycloakInterfaceMockUpdateAuthenticatorConfig            sync.RWMutex
-	lockKeycloakInterfaceMockUpdateClient                         sync.RWMutex
-	lockKeycloakInterfaceMockUpdateIdentityProvider               sync.RWMutex
-	lockKeycloakInterfaceMockUpdatePassword                       sync.RWMutex
-	lockKeycloakInterfaceMockUpdateRealm                          sync.RWMutex
-	lockKeycloakInterfaceMockUpdateUser                           sync.RWMutex
+	lockKeycloakInterfaceMockAddClientScopeClientScopeMappings           sync.RWMutex
+	lockKeycloakInterfaceMockAddClientScopeRealmScopeMappings            sync.RWMutex
+	lockKeycloakInterfaceMockAddCompositesToClientRole                   sync.RWMutex
+	lockKeycloakInterfaceMockAddUserToGroup                              sync.RWMutex
+	lockKeycloakInterfaceMockAssignDefaultClientScopeToClient            sync.RWMutex
+	lockKeycloakInterfaceMockAssignOptionalClientScopeToClient           sync.RWMutex
+	lockKeycloakInterfaceMockBulkCreateUsers                             sync.RWMutex
+	lockKeycloakInterfaceMockClearAdminEvents                            sync.RWMutex
+	lockKeycloakInterfaceMockClearBruteForceForUser                      sync.RWMutex
+	lockKeycloakInterfaceMockClearRealmEvents                            sync.RWMutex
+	lockKeycloakInterfaceMockCopyClientScope                             sync.RWMutex
+	lockKeycloakInterfaceMockCountClientOfflineSessions                  sync.RWMutex
+	lockKeycloakInterfaceMockCountClientSessions                         sync.RWMutex
+	lockKeycloakInterfaceMockCountGroups                                 sync.RWMutex
+	lockKeycloakInterfaceMockCreateAuthenticatorConfig                   sync.RWMutex
+	lockKeycloakInterfaceMockCreateAuthzPolicy                           sync.RWMutex
+	lockKeycloakInterfaceMockCreateAuthzResource                         sync.RWMutex
+	lockKeycloakInterfaceMockCreateAuthzResourcePermission               sync.RWMutex
+	lockKeycloakInterfaceMockCreateAuthzScope                            sync.RWMutex
+	lockKeycloakInterfaceMockCreateAuthzScopePermission                  sync.RWMutex
+	lockKeycloakInterfaceMockCreateChildGroup                            sync.RWMutex
+	lockKeycloakInterfaceMockCreateClient                                sync.RWMutex
+	lockKeycloakInterfaceMockCreateClientRole                            sync.RWMutex
+	lockKeycloakInterfaceMockCreateClientScope                           sync.RWMutex
+	lockKeycloakInterfaceMockCreateComponent                             sync.RWMutex
+	lockKeycloakInterfaceMockCreateFederatedIdentity                     sync.RWMutex
+	lockKeycloakInterfaceMockCreateGroup                                 sync.RWMutex
+	lockKeycloakInterfaceMockCreateGroupClientRole                       sync.RWMutex
+	lockKeycloakInterfaceMockCreateGroupRealmRole                        sync.RWMutex
+	lockKeycloakInterfaceMockCreateIdentityProvider                      sync.RWMutex
+	lockKeycloakInterfaceMockCreateIdentityProviderMapper                sync.RWMutex
+	lockKeycloakInterfaceMockCreateProtocolMapperForClient               sync.RWMutex
+	lockKeycloakInterfaceMockCreateProtocolMapperForClientScope          sync.RWMutex
+	lockKeycloakInterfaceMockCreateRealm                                 sync.RWMutex
+	lockKeycloakInterfaceMockCreateUser                                  sync.RWMutex
+	lockKeycloakInterfaceMockCreateUserClientRole                        sync.RWMutex
+	lockKeycloakInterfaceMockCreateUserRealmRole                         sync.RWMutex
+	lockKeycloakInterfaceMockDeleteAuthenticatorConfig                   sync.RWMutex
+	lockKeycloakInterfaceMockDeleteAuthzPermission                       sync.RWMutex
+	lockKeycloakInterfaceMockDeleteAuthzPolicy                           sync.RWMutex
+	lockKeycloakInterfaceMockDeleteAuthzResource                         sync.RWMutex
+	lockKeycloakInterfaceMockDeleteAuthzScope                            sync.RWMutex
+	lockKeycloakInterfaceMockDeleteClient                                sync.RWMutex
+	lockKeycloakInterfaceMockDeleteClientRole                            sync.RWMutex
+	lockKeycloakInterfaceMockDeleteClientScope                           sync.RWMutex
+	lockKeycloakInterfaceMockDeleteClientScopeClientScopeMappings        sync.RWMutex
+	lockKeycloakInterfaceMockDeleteClientScopeRealmScopeMappings         sync.RWMutex
+	lockKeycloakInterfaceMockDeleteComponent                             sync.RWMutex
+	lockKeycloakInterfaceMockDeleteGroup                                 sync.RWMutex
+	lockKeycloakInterfaceMockDeleteGroupClientRole                       sync.RWMutex
+	lockKeycloakInterfaceMockDeleteIdentityProvider                      sync.RWMutex
+	lockKeycloakInterfaceMockDeleteIdentityProviderMapper                sync.RWMutex
+	lockKeycloakInterfaceMockDeleteProtocolMapperForClient               sync.RWMutex
+	lockKeycloakInterfaceMockDeleteProtocolMapperForClientScope          sync.RWMutex
+	lockKeycloakInterfaceMockDeleteRealm                                 sync.RWMutex
+	lockKeycloakInterfaceMockDeleteRequiredAction                        sync.RWMutex
+	lockKeycloakInterfaceMockDeleteUser                                  sync.RWMutex
+	lockKeycloakInterfaceMockDeleteUserClientRole                        sync.RWMutex
+	lockKeycloakInterfaceMockDeleteUserCredential                        sync.RWMutex
+	lockKeycloakInterfaceMockDeleteUserFromGroup                         sync.RWMutex
+	lockKeycloakInterfaceMockDeleteUserRealmRole                         sync.RWMutex
+	lockKeycloakInterfaceMockDeleteUserSession                           sync.RWMutex
+	lockKeycloakInterfaceMockDownloadClientKeystore                      sync.RWMutex
+	lockKeycloakInterfaceMockEvaluateAuthzPermissions                    sync.RWMutex
+	lockKeycloakInterfaceMockExchangeToken                               sync.RWMutex
+	lockKeycloakInterfaceMockFindAuthenticationExecutionForFlow          sync.RWMutex
+	lockKeycloakInterfaceMockFindAvailableGroupClientRole                sync.RWMutex
+	lockKeycloakInterfaceMockFindClientByClientID                        sync.RWMutex
+	lockKeycloakInterfaceMockFindClientScopeByName                       sync.RWMutex
+	lockKeycloakInterfaceMockFindGroupByName                             sync.RWMutex
+	lockKeycloakInterfaceMockFindGroupByNameInHierarchy                  sync.RWMutex
+	lockKeycloakInterfaceMockFindGroupClientRole                         sync.RWMutex
+	lockKeycloakInterfaceMockFindUserByEmail                             sync.RWMutex
+	lockKeycloakInterfaceMockFindUserByUsername                          sync.RWMutex
+	lockKeycloakInterfaceMockGenerateClientCertificate                   sync.RWMutex
+	lockKeycloakInterfaceMockGenerateExampleAccessToken                  sync.RWMutex
+	lockKeycloakInterfaceMockGetAllGroupMembers                          sync.RWMutex
+	lockKeycloakInterfaceMockGetAuthenticatorConfig                      sync.RWMutex
+	lockKeycloakInterfaceMockGetAuthzPolicy                              sync.RWMutex
+	lockKeycloakInterfaceMockGetAuthzResource                            sync.RWMutex
+	lockKeycloakInterfaceMockGetBruteForceStatus                         sync.RWMutex
+	lockKeycloakInterfaceMockGetClient                                   sync.RWMutex
+	lockKeycloakInterfaceMockGetClientCertificate                        sync.RWMutex
+	lockKeycloakInterfaceMockGetClientInstall                            sync.RWMutex
+	lockKeycloakInterfaceMockGetClientInstallationProvider               sync.RWMutex
+	lockKeycloakInterfaceMockGetClientManagementPermissions              sync.RWMutex
+	lockKeycloakInterfaceMockGetClientRoleByName                         sync.RWMutex
+	lockKeycloakInterfaceMockGetClientScope                              sync.RWMutex
+	lockKeycloakInterfaceMockGetClientSecret                             sync.RWMutex
+	lockKeycloakInterfaceMockGetClientServiceAccountUser                 sync.RWMutex
+	lockKeycloakInterfaceMockGetGroupByPath                              sync.RWMutex
+	lockKeycloakInterfaceMockGetGroupHierarchy                           sync.RWMutex
+	lockKeycloakInterfaceMockGetGroupManagementPermissions               sync.RWMutex
+	lockKeycloakInterfaceMockGetGroupMembers                             sync.RWMutex
+	lockKeycloakInterfaceMockGetIdentityProvider                         sync.RWMutex
+	lockKeycloakInterfaceMockGetJWKS                                     sync.RWMutex
+	lockKeycloakInterfaceMockGetRealm                                    sync.RWMutex
+	lockKeycloakInterfaceMockGetRealmAttribute                           sync.RWMutex
+	lockKeycloakInterfaceMockGetRealmEventsConfig                        sync.RWMutex
+	lockKeycloakInterfaceMockGetRotatedClientSecret                      sync.RWMutex
+	lockKeycloakInterfaceMockGetServerInfo                               sync.RWMutex
+	lockKeycloakInterfaceMockGetUser                                     sync.RWMutex
+	lockKeycloakInterfaceMockGetUserByFederatedIdentity                  sync.RWMutex
+	lockKeycloakInterfaceMockGetUserFederatedIdentities                  sync.RWMutex
+	lockKeycloakInterfaceMockGetWellKnownConfiguration                   sync.RWMutex
+	lockKeycloakInterfaceMockIntrospectToken                             sync.RWMutex
+	lockKeycloakInterfaceMockInvalidateRotatedClientSecret               sync.RWMutex
+	lockKeycloakInterfaceMockListAdminEvents                             sync.RWMutex
+	lockKeycloakInterfaceMockListAuthenticationExecutionsForFlow         sync.RWMutex
+	lockKeycloakInterfaceMockListAuthzPermissionPolicies                 sync.RWMutex
+	lockKeycloakInterfaceMockListAuthzPermissionResources                sync.RWMutex
+	lockKeycloakInterfaceMockListAuthzPermissionScopes                   sync.RWMutex
+	lockKeycloakInterfaceMockListAuthzPermissions                        sync.RWMutex
+	lockKeycloakInterfaceMockListAuthzPolicies                           sync.RWMutex
+	lockKeycloakInterfaceMockListAuthzResources                          sync.RWMutex
+	lockKeycloakInterfaceMockListAuthzScopes                             sync.RWMutex
+	lockKeycloakInterfaceMockListAvailableClientScopeClientScopeMappings sync.RWMutex
+	lockKeycloakInterfaceMockListAvailableClientScopeRealmScopeMappings  sync.RWMutex
+	lockKeycloakInterfaceMockListAvailableGroupClientRoles               sync.RWMutex
+	lockKeycloakInterfaceMockListAvailableGroupRealmRoles                sync.RWMutex
+	lockKeycloakInterfaceMockListAvailableUserClientRoles                sync.RWMutex
+	lockKeycloakInterfaceMockListAvailableUserRealmRoles                 sync.RWMutex
+	lockKeycloakInterfaceMockListClientOfflineSessions                   sync.RWMutex
+	lockKeycloakInterfaceMockListClientRoleClientComposites              sync.RWMutex
+	lockKeycloakInterfaceMockListClientRoleComposites                    sync.RWMutex
+	lockKeycloakInterfaceMockListClientRoleRealmComposites               sync.RWMutex
+	lockKeycloakInterfaceMockListClientRoles                             sync.RWMutex
+	lockKeycloakInterfaceMockListClientScopeClientScopeMappings          sync.RWMutex
+	lockKeycloakInterfaceMockListClientScopeRealmScopeMappings           sync.RWMutex
+	lockKeycloakInterfaceMockListClientScopes                            sync.RWMutex
+	lockKeycloakInterfaceMockListClientSessions                          sync.RWMutex
+	lockKeycloakInterfaceMockListClientUserSessions                      sync.RWMutex
+	lockKeycloakInterfaceMockListClients                                 sync.RWMutex
+	lockKeycloakInterfaceMockListClientsWithParams                       sync.RWMutex
+	lockKeycloakInterfaceMockListComponents                              sync.RWMutex
+	lockKeycloakInterfaceMockListDefaultClientScopesForClient            sync.RWMutex
+	lockKeycloakInterfaceMockListDefaultGroups                           sync.RWMutex
+	lockKeycloakInterfaceMockListEvaluatedProtocolMappers                sync.RWMutex
+	lockKeycloakInterfaceMockListGroupClientRoles                        sync.RWMutex
+	lockKeycloakInterfaceMockListGroupRealmRoles                         sync.RWMutex
+	lockKeycloakInterfaceMockListGroups                                  sync.RWMutex
+	lockKeycloakInterfaceMockListIdentityProviderMappers                 sync.RWMutex
+	lockKeycloakInterfaceMockListIdentityProviders                       sync.RWMutex
+	lockKeycloakInterfaceMockListOfflineSessionsForUser                  sync.RWMutex
+	lockKeycloakInterfaceMockListOptionalClientScopesForClient           sync.RWMutex
+	lockKeycloakInterfaceMockListProtocolMappersForClient                sync.RWMutex
+	lockKeycloakInterfaceMockListProtocolMappersForClientScope           sync.RWMutex
+	lockKeycloakInterfaceMockListRealmEvents                             sync.RWMutex
+	lockKeycloakInterfaceMockListRealmKeys                               sync.RWMutex
+	lockKeycloakInterfaceMockListRealms                                  sync.RWMutex
+	lockKeycloakInterfaceMockListRequiredActions                         sync.RWMutex
+	lockKeycloakInterfaceMockListUserClientRoles                         sync.RWMutex
+	lockKeycloakInterfaceMockListUserConsents                            sync.RWMutex
+	lockKeycloakInterfaceMockListUserCredentials                         sync.RWMutex
+	lockKeycloakInterfaceMockListUserRealmRoles                          sync.RWMutex
+	lockKeycloakInterfaceMockListUserSessions                            sync.RWMutex
+	lockKeycloakInterfaceMockListUsers                                   sync.RWMutex
+	lockKeycloakInterfaceMockListUsersInClientRole                       sync.RWMutex
+	lockKeycloakInterfaceMockListUsersInGroup                            sync.RWMutex
+	lockKeycloakInterfaceMockMakeGroupDefault                            sync.RWMutex
+	lockKeycloakInterfaceMockMoveCredentialAfter                         sync.RWMutex
+	lockKeycloakInterfaceMockMoveCredentialToFirst                       sync.RWMutex
+	lockKeycloakInterfaceMockPatchRealm                                  sync.RWMutex
+	lockKeycloakInterfaceMockPing                                        sync.RWMutex
+	lockKeycloakInterfaceMockPushClientRevocation                        sync.RWMutex
+	lockKeycloakInterfaceMockRefreshToken                                sync.RWMutex
+	lockKeycloakInterfaceMockRegenerateClientRegistrationToken           sync.RWMutex
+	lockKeycloakInterfaceMockRegenerateClientSecret                      sync.RWMutex
+	lockKeycloakInterfaceMockRegisterClientNode                          sync.RWMutex
+	lockKeycloakInterfaceMockRegisterRequiredAction                      sync.RWMutex
+	lockKeycloakInterfaceMockRemoveCompositesFromClientRole              sync.RWMutex
+	lockKeycloakInterfaceMockRemoveDefaultClientScopeFromClient          sync.RWMutex
+	lockKeycloakInterfaceMockRemoveFederatedIdentity                     sync.RWMutex
+	lockKeycloakInterfaceMockRemoveOptionalClientScopeFromClient         sync.RWMutex
+	lockKeycloakInterfaceMockResolveGroupPath                            sync.RWMutex
+	lockKeycloakInterfaceMockRevokeOfflineSession                        sync.RWMutex
+	lockKeycloakInterfaceMockRevokeToken                                 sync.RWMutex
+	lockKeycloakInterfaceMockRevokeUserConsent                           sync.RWMutex
+	lockKeycloakInterfaceMockSetGroupChild                               sync.RWMutex
+	lockKeycloakInterfaceMockSetRealmAttribute                           sync.RWMutex
+	lockKeycloakInterfaceMockSetTemporaryPassword                        sync.RWMutex
+	lockKeycloakInterfaceMockTestClientNodesAvailable                    sync.RWMutex
+	lockKeycloakInterfaceMockTriggerLDAPSync                             sync.RWMutex
+	lockKeycloakInterfaceMockUnlinkAllUsersFromIdP                       sync.RWMutex
+	lockKeycloakInterfaceMockUnlinkUserFromIdP                           sync.RWMutex
+	lockKeycloakInterfaceMockUnregisterClientNode                        sync.RWMutex
+	lockKeycloakInterfaceMockUpdateAuthenticationExecutionForFlow        sync.RWMutex
+	lockKeycloakInterfaceMockUpdateAuthenticatorConfig                   sync.RWMutex
+	lockKeycloakInterfaceMockUpdateAuthzPolicy                           sync.RWMutex
+	lockKeycloakInterfaceMockUpdateAuthzResource                         sync.RWMutex
+	lockKeycloakInterfaceMockUpdateAuthzScope                            sync.RWMutex
+	lockKeycloakInterfaceMockUpdateClient                                sync.RWMutex
+	lockKeycloakInterfaceMockUpdateClientManagementPermissions           sync.RWMutex
+	lockKeycloakInterfaceMockUpdateClientRole                            sync.RWMutex
+	lockKeycloakInterfaceMockUpdateClientScope                           sync.RWMutex
+	lockKeycloakInterfaceMockUpdateComponent                             sync.RWMutex
+	lockKeycloakInterfaceMockUpdateCredentialLabel                       sync.RWMutex
+	lockKeycloakInterfaceMockUpdateGroupManagementPermissions            sync.RWMutex
+	lockKeycloakInterfaceMockUpdateIdentityProvider                      sync.RWMutex
+	lockKeycloakInterfaceMockUpdateIdentityProviderMapper                sync.RWMutex
+	lockKeycloakInterfaceMockUpdatePassword                              sync.RWMutex
+	lockKeycloakInterfaceMockUpdateProtocolMapperForClient               sync.RWMutex
+	lockKeycloakInterfaceMockUpdateProtocolMapperForClientScope          sync.RWMutex
+	lockKeycloakInterfaceMockUpdateRealm                                 sync.RWMutex
+	lockKeycloakInterfaceMockUpdateRealmEventsConfig                     sync.RWMutex
+	lockKeycloakInterfaceMockUpdateRequiredAction                        sync.RWMutex
+	lockKeycloakInterfaceMockUpdateUser                                  sync.RWMutex
+	lockKeycloakInterfaceMockUploadClientCertificate                     sync.RWMutex
 )
 
 // Ensure, that KeycloakInterfaceMock does implement KeycloakInterface.
@@ -81,186 +231,630 @@ var _ KeycloakInterface = &KeycloakInterfaceMock{}
 //
 //         // make and configure a mocked KeycloakInterface
 //         mockedKeycloakInterface := &KeycloakInterfaceMock{
-//             AddUserToGroupFunc: func(realmName string, userID string, groupID string) error {
+//             AddClientScopeClientScopeMappingsFunc: func(ctx context.Context, scopeID string, targetClientID string, realmName string, roles []*v1alpha1.KeycloakUserRole) error {
+// 	               panic("mock out the AddClientScopeClientScopeMappings method")
+//             },
+//             AddClientScopeRealmScopeMappingsFunc: func(ctx context.Context, scopeID string, realmName string, roles []*v1alpha1.KeycloakUserRole) error {
+// 	               panic("mock out the AddClientScopeRealmScopeMappings method")
+//             },
+//             AddCompositesToClientRoleFunc: func(ctx context.Context, clientID string, roleName string, realmName string, composites []*v1alpha1.KeycloakUserRole) error {
+// 	               panic("mock out the AddCompositesToClientRole method")
+//             },
+//             AddUserToGroupFunc: func(ctx context.Context, realmName string, userID string, groupID string) error {
 // 	               panic("mock out the AddUserToGroup method")
 //             },
-//             CreateAuthenticatorConfigFunc: func(authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string, executionID string) (string, error) {
+//             AssignDefaultClientScopeToClientFunc: func(ctx context.Context, clientID string, scopeID string, realmName string) error {
+// 	               panic("mock out the AssignDefaultClientScopeToClient method")
+//             },
+//             AssignOptionalClientScopeToClientFunc: func(ctx context.Context, clientID string, scopeID string, realmName string) error {
+// 	               panic("mock out the AssignOptionalClientScopeToClient method")
+//             },
+//             BulkCreateUsersFunc: func(ctx context.Context, users []*v1alpha1.KeycloakAPIUser, realmName string, concurrency int) []error {
+// 	               panic("mock out the BulkCreateUsers method")
+//             },
+//             ClearAdminEventsFunc: func(ctx context.Context, realmName string) error {
+// 	               panic("mock out the ClearAdminEvents method")
+//             },
+//             ClearBruteForceForUserFunc: func(ctx context.Context, userID string, realmName string) error {
+// 	               panic("mock out the ClearBruteForceForUser method")
+//             },
+//             ClearRealmEventsFunc: func(ctx context.Context, realmName string) error {
+// 	               panic("mock out the ClearRealmEvents method")
+//             },
+//             CopyClientScopeFunc: func(ctx context.Context, sourceScopeID string, newName string, realmName string) (string, error) {
+// 	               panic("mock out the CopyClientScope method")
+//             },
+//             CountClientOfflineSessionsFunc: func(ctx context.Context, clientID string, realmName string) (int, error) {
+// 	               panic("mock out the CountClientOfflineSessions method")
+//             },
+//             CountClientSessionsFunc: func(ctx context.Context, clientID string, realmName string) (int, error) {
+// 	               panic("mock out the CountClientSessions method")
+//             },
+//             CountGroupsFunc: func(ctx context.Context, realmName string, search string, topLevelOnly bool) (int, error) {
+// 	               panic("mock out the CountGroups method")
+//             },
+//             CreateAuthenticatorConfigFunc: func(ctx context.Context, authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string, executionID string) (string, error) {
 // 	               panic("mock out the CreateAuthenticatorConfig method")
 //             },
-//             CreateClientFunc: func(client *v1alpha1.KeycloakAPIClient, realmName string) (string, error) {
+//             CreateAuthzPolicyFunc: func(ctx context.Context, clientID string, realmName string, policyType string, policy json.RawMessage) (*AuthzPolicy, error) {
+// 	               panic("mock out the CreateAuthzPolicy method")
+//             },
+//             CreateAuthzResourceFunc: func(ctx context.Context, clientID string, realmName string, resource *AuthzResource) (string, error) {
+// 	               panic("mock out the CreateAuthzResource method")
+//             },
+//             CreateAuthzResourcePermissionFunc: func(ctx context.Context, clientID string, realmName string, permission *AuthzPermission) (string, error) {
+// 	               panic("mock out the CreateAuthzResourcePermission method")
+//             },
+//             CreateAuthzScopeFunc: func(ctx context.Context, clientID string, realmName string, scope *AuthzScope) (string, error) {
+// 	               panic("mock out the CreateAuthzScope method")
+//             },
+//             CreateAuthzScopePermissionFunc: func(ctx context.Context, clientID string, realmName string, permission *AuthzPermission) (string, error) {
+// 	               panic("mock out the CreateAuthzScopePermission method")
+//             },
+//             CreateChildGroupFunc: func(ctx context.Context, parentGroupID string, name string, realmName string) (string, error) {
+// 	               panic("mock out the CreateChildGroup method")
+//             },
+//             CreateClientFunc: func(ctx context.Context, client *v1alpha1.KeycloakAPIClient, realmName string) (string, error) {
 // 	               panic("mock out the CreateClient method")
 //             },
-//             CreateFederatedIdentityFunc: func(fid v1alpha1.FederatedIdentity, userID string, realmName string) (string, error) {
+//             CreateClientRoleFunc: func(ctx context.Context, clientID string, realmName string, role *v1alpha1.KeycloakUserRole) error {
+// 	               panic("mock out the CreateClientRole method")
+//             },
+//             CreateClientScopeFunc: func(ctx context.Context, scope *ClientScope, realmName string) error {
+// 	               panic("mock out the CreateClientScope method")
+//             },
+//             CreateComponentFunc: func(ctx context.Context, component *Component, realmName string) error {
+// 	               panic("mock out the CreateComponent method")
+//             },
+//             CreateFederatedIdentityFunc: func(ctx context.Context, fid v1alpha1.FederatedIdentity, userID string, realmName string) (string, error) {
 // 	               panic("mock out the CreateFederatedIdentity method")
 //             },
-//             CreateGroupFunc: func(group string, realmName string) (string, error) {
+//             CreateGroupFunc: func(ctx context.Context, group string, realmName string) (string, error) {
 // 	               panic("mock out the CreateGroup method")
 //             },
-//             CreateGroupClientRoleFunc: func(role *v1alpha1.KeycloakUserRole, realmName string, clientID string, groupID string) (string, error) {
+//             CreateGroupClientRoleFunc: func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, clientID string, groupID string) (string, error) {
 // 	               panic("mock out the CreateGroupClientRole method")
 //             },
-//             CreateGroupRealmRoleFunc: func(role *v1alpha1.KeycloakUserRole, realmName string, groupID string) (string, error) {
+//             CreateGroupRealmRoleFunc: func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, groupID string) (string, error) {
 // 	               panic("mock out the CreateGroupRealmRole method")
 //             },
-//             CreateIdentityProviderFunc: func(identityProvider *v1alpha1.KeycloakIdentityProvider, realmName string) (string, error) {
+//             CreateIdentityProviderFunc: func(ctx context.Context, identityProvider *IdentityProvider, realmName string) error {
 // 	               panic("mock out the CreateIdentityProvider method")
 //             },
-//             CreateRealmFunc: func(realm *v1alpha1.KeycloakRealm) (string, error) {
+//             CreateIdentityProviderMapperFunc: func(ctx context.Context, alias string, realmName string, mapper *IdentityProviderMapper) error {
+// 	               panic("mock out the CreateIdentityProviderMapper method")
+//             },
+//             CreateProtocolMapperForClientFunc: func(ctx context.Context, clientID string, realmName string, mapper *ProtocolMapper) error {
+// 	               panic("mock out the CreateProtocolMapperForClient method")
+//             },
+//             CreateProtocolMapperForClientScopeFunc: func(ctx context.Context, scopeID string, realmName string, mapper *ProtocolMapper) error {
+// 	               panic("mock out the CreateProtocolMapperForClientScope method")
+//             },
+//             CreateRealmFunc: func(ctx context.Context, realm *v1alpha1.KeycloakRealm) (string, error) {
 // 	               panic("mock out the CreateRealm method")
 //             },
-//             CreateUserFunc: func(user *v1alpha1.KeycloakAPIUser, realmName string) (string, error) {
+//             CreateUserFunc: func(ctx context.Context, user *v1alpha1.KeycloakAPIUser, realmName string) (string, error) {
 // 	               panic("mock out the CreateUser method")
 //             },
-//             CreateUserClientRoleFunc: func(role *v1alpha1.KeycloakUserRole, realmName string, clientID string, userID string) (string, error) {
+//             CreateUserClientRoleFunc: func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, clientID string, userID string) (string, error) {
 // 	               panic("mock out the CreateUserClientRole method")
 //             },
-//             CreateUserRealmRoleFunc: func(role *v1alpha1.KeycloakUserRole, realmName string, userID string) (string, error) {
+//             CreateUserRealmRoleFunc: func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, userID string) (string, error) {
 // 	               panic("mock out the CreateUserRealmRole method")
 //             },
-//             DeleteAuthenticatorConfigFunc: func(configID string, realmName string) error {
+//             DeleteAuthenticatorConfigFunc: func(ctx context.Context, configID string, realmName string) error {
 // 	               panic("mock out the DeleteAuthenticatorConfig method")
 //             },
-//             DeleteClientFunc: func(clientID string, realmName string) error {
+//             DeleteAuthzPermissionFunc: func(ctx context.Context, clientID string, permissionID string, realmName string) error {
+// 	               panic("mock out the DeleteAuthzPermission method")
+//             },
+//             DeleteAuthzPolicyFunc: func(ctx context.Context, clientID string, policyID string, realmName string) error {
+// 	               panic("mock out the DeleteAuthzPolicy method")
+//             },
+//             DeleteAuthzResourceFunc: func(ctx context.Context, clientID string, resourceID string, realmName string) error {
+// 	               panic("mock out the DeleteAuthzResource method")
+//             },
+//             DeleteAuthzScopeFunc: func(ctx context.Context, clientID string, scopeID string, realmName string) error {
+// 	               panic("mock out the DeleteAuthzScope method")
+//             },
+//             DeleteClientFunc: func(ctx context.Context, clientID string, realmName string) error {
 // 	               panic("mock out the DeleteClient method")
 //             },
-//             DeleteIdentityProviderFunc: func(alias string, realmName string) error {
+//             DeleteClientRoleFunc: func(ctx context.Context, clientID string, roleName string, realmName string) error {
+// 	               panic("mock out the DeleteClientRole method")
+//             },
+//             DeleteClientScopeFunc: func(ctx context.Context, scopeID string, realmName string) error {
+// 	               panic("mock out the DeleteClientScope method")
+//             },
+//             DeleteClientScopeClientScopeMappingsFunc: func(ctx context.Context, scopeID string, targetClientID string, realmName string, roles []*v1alpha1.KeycloakUserRole) error {
+// 	               panic("mock out the DeleteClientScopeClientScopeMappings method")
+//             },
+//             DeleteClientScopeRealmScopeMappingsFunc: func(ctx context.Context, scopeID string, realmName string, roles []*v1alpha1.KeycloakUserRole) error {
+// 	               panic("mock out the DeleteClientScopeRealmScopeMappings method")
+//             },
+//             DeleteComponentFunc: func(ctx context.Context, componentID string, realmName string) error {
+// 	               panic("mock out the DeleteComponent method")
+//             },
+//             DeleteGroupFunc: func(ctx context.Context, groupID string, realmName string) error {
+// 	               panic("mock out the DeleteGroup method")
+//             },
+//             DeleteGroupClientRoleFunc: func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, clientID string, groupID string) error {
+// 	               panic("mock out the DeleteGroupClientRole method")
+//             },
+//             DeleteIdentityProviderFunc: func(ctx context.Context, alias string, realmName string) error {
 // 	               panic("mock out the DeleteIdentityProvider method")
 //             },
-//             DeleteRealmFunc: func(realmName string) error {
+//             DeleteIdentityProviderMapperFunc: func(ctx context.Context, alias string, mapperID string, realmName string) error {
+// 	               panic("mock out the DeleteIdentityProviderMapper method")
+//             },
+//             DeleteProtocolMapperForClientFunc: func(ctx context.Context, clientID string, mapperID string, realmName string) error {
+// 	               panic("mock out the DeleteProtocolMapperForClient method")
+//             },
+//             DeleteProtocolMapperForClientScopeFunc: func(ctx context.Context, scopeID string, mapperID string, realmName string) error {
+// 	               panic("mock out the DeleteProtocolMapperForClientScope method")
+//             },
+//             DeleteRealmFunc: func(ctx context.Context, realmName string) error {
 // 	               panic("mock out the DeleteRealm method")
 //             },
-//             DeleteUserFunc: func(userID string, realmName string) error {
+//             DeleteRequiredActionFunc: func(ctx context.Context, alias string, realmName string) error {
+// 	               panic("mock out the DeleteRequiredAction method")
+//             },
+//             DeleteUserFunc: func(ctx context.Context, userID string, realmName string) error {
 // 	               panic("mock out the DeleteUser method")
 //             },
-//             DeleteUserClientRoleFunc: func(role *v1alpha1.KeycloakUserRole, realmName string, clientID string, userID string) error {
+//             DeleteUserClientRoleFunc: func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, clientID string, userID string) error {
 // 	               panic("mock out the DeleteUserClientRole method")
 //             },
-//             DeleteUserFromGroupFunc: func(realmName string, userID string, groupID string) error {
+//             DeleteUserCredentialFunc: func(ctx context.Context, userID string, credentialID string, realmName string) error {
+// 	               panic("mock out the DeleteUserCredential method")
+//             },
+//             DeleteUserFromGroupFunc: func(ctx context.Context, realmName string, userID string, groupID string) error {
 // 	               panic("mock out the DeleteUserFromGroup method")
 //             },
-//             DeleteUserRealmRoleFunc: func(role *v1alpha1.KeycloakUserRole, realmName string, userID string) error {
+//             DeleteUserRealmRoleFunc: func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, userID string) error {
 // 	               panic("mock out the DeleteUserRealmRole method")
 //             },
-//             FindAuthenticationExecutionForFlowFunc: func(flowAlias string, realmName string, predicate func(*v1alpha1.AuthenticationExecutionInfo) bool) (*v1alpha1.AuthenticationExecutionInfo, error) {
+//             DeleteUserSessionFunc: func(ctx context.Context, sessionID string, realmName string) error {
+// 	               panic("mock out the DeleteUserSession method")
+//             },
+//             DownloadClientKeystoreFunc: func(ctx context.Context, clientID string, realmName string, attr string, config *KeyStoreConfig) ([]byte, error) {
+// 	               panic("mock out the DownloadClientKeystore method")
+//             },
+//             EvaluateAuthzPermissionsFunc: func(ctx context.Context, clientID string, realmName string, evaluation *PolicyEvaluationRequest) (*PolicyEvaluationResponse, error) {
+// 	               panic("mock out the EvaluateAuthzPermissions method")
+//             },
+//             ExchangeTokenFunc: func(ctx context.Context, realmName string, subjectToken string, audience string, clientID string, clientSecret string) (*TokenResponse, error) {
+// 	               panic("mock out the ExchangeToken method")
+//             },
+//             FindAuthenticationExecutionForFlowFunc: func(ctx context.Context, flowAlias string, realmName string, predicate func(*v1alpha1.AuthenticationExecutionInfo) bool) (*v1alpha1.AuthenticationExecutionInfo, error) {
 // 	               panic("mock out the FindAuthenticationExecutionForFlow method")
 //             },
-//             FindAvailableGroupClientRoleFunc: func(realmName string, clientID string, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error) {
+//             FindAvailableGroupClientRoleFunc: func(ctx context.Context, realmName string, clientID string, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error) {
 // 	               panic("mock out the FindAvailableGroupClientRole method")
 //             },
-//             FindGroupByNameFunc: func(groupName string, realmName string) (*Group, error) {
+//             FindClientByClientIDFunc: func(ctx context.Context, clientID string, realmName string) (*v1alpha1.KeycloakAPIClient, error) {
+// 	               panic("mock out the FindClientByClientID method")
+//             },
+//             FindClientScopeByNameFunc: func(ctx context.Context, name string, realmName string) (*ClientScope, error) {
+// 	               panic("mock out the FindClientScopeByName method")
+//             },
+//             FindGroupByNameFunc: func(ctx context.Context, groupName string, realmName string) (*Group, error) {
 // 	               panic("mock out the FindGroupByName method")
 //             },
-//             FindGroupClientRoleFunc: func(realmName string, clientID string, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error) {
+//             FindGroupByNameInHierarchyFunc: func(ctx context.Context, groupName string, realmName string) (*Group, error) {
+// 	               panic("mock out the FindGroupByNameInHierarchy method")
+//             },
+//             FindGroupClientRoleFunc: func(ctx context.Context, realmName string, clientID string, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error) {
 // 	               panic("mock out the FindGroupClientRole method")
 //             },
-//             FindUserByEmailFunc: func(email string, realm string) (*v1alpha1.KeycloakAPIUser, error) {
+//             FindUserByEmailFunc: func(ctx context.Context, email string, realm string) (*v1alpha1.KeycloakAPIUser, error) {
 // 	               panic("mock out the FindUserByEmail method")
 //             },
-//             FindUserByUsernameFunc: func(name string, realm string) (*v1alpha1.KeycloakAPIUser, error) {
+//             FindUserByUsernameFunc: func(ctx context.Context, name string, realm string) (*v1alpha1.KeycloakAPIUser, error) {
 // 	               panic("mock out the FindUserByUsername method")
 //             },
-//             GetAuthenticatorConfigFunc: func(configID string, realmName string) (*v1alpha1.AuthenticatorConfig, error) {
+//             GenerateClientCertificateFunc: func(ctx context.Context, clientID string, realmName string, attr string) (*Certificate, error) {
+// 	               panic("mock out the GenerateClientCertificate method")
+//             },
+//             GenerateExampleAccessTokenFunc: func(ctx context.Context, clientID string, realmName string, userID string, scope string) (string, error) {
+// 	               panic("mock out the GenerateExampleAccessToken method")
+//             },
+//             GetAllGroupMembersFunc: func(ctx context.Context, groupID string, realmName string, briefRepresentation bool) ([]*v1alpha1.KeycloakAPIUser, error) {
+// 	               panic("mock out the GetAllGroupMembers method")
+//             },
+//             GetAuthenticatorConfigFunc: func(ctx context.Context, configID string, realmName string) (*v1alpha1.AuthenticatorConfig, error) {
 // 	               panic("mock out the GetAuthenticatorConfig method")
 //             },
-//             GetClientFunc: func(clientID string, realmName string) (*v1alpha1.KeycloakAPIClient, error) {
+//             GetAuthzPolicyFunc: func(ctx context.Context, clientID string, policyID string, realmName string) (*AuthzPolicy, error) {
+// 	               panic("mock out the GetAuthzPolicy method")
+//             },
+//             GetAuthzResourceFunc: func(ctx context.Context, clientID string, resourceID string, realmName string) (*AuthzResource, error) {
+// 	               panic("mock out the GetAuthzResource method")
+//             },
+//             GetBruteForceStatusFunc: func(ctx context.Context, userID string, realmName string) (*BruteForceStatus, error) {
+// 	               panic("mock out the GetBruteForceStatus method")
+//             },
+//             GetClientFunc: func(ctx context.Context, clientID string, realmName string) (*v1alpha1.KeycloakAPIClient, error) {
 // 	               panic("mock out the GetClient method")
 //             },
-//             GetClientInstallFunc: func(clientID string, realmName string) ([]byte, error) {
+//             GetClientCertificateFunc: func(ctx context.Context, clientID string, realmName string, attr string) (*Certificate, error) {
+// 	               panic("mock out the GetClientCertificate method")
+//             },
+//             GetClientInstallFunc: func(ctx context.Context, clientID string, realmName string) ([]byte, error) {
 // 	               panic("mock out the GetClientInstall method")
 //             },
-//             GetClientSecretFunc: func(clientID string, realmName string) (string, error) {
+//             GetClientInstallationProviderFunc: func(ctx context.Context, clientID string, realmName string, providerID string) (*ClientInstallation, error) {
+// 	               panic("mock out the GetClientInstallationProvider method")
+//             },
+//             GetClientManagementPermissionsFunc: func(ctx context.Context, clientID string, realmName string) (*ManagementPermissionReference, error) {
+// 	               panic("mock out the GetClientManagementPermissions method")
+//             },
+//             GetClientRoleByNameFunc: func(ctx context.Context, clientID string, roleName string, realmName string) (*v1alpha1.KeycloakUserRole, error) {
+// 	               panic("mock out the GetClientRoleByName method")
+//             },
+//             GetClientScopeFunc: func(ctx context.Context, scopeID string, realmName string) (*ClientScope, error) {
+// 	               panic("mock out the GetClientScope method")
+//             },
+//             GetClientSecretFunc: func(ctx context.Context, clientID string, realmName string) (string, error) {
 // 	               panic("mock out the GetClientSecret method")
 //             },
-//             GetIdentityProviderFunc: func(alias string, realmName string) (*v1alpha1.KeycloakIdentityProvider, error) {
+//             GetClientServiceAccountUserFunc: func(ctx context.Context, clientID string, realmName string) (*v1alpha1.KeycloakAPIUser, error) {
+// 	               panic("mock out the GetClientServiceAccountUser method")
+//             },
+//             GetGroupByPathFunc: func(ctx context.Context, path string, realmName string) (*Group, error) {
+// 	               panic("mock out the GetGroupByPath method")
+//             },
+//             GetGroupHierarchyFunc: func(ctx context.Context, realmName string) ([]*Group, error) {
+// 	               panic("mock out the GetGroupHierarchy method")
+//             },
+//             GetGroupManagementPermissionsFunc: func(ctx context.Context, groupID string, realmName string) (*ManagementPermissionReference, error) {
+// 	               panic("mock out the GetGroupManagementPermissions method")
+//             },
+//             GetGroupMembersFunc: func(ctx context.Context, groupID string, realmName string, first int, max int) ([]*v1alpha1.KeycloakAPIUser, error) {
+// 	               panic("mock out the GetGroupMembers method")
+//             },
+//             GetIdentityProviderFunc: func(ctx context.Context, alias string, realmName string) (*IdentityProvider, error) {
 // 	               panic("mock out the GetIdentityProvider method")
 //             },
-//             GetRealmFunc: func(realmName string) (*v1alpha1.KeycloakRealm, error) {
+//             GetJWKSFunc: func(ctx context.Context, realmName string) (*JWKS, error) {
+// 	               panic("mock out the GetJWKS method")
+//             },
+//             GetRealmFunc: func(ctx context.Context, realmName string) (*v1alpha1.KeycloakRealm, error) {
 // 	               panic("mock out the GetRealm method")
 //             },
-//             GetUserFunc: func(userID string, realmName string) (*v1alpha1.KeycloakAPIUser, error) {
+//             GetRealmAttributeFunc: func(ctx context.Context, realmName string, key string) (string, bool, error) {
+// 	               panic("mock out the GetRealmAttribute method")
+//             },
+//             GetRealmEventsConfigFunc: func(ctx context.Context, realmName string) (*RealmEventsConfig, error) {
+// 	               panic("mock out the GetRealmEventsConfig method")
+//             },
+//             GetRotatedClientSecretFunc: func(ctx context.Context, clientID string, realmName string) (string, error) {
+// 	               panic("mock out the GetRotatedClientSecret method")
+//             },
+//             GetServerInfoFunc: func(ctx context.Context) (*ServerInfo, error) {
+// 	               panic("mock out the GetServerInfo method")
+//             },
+//             GetUserFunc: func(ctx context.Context, userID string, realmName string) (*v1alpha1.KeycloakAPIUser, error) {
 // 	               panic("mock out the GetUser method")
 //             },
-//             GetUserFederatedIdentitiesFunc: func(userName string, realmName string) ([]v1alpha1.FederatedIdentity, error) {
+//             GetUserByFederatedIdentityFunc: func(ctx context.Context, realmName string, providerAlias string, externalUserID string) (*v1alpha1.KeycloakAPIUser, error) {
+// 	               panic("mock out the GetUserByFederatedIdentity method")
+//             },
+//             GetUserFederatedIdentitiesFunc: func(ctx context.Context, userName string, realmName string) ([]v1alpha1.FederatedIdentity, error) {
 // 	               panic("mock out the GetUserFederatedIdentities method")
 //             },
-//             ListAuthenticationExecutionsForFlowFunc: func(flowAlias string, realmName string) ([]*v1alpha1.AuthenticationExecutionInfo, error) {
+//             GetWellKnownConfigurationFunc: func(ctx context.Context, realmName string) (*OIDCConfiguration, error) {
+// 	               panic("mock out the GetWellKnownConfiguration method")
+//             },
+//             IntrospectTokenFunc: func(ctx context.Context, realmName string, clientID string, clientSecret string, token string) (*TokenIntrospection, error) {
+// 	               panic("mock out the IntrospectToken method")
+//             },
+//             InvalidateRotatedClientSecretFunc: func(ctx context.Context, clientID string, realmName string) error {
+// 	               panic("mock out the InvalidateRotatedClientSecret method")
+//             },
+//             ListAdminEventsFunc: func(ctx context.Context, realmName string, params *AdminEventListParams) ([]*AdminEvent, error) {
+// 	               panic("mock out the ListAdminEvents method")
+//             },
+//             ListAuthenticationExecutionsForFlowFunc: func(ctx context.Context, flowAlias string, realmName string) ([]*v1alpha1.AuthenticationExecutionInfo, error) {
 // 	               panic("mock out the ListAuthenticationExecutionsForFlow method")
 //             },
-//             ListAvailableGroupClientRolesFunc: func(realmName string, clientID string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
+//             ListAuthzPermissionPoliciesFunc: func(ctx context.Context, clientID string, permissionID string, realmName string) ([]*AuthzPolicy, error) {
+// 	               panic("mock out the ListAuthzPermissionPolicies method")
+//             },
+//             ListAuthzPermissionResourcesFunc: func(ctx context.Context, clientID string, permissionID string, realmName string) ([]*AuthzResource, error) {
+// 	               panic("mock out the ListAuthzPermissionResources method")
+//             },
+//             ListAuthzPermissionScopesFunc: func(ctx context.Context, clientID string, permissionID string, realmName string) ([]*AuthzScope, error) {
+// 	               panic("mock out the ListAuthzPermissionScopes method")
+//             },
+//             ListAuthzPermissionsFunc: func(ctx context.Context, clientID string, realmName string) ([]*AuthzPermission, error) {
+// 	               panic("mock out the ListAuthzPermissions method")
+//             },
+//             ListAuthzPoliciesFunc: func(ctx context.Context, clientID string, realmName string) ([]*AuthzPolicy, error) {
+// 	               panic("mock out the ListAuthzPolicies method")
+//             },
+//             ListAuthzResourcesFunc: func(ctx context.Context, clientID string, realmName string, params *AuthzResourceListParams) ([]*AuthzResource, error) {
+// 	               panic("mock out the ListAuthzResources method")
+//             },
+//             ListAuthzScopesFunc: func(ctx context.Context, clientID string, realmName string, params *AuthzScopeListParams) ([]*AuthzScope, error) {
+// 	               panic("mock out the ListAuthzScopes method")
+//             },
+//             ListAvailableClientScopeClientScopeMappingsFunc: func(ctx context.Context, scopeID string, targetClientID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+// 	               panic("mock out the ListAvailableClientScopeClientScopeMappings method")
+//             },
+//             ListAvailableClientScopeRealmScopeMappingsFunc: func(ctx context.Context, scopeID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+// 	               panic("mock out the ListAvailableClientScopeRealmScopeMappings method")
+//             },
+//             ListAvailableGroupClientRolesFunc: func(ctx context.Context, realmName string, clientID string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
 // 	               panic("mock out the ListAvailableGroupClientRoles method")
 //             },
-//             ListAvailableGroupRealmRolesFunc: func(realmName string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
+//             ListAvailableGroupRealmRolesFunc: func(ctx context.Context, realmName string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
 // 	               panic("mock out the ListAvailableGroupRealmRoles method")
 //             },
-//             ListAvailableUserClientRolesFunc: func(realmName string, clientID string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
+//             ListAvailableUserClientRolesFunc: func(ctx context.Context, realmName string, clientID string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
 // 	               panic("mock out the ListAvailableUserClientRoles method")
 //             },
-//             ListAvailableUserRealmRolesFunc: func(realmName string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
+//             ListAvailableUserRealmRolesFunc: func(ctx context.Context, realmName string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
 // 	               panic("mock out the ListAvailableUserRealmRoles method")
 //             },
-//             ListClientsFunc: func(realmName string) ([]*v1alpha1.KeycloakAPIClient, error) {
+//             ListClientOfflineSessionsFunc: func(ctx context.Context, clientID string, realmName string, first int, max int) ([]*UserSession, error) {
+// 	               panic("mock out the ListClientOfflineSessions method")
+//             },
+//             ListClientRoleClientCompositesFunc: func(ctx context.Context, clientID string, roleName string, otherClientID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+// 	               panic("mock out the ListClientRoleClientComposites method")
+//             },
+//             ListClientRoleCompositesFunc: func(ctx context.Context, clientID string, roleName string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+// 	               panic("mock out the ListClientRoleComposites method")
+//             },
+//             ListClientRoleRealmCompositesFunc: func(ctx context.Context, clientID string, roleName string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+// 	               panic("mock out the ListClientRoleRealmComposites method")
+//             },
+//             ListClientRolesFunc: func(ctx context.Context, clientID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+// 	               panic("mock out the ListClientRoles method")
+//             },
+//             ListClientScopeClientScopeMappingsFunc: func(ctx context.Context, scopeID string, targetClientID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+// 	               panic("mock out the ListClientScopeClientScopeMappings method")
+//             },
+//             ListClientScopeRealmScopeMappingsFunc: func(ctx context.Context, scopeID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+// 	               panic("mock out the ListClientScopeRealmScopeMappings method")
+//             },
+//             ListClientScopesFunc: func(ctx context.Context, realmName string) ([]*ClientScope, error) {
+// 	               panic("mock out the ListClientScopes method")
+//             },
+//             ListClientSessionsFunc: func(ctx context.Context, clientID string, realmName string) ([]*UserSession, error) {
+// 	               panic("mock out the ListClientSessions method")
+//             },
+//             ListClientUserSessionsFunc: func(ctx context.Context, clientID string, realmName string, first int, max int) ([]*UserSession, error) {
+// 	               panic("mock out the ListClientUserSessions method")
+//             },
+//             ListClientsFunc: func(ctx context.Context, realmName string) ([]*v1alpha1.KeycloakAPIClient, error) {
 // 	               panic("mock out the ListClients method")
 //             },
-//             ListDefaultGroupsFunc: func(realmName string) ([]*Group, error) {
+//             ListClientsWithParamsFunc: func(ctx context.Context, realmName string, params *ClientListParams) ([]*v1alpha1.KeycloakAPIClient, error) {
+// 	               panic("mock out the ListClientsWithParams method")
+//             },
+//             ListComponentsFunc: func(ctx context.Context, realmName string, params *ComponentListParams) ([]*Component, error) {
+// 	               panic("mock out the ListComponents method")
+//             },
+//             ListDefaultClientScopesForClientFunc: func(ctx context.Context, clientID string, realmName string) ([]*ClientScope, error) {
+// 	               panic("mock out the ListDefaultClientScopesForClient method")
+//             },
+//             ListDefaultGroupsFunc: func(ctx context.Context, realmName string) ([]*Group, error) {
 // 	               panic("mock out the ListDefaultGroups method")
 //             },
-//             ListGroupClientRolesFunc: func(realmName string, clientID string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
+//             ListEvaluatedProtocolMappersFunc: func(ctx context.Context, clientID string, realmName string, scope string) ([]*ProtocolMapperEvaluation, error) {
+// 	               panic("mock out the ListEvaluatedProtocolMappers method")
+//             },
+//             ListGroupClientRolesFunc: func(ctx context.Context, realmName string, clientID string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
 // 	               panic("mock out the ListGroupClientRoles method")
 //             },
-//             ListGroupRealmRolesFunc: func(realmName string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
+//             ListGroupRealmRolesFunc: func(ctx context.Context, realmName string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
 // 	               panic("mock out the ListGroupRealmRoles method")
 //             },
-//             ListIdentityProvidersFunc: func(realmName string) ([]*v1alpha1.KeycloakIdentityProvider, error) {
+//             ListGroupsFunc: func(ctx context.Context, realmName string, search string, first int, max int) ([]*Group, error) {
+// 	               panic("mock out the ListGroups method")
+//             },
+//             ListIdentityProviderMappersFunc: func(ctx context.Context, alias string, realmName string) ([]*IdentityProviderMapper, error) {
+// 	               panic("mock out the ListIdentityProviderMappers method")
+//             },
+//             ListIdentityProvidersFunc: func(ctx context.Context, realmName string) ([]*IdentityProvider, error) {
 // 	               panic("mock out the ListIdentityProviders method")
 //             },
-//             ListRealmsFunc: func() ([]*v1alpha1.KeycloakAPIRealm, error) {
+//             ListOfflineSessionsForUserFunc: func(ctx context.Context, userID string, realmName string) ([]*UserSession, error) {
+// 	               panic("mock out the ListOfflineSessionsForUser method")
+//             },
+//             ListOptionalClientScopesForClientFunc: func(ctx context.Context, clientID string, realmName string) ([]*ClientScope, error) {
+// 	               panic("mock out the ListOptionalClientScopesForClient method")
+//             },
+//             ListProtocolMappersForClientFunc: func(ctx context.Context, clientID string, realmName string) ([]*ProtocolMapper, error) {
+// 	               panic("mock out the ListProtocolMappersForClient method")
+//             },
+//             ListProtocolMappersForClientScopeFunc: func(ctx context.Context, scopeID string, realmName string) ([]*ProtocolMapper, error) {
+// 	               panic("mock out the ListProtocolMappersForClientScope method")
+//             },
+//             ListRealmEventsFunc: func(ctx context.Context, realmName string, params *EventListParams) ([]*RealmEvent, error) {
+// 	               panic("mock out the ListRealmEvents method")
+//             },
+//             ListRealmKeysFunc: func(ctx context.Context, realmName string) (*RealmKeyMetadata, error) {
+// 	               panic("mock out the ListRealmKeys method")
+//             },
+//             ListRealmsFunc: func(ctx context.Context) ([]*v1alpha1.KeycloakAPIRealm, error) {
 // 	               panic("mock out the ListRealms method")
 //             },
-//             ListUserClientRolesFunc: func(realmName string, clientID string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
+//             ListRequiredActionsFunc: func(ctx context.Context, realmName string) ([]*RequiredAction, error) {
+// 	               panic("mock out the ListRequiredActions method")
+//             },
+//             ListUserClientRolesFunc: func(ctx context.Context, realmName string, clientID string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
 // 	               panic("mock out the ListUserClientRoles method")
 //             },
-//             ListUserRealmRolesFunc: func(realmName string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
+//             ListUserConsentsFunc: func(ctx context.Context, userID string, realmName string) ([]*UserConsent, error) {
+// 	               panic("mock out the ListUserConsents method")
+//             },
+//             ListUserCredentialsFunc: func(ctx context.Context, userID string, realmName string) ([]*Credential, error) {
+// 	               panic("mock out the ListUserCredentials method")
+//             },
+//             ListUserRealmRolesFunc: func(ctx context.Context, realmName string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
 // 	               panic("mock out the ListUserRealmRoles method")
 //             },
-//             ListUsersFunc: func(realmName string) ([]*v1alpha1.KeycloakAPIUser, error) {
+//             ListUserSessionsFunc: func(ctx context.Context, userID string, realmName string) ([]*UserSession, error) {
+// 	               panic("mock out the ListUserSessions method")
+//             },
+//             ListUsersFunc: func(ctx context.Context, realmName string) ([]*v1alpha1.KeycloakAPIUser, error) {
 // 	               panic("mock out the ListUsers method")
 //             },
-//             ListUsersInGroupFunc: func(realmName string, groupID string) ([]*v1alpha1.KeycloakAPIUser, error) {
+//             ListUsersInClientRoleFunc: func(ctx context.Context, clientID string, roleName string, realmName string, first int, max int) ([]*v1alpha1.KeycloakAPIUser, error) {
+// 	               panic("mock out the ListUsersInClientRole method")
+//             },
+//             ListUsersInGroupFunc: func(ctx context.Context, realmName string, groupID string) ([]*v1alpha1.KeycloakAPIUser, error) {
 // 	               panic("mock out the ListUsersInGroup method")
 //             },
-//             MakeGroupDefaultFunc: func(groupID string, realmName string) error {
+//             MakeGroupDefaultFunc: func(ctx context.Context, groupID string, realmName string) error {
 // 	               panic("mock out the MakeGroupDefault method")
 //             },
-//             PingFunc: func() error {
+//             MoveCredentialAfterFunc: func(ctx context.Context, userID string, credentialID string, newPreviousCredentialID string, realmName string) error {
+// 	               panic("mock out the MoveCredentialAfter method")
+//             },
+//             MoveCredentialToFirstFunc: func(ctx context.Context, userID string, credentialID string, realmName string) error {
+// 	               panic("mock out the MoveCredentialToFirst method")
+//             },
+//             PatchRealmFunc: func(ctx context.Context, realmName string, patch map[string]interface{}) error {
+// 	               panic("mock out the PatchRealm method")
+//             },
+//             PingFunc: func(ctx context.Context) error {
 // 	               panic("mock out the Ping method")
 //             },
-//             RemoveFederatedIdentityFunc: func(fid v1alpha1.FederatedIdentity, userID string, realmName string) error {
+//             PushClientRevocationFunc: func(ctx context.Context, clientID string, realmName string) (*GlobalRequestResult, error) {
+// 	               panic("mock out the PushClientRevocation method")
+//             },
+//             RefreshTokenFunc: func(ctx context.Context, realmName string, clientID string, clientSecret string, refreshToken string) (*TokenResponse, error) {
+// 	               panic("mock out the RefreshToken method")
+//             },
+//             RegenerateClientRegistrationTokenFunc: func(ctx context.Context, clientID string, realmName string) (string, error) {
+// 	               panic("mock out the RegenerateClientRegistrationToken method")
+//             },
+//             RegenerateClientSecretFunc: func(ctx context.Context, clientID string, realmName string) (string, error) {
+// 	               panic("mock out the RegenerateClientSecret method")
+//             },
+//             RegisterClientNodeFunc: func(ctx context.Context, clientID string, realmName string, nodeHost string) error {
+// 	               panic("mock out the RegisterClientNode method")
+//             },
+//             RegisterRequiredActionFunc: func(ctx context.Context, realmName string, providerID string) error {
+// 	               panic("mock out the RegisterRequiredAction method")
+//             },
+//             RemoveCompositesFromClientRoleFunc: func(ctx context.Context, clientID string, roleName string, realmName string, composites []*v1alpha1.KeycloakUserRole) error {
+// 	               panic("mock out the RemoveCompositesFromClientRole method")
+//             },
+//             RemoveDefaultClientScopeFromClientFunc: func(ctx context.Context, clientID string, scopeID string, realmName string) error {
+// 	               panic("mock out the RemoveDefaultClientScopeFromClient method")
+//             },
+//             RemoveFederatedIdentityFunc: func(ctx context.Context, fid v1alpha1.FederatedIdentity, userID string, realmName string) error {
 // 	               panic("mock out the RemoveFederatedIdentity method")
 //             },
-//             SetGroupChildFunc: func(groupID string, realmName string, childGroup *Group) error {
+//             RemoveOptionalClientScopeFromClientFunc: func(ctx context.Context, clientID string, scopeID string, realmName string) error {
+// 	               panic("mock out the RemoveOptionalClientScopeFromClient method")
+//             },
+//             ResolveGroupPathFunc: func(ctx context.Context, path string, realmName string, createMissing bool) (string, error) {
+// 	               panic("mock out the ResolveGroupPath method")
+//             },
+//             RevokeOfflineSessionFunc: func(ctx context.Context, userID string, clientID string, realmName string) error {
+// 	               panic("mock out the RevokeOfflineSession method")
+//             },
+//             RevokeTokenFunc: func(ctx context.Context, realmName string, clientID string, clientSecret string, token string, tokenTypeHint string) error {
+// 	               panic("mock out the RevokeToken method")
+//             },
+//             RevokeUserConsentFunc: func(ctx context.Context, userID string, clientID string, realmName string) error {
+// 	               panic("mock out the RevokeUserConsent method")
+//             },
+//             SetGroupChildFunc: func(ctx context.Context, groupID string, realmName string, childGroup *Group) error {
 // 	               panic("mock out the SetGroupChild method")
 //             },
-//             UpdateAuthenticationExecutionForFlowFunc: func(flowAlias string, realmName string, execution *v1alpha1.AuthenticationExecutionInfo) error {
+//             SetRealmAttributeFunc: func(ctx context.Context, realmName string, key string, value string) error {
+// 	               panic("mock out the SetRealmAttribute method")
+//             },
+//             SetTemporaryPasswordFunc: func(ctx context.Context, userID string, realmName string, password string) error {
+// 	               panic("mock out the SetTemporaryPassword method")
+//             },
+//             TestClientNodesAvailableFunc: func(ctx context.Context, clientID string, realmName string) (*GlobalRequestResult, error) {
+// 	               panic("mock out the TestClientNodesAvailable method")
+//             },
+//             TriggerLDAPSyncFunc: func(ctx context.Context, componentID string, realmName string, syncType LDAPSyncType) (*LDAPSyncResult, error) {
+// 	               panic("mock out the TriggerLDAPSync method")
+//             },
+//             UnlinkAllUsersFromIdPFunc: func(ctx context.Context, realmName string, providerAlias string, concurrency int) (int, error) {
+// 	               panic("mock out the UnlinkAllUsersFromIdP method")
+//             },
+//             UnlinkUserFromIdPFunc: func(ctx context.Context, userID string, realmName string, providerAlias string) error {
+// 	               panic("mock out the UnlinkUserFromIdP method")
+//             },
+//             UnregisterClientNodeFunc: func(ctx context.Context, clientID string, realmName string, nodeHost string) error {
+// 	               panic("mock out the UnregisterClientNode method")
+//             },
+//             UpdateAuthenticationExecutionForFlowFunc: func(ctx context.Context, flowAlias string, realmName string, execution *v1alpha1.AuthenticationExecutionInfo) error {
 // 	               panic("mock out the UpdateAuthenticationExecutionForFlow method")
 //             },
-//             UpdateAuthenticatorConfigFunc: func(authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string) error {
+//             UpdateAuthenticatorConfigFunc: func(ctx context.Context, authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string) error {
 // 	               panic("mock out the UpdateAuthenticatorConfig method")
 //             },
-//             UpdateClientFunc: func(specClient *v1alpha1.KeycloakAPIClient, realmName string) error {
+//             UpdateAuthzPolicyFunc: func(ctx context.Context, clientID string, realmName string, policyType string, policyID string, policy json.RawMessage) error {
+// 	               panic("mock out the UpdateAuthzPolicy method")
+//             },
+//             UpdateAuthzResourceFunc: func(ctx context.Context, clientID string, realmName string, resource *AuthzResource) error {
+// 	               panic("mock out the UpdateAuthzResource method")
+//             },
+//             UpdateAuthzScopeFunc: func(ctx context.Context, clientID string, realmName string, scope *AuthzScope) error {
+// 	               panic("mock out the UpdateAuthzScope method")
+//             },
+//             UpdateClientFunc: func(ctx context.Context, specClient *v1alpha1.KeycloakAPIClient, realmName string) error {
 // 	               panic("mock out the UpdateClient method")
 //             },
-//             UpdateIdentityProviderFunc: func(specIdentityProvider *v1alpha1.KeycloakIdentityProvider, realmName string) error {
+//             UpdateClientManagementPermissionsFunc: func(ctx context.Context, clientID string, realmName string, enabled bool) (*ManagementPermissionReference, error) {
+// 	               panic("mock out the UpdateClientManagementPermissions method")
+//             },
+//             UpdateClientRoleFunc: func(ctx context.Context, clientID string, realmName string, role *v1alpha1.KeycloakUserRole) error {
+// 	               panic("mock out the UpdateClientRole method")
+//             },
+//             UpdateClientScopeFunc: func(ctx context.Context, scope *ClientScope, realmName string) error {
+// 	               panic("mock out the UpdateClientScope method")
+//             },
+//             UpdateComponentFunc: func(ctx context.Context, component *Component, realmName string) error {
+// 	               panic("mock out the UpdateComponent method")
+//             },
+//             UpdateCredentialLabelFunc: func(ctx context.Context, userID string, credentialID string, label string, realmName string) error {
+// 	               panic("mock out the UpdateCredentialLabel method")
+//             },
+//             UpdateGroupManagementPermissionsFunc: func(ctx context.Context, groupID string, realmName string, enabled bool) (*ManagementPermissionReference, error) {
+// 	               panic("mock out the UpdateGroupManagementPermissions method")
+//             },
+//             UpdateIdentityProviderFunc: func(ctx context.Context, specIdentityProvider *IdentityProvider, realmName string) error {
 // 	               panic("mock out the UpdateIdentityProvider method")
 //             },
-//             UpdatePasswordFunc: func(user *v1alpha1.KeycloakAPIUser, realmName string, newPass string) error {
+//             UpdateIdentityProviderMapperFunc: func(ctx context.Context, alias string, realmName string, mapper *IdentityProviderMapper) error {
+// 	               panic("mock out the UpdateIdentityProviderMapper method")
+//             },
+//             UpdatePasswordFunc: func(ctx context.Context, user *v1alpha1.KeycloakAPIUser, realmName string, newPass string) error {
 // 	               panic("mock out the UpdatePassword method")
 //             },
-//             UpdateRealmFunc: func(specRealm *v1alpha1.KeycloakRealm) error {
+//             UpdateProtocolMapperForClientFunc: func(ctx context.Context, clientID string, realmName string, mapper *ProtocolMapper) error {
+// 	               panic("mock out the UpdateProtocolMapperForClient method")
+//             },
+//             UpdateProtocolMapperForClientScopeFunc: func(ctx context.Context, scopeID string, realmName string, mapper *ProtocolMapper) error {
+// 	               panic("mock out the UpdateProtocolMapperForClientScope method")
+//             },
+//             UpdateRealmFunc: func(ctx context.Context, specRealm *v1alpha1.KeycloakRealm) error {
 // 	               panic("mock out the UpdateRealm method")
 //             },
-//             UpdateUserFunc: func(specUser *v1alpha1.KeycloakAPIUser, realmName string) error {
+//             UpdateRealmEventsConfigFunc: func(ctx context.Context, realmName string, config *RealmEventsConfig) error {
+// 	               panic("mock out the UpdateRealmEventsConfig method")
+//             },
+//             UpdateRequiredActionFunc: func(ctx context.Context, alias string, realmName string, action *RequiredAction) error {
+// 	               panic("mock out the UpdateRequiredAction method")
+//             },
+//             UpdateUserFunc: func(ctx context.Context, specUser *v1alpha1.KeycloakAPIUser, realmName string) error {
 // 	               panic("mock out the UpdateUser method")
 //             },
+//             UploadClientCertificateFunc: func(ctx context.Context, clientID string, realmName string, attr string, config *KeyStoreConfig, data []byte) (*Certificate, error) {
+// 	               panic("mock out the UploadClientCertificate method")
+//             },
 //         }
 //
 //         // use mockedKeycloakInterface in code that requires KeycloakInterface
@@ -268,190 +862,673 @@ var _ KeycloakInterface = &KeycloakInterfaceMock{}
 //
 //     }
 type KeycloakInterfaceMock struct {
+	// AddClientScopeClientScopeMappingsFunc mocks the AddClientScopeClientScopeMappings method.
+	AddClientScopeClientScopeMappingsFunc func(ctx context.Context, scopeID string, targetClientID string, realmName string, roles []*v1alpha1.KeycloakUserRole) error
+
+	// AddClientScopeRealmScopeMappingsFunc mocks the AddClientScopeRealmScopeMappings method.
+	AddClientScopeRealmScopeMappingsFunc func(ctx context.Context, scopeID string, realmName string, roles []*v1alpha1.KeycloakUserRole) error
+
+	// AddCompositesToClientRoleFunc mocks the AddCompositesToClientRole method.
+	AddCompositesToClientRoleFunc func(ctx context.Context, clientID string, roleName string, realmName string, composites []*v1alpha1.KeycloakUserRole) error
+
 	// AddUserToGroupFunc mocks the AddUserToGroup method.
-	AddUserToGroupFunc func(realmName string, userID string, groupID string) error
+	AddUserToGroupFunc func(ctx context.Context, realmName string, userID string, groupID string) error
+
+	// AssignDefaultClientScopeToClientFunc mocks the AssignDefaultClientScopeToClient method.
+	AssignDefaultClientScopeToClientFunc func(ctx context.Context, clientID string, scopeID string, realmName string) error
+
+	// AssignOptionalClientScopeToClientFunc mocks the AssignOptionalClientScopeToClient method.
+	AssignOptionalClientScopeToClientFunc func(ctx context.Context, clientID string, scopeID string, realmName string) error
+
+	// BulkCreateUsersFunc mocks the BulkCreateUsers method.
+	BulkCreateUsersFunc func(ctx context.Context, users []*v1alpha1.KeycloakAPIUser, realmName string, concurrency int) []error
+
+	// ClearAdminEventsFunc mocks the ClearAdminEvents method.
+	ClearAdminEventsFunc func(ctx context.Context, realmName string) error
+
+	// ClearBruteForceForUserFunc mocks the ClearBruteForceForUser method.
+	ClearBruteForceForUserFunc func(ctx context.Context, userID string, realmName string) error
+
+	// ClearRealmEventsFunc mocks the ClearRealmEvents method.
+	ClearRealmEventsFunc func(ctx context.Context, realmName string) error
+
+	// CopyClientScopeFunc mocks the CopyClientScope method.
+	CopyClientScopeFunc func(ctx context.Context, sourceScopeID string, newName string, realmName string) (string, error)
+
+	// CountClientOfflineSessionsFunc mocks the CountClientOfflineSessions method.
+	CountClientOfflineSessionsFunc func(ctx context.Context, clientID string, realmName string) (int, error)
+
+	// CountClientSessionsFunc mocks the CountClientSessions method.
+	CountClientSessionsFunc func(ctx context.Context, clientID string, realmName string) (int, error)
+
+	// CountGroupsFunc mocks the CountGroups method.
+	CountGroupsFunc func(ctx context.Context, realmName string, search string, topLevelOnly bool) (int, error)
 
 	// CreateAuthenticatorConfigFunc mocks the CreateAuthenticatorConfig method.
-	CreateAuthenticatorConfigFunc func(authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string, executionID string) (string, error)
+	CreateAuthenticatorConfigFunc func(ctx context.Context, authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string, executionID string) (string, error)
+
+	// CreateAuthzPolicyFunc mocks the CreateAuthzPolicy method.
+	CreateAuthzPolicyFunc func(ctx context.Context, clientID string, realmName string, policyType string, policy json.RawMessage) (*AuthzPolicy, error)
+
+	// CreateAuthzResourceFunc mocks the CreateAuthzResource method.
+	CreateAuthzResourceFunc func(ctx context.Context, clientID string, realmName string, resource *AuthzResource) (string, error)
+
+	// CreateAuthzResourcePermissionFunc mocks the CreateAuthzResourcePermission method.
+	CreateAuthzResourcePermissionFunc func(ctx context.Context, clientID string, realmName string, permission *AuthzPermission) (string, error)
+
+	// CreateAuthzScopeFunc mocks the CreateAuthzScope method.
+	CreateAuthzScopeFunc func(ctx context.Context, clientID string, realmName string, scope *AuthzScope) (string, error)
+
+	// CreateAuthzScopePermissionFunc mocks the CreateAuthzScopePermission method.
+	CreateAuthzScopePermissionFunc func(ctx context.Context, clientID string, realmName string, permission *AuthzPermission) (string, error)
+
+	// CreateChildGroupFunc mocks the CreateChildGroup method.
+	CreateChildGroupFunc func(ctx context.Context, parentGroupID string, name string, realmName string) (string, error)
 
 	// CreateClientFunc mocks the CreateClient method.
-	CreateClientFunc func(client *v1alpha1.KeycloakAPIClient, realmName string) (string, error)
+	CreateClientFunc func(ctx context.Context, client *v1alpha1.KeycloakAPIClient, realmName string) (string, error)
+
+	// CreateClientRoleFunc mocks the CreateClientRole method.
+	CreateClientRoleFunc func(ctx context.Context, clientID string, realmName string, role *v1alpha1.KeycloakUserRole) error
+
+	// CreateClientScopeFunc mocks the CreateClientScope method.
+	CreateClientScopeFunc func(ctx context.Context, scope *ClientScope, realmName string) error
+
+	// CreateComponentFunc mocks the CreateComponent method.
+	CreateComponentFunc func(ctx context.Context, component *Component, realmName string) error
 
 	// CreateFederatedIdentityFunc mocks the CreateFederatedIdentity method.
-	CreateFederatedIdentityFunc func(fid v1alpha1.FederatedIdentity, userID string, realmName string) (string, error)
+	CreateFederatedIdentityFunc func(ctx context.Context, fid v1alpha1.FederatedIdentity, userID string, realmName string) (string, error)
 
 	// CreateGroupFunc mocks the CreateGroup method.
-	CreateGroupFunc func(group string, realmName string) (string, error)
+	CreateGroupFunc func(ctx context.Context, group string, realmName string) (string, error)
 
 	// CreateGroupClientRoleFunc mocks the CreateGroupClientRole method.
-	CreateGroupClientRoleFunc func(role *v1alpha1.KeycloakUserRole, realmName string, clientID string, groupID string) (string, error)
+	CreateGroupClientRoleFunc func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, clientID string, groupID string) (string, error)
 
 	// CreateGroupRealmRoleFunc mocks the CreateGroupRealmRole method.
-	CreateGroupRealmRoleFunc func(role *v1alpha1.KeycloakUserRole, realmName string, groupID string) (string, error)
+	CreateGroupRealmRoleFunc func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, groupID string) (string, error)
 
 	// CreateIdentityProviderFunc mocks the CreateIdentityProvider method.
-	CreateIdentityProviderFunc func(identityProvider *v1alpha1.KeycloakIdentityProvider, realmName string) (string, error)
+	CreateIdentityProviderFunc func(ctx context.Context, identityProvider *IdentityProvider, realmName string) error
+
+	// CreateIdentityProviderMapperFunc mocks the CreateIdentityProviderMapper method.
+	CreateIdentityProviderMapperFunc func(ctx context.Context, alias string, realmName string, mapper *IdentityProviderMapper) error
+
+	// CreateProtocolMapperForClientFunc mocks the CreateProtocolMapperForClient method.
+	CreateProtocolMapperForClientFunc func(ctx context.Context, clientID string, realmName string, mapper *ProtocolMapper) error
+
+	// CreateProtocolMapperForClientScopeFunc mocks the CreateProtocolMapperForClientScope method.
+	CreateProtocolMapperForClientScopeFunc func(ctx context.Context, scopeID string, realmName string, mapper *ProtocolMapper) error
 
 	// CreateRealmFunc mocks the CreateRealm method.
-	CreateRealmFunc func(realm *v1alpha1.KeycloakRealm) (string, error)
+	CreateRealmFunc func(ctx context.Context, realm *v1alpha1.KeycloakRealm) (string, error)
 
 	// CreateUserFunc mocks the CreateUser method.
-	CreateUserFunc func(user *v1alpha1.KeycloakAPIUser, realmName string) (string, error)
+	CreateUserFunc func(ctx context.Context, user *v1alpha1.KeycloakAPIUser, realmName string) (string, error)
 
 	// CreateUserClientRoleFunc mocks the CreateUserClientRole method.
-	CreateUserClientRoleFunc func(role *v1alpha1.KeycloakUserRole, realmName string, clientID string, userID string) (string, error)
+	CreateUserClientRoleFunc func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, clientID string, userID string) (string, error)
 
 	// CreateUserRealmRoleFunc mocks the CreateUserRealmRole method.
-	CreateUserRealmRoleFunc func(role *v1alpha1.KeycloakUserRole, realmName string, userID string) (string, error)
+	CreateUserRealmRoleFunc func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, userID string) (string, error)
 
 	// DeleteAuthenticatorConfigFunc mocks the DeleteAuthenticatorConfig method.
-	DeleteAuthenticatorConfigFunc func(configID string, realmName string) error
+	DeleteAuthenticatorConfigFunc func(ctx context.Context, configID string, realmName string) error
+
+	// DeleteAuthzPermissionFunc mocks the DeleteAuthzPermission method.
+	DeleteAuthzPermissionFunc func(ctx context.Context, clientID string, permissionID string, realmName string) error
+
+	// DeleteAuthzPolicyFunc mocks the DeleteAuthzPolicy method.
+	DeleteAuthzPolicyFunc func(ctx context.Context, clientID string, policyID string, realmName string) error
+
+	// DeleteAuthzResourceFunc mocks the DeleteAuthzResource method.
+	DeleteAuthzResourceFunc func(ctx context.Context, clientID string, resourceID string, realmName string) error
+
+	// DeleteAuthzScopeFunc mocks the DeleteAuthzScope method.
+	DeleteAuthzScopeFunc func(ctx context.Context, clientID string, scopeID string, realmName string) error
 
 	// DeleteClientFunc mocks the DeleteClient method.
-	DeleteClientFunc func(clientID string, realmName string) error
+	DeleteClientFunc func(ctx context.Context, clientID string, realmName string) error
+
+	// DeleteClientRoleFunc mocks the DeleteClientRole method.
+	DeleteClientRoleFunc func(ctx context.Context, clientID string, roleName string, realmName string) error
+
+	// DeleteClientScopeFunc mocks the DeleteClientScope method.
+	DeleteClientScopeFunc func(ctx context.Context, scopeID string, realmName string) error
+
+	// DeleteClientScopeClientScopeMappingsFunc mocks the DeleteClientScopeClientScopeMappings method.
+	DeleteClientScopeClientScopeMappingsFunc func(ctx context.Context, scopeID string, targetClientID string, realmName string, roles []*v1alpha1.KeycloakUserRole) error
+
+	// DeleteClientScopeRealmScopeMappingsFunc mocks the DeleteClientScopeRealmScopeMappings method.
+	DeleteClientScopeRealmScopeMappingsFunc func(ctx context.Context, scopeID string, realmName string, roles []*v1alpha1.KeycloakUserRole) error
+
+	// DeleteComponentFunc mocks the DeleteComponent method.
+	DeleteComponentFunc func(ctx context.Context, componentID string, realmName string) error
+
+	// DeleteGroupFunc mocks the DeleteGroup method.
+	DeleteGroupFunc func(ctx context.Context, groupID string, realmName string) error
+
+	// DeleteGroupClientRoleFunc mocks the DeleteGroupClientRole method.
+	DeleteGroupClientRoleFunc func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, clientID string, groupID string) error
 
 	// DeleteIdentityProviderFunc mocks the DeleteIdentityProvider method.
-	DeleteIdentityProviderFunc func(alias string, realmName string) error
+	DeleteIdentityProviderFunc func(ctx context.Context, alias string, realmName string) error
+
+	// DeleteIdentityProviderMapperFunc mocks the DeleteIdentityProviderMapper method.
+	DeleteIdentityProviderMapperFunc func(ctx context.Context, alias string, mapperID string, realmName string) error
+
+	// DeleteProtocolMapperForClientFunc mocks the DeleteProtocolMapperForClient method.
+	DeleteProtocolMapperForClientFunc func(ctx context.Context, clientID string, mapperID string, realmName string) error
+
+	// DeleteProtocolMapperForClientScopeFunc mocks the DeleteProtocolMapperForClientScope method.
+	DeleteProtocolMapperForClientScopeFunc func(ctx context.Context, scopeID string, mapperID string, realmName string) error
 
 	// DeleteRealmFunc mocks the DeleteRealm method.
-	DeleteRealmFunc func(realmName string) error
+	DeleteRealmFunc func(ctx context.Context, realmName string) error
+
+	// DeleteRequiredActionFunc mocks the DeleteRequiredAction method.
+	DeleteRequiredActionFunc func(ctx context.Context, alias string, realmName string) error
 
 	// DeleteUserFunc mocks the DeleteUser method.
-	DeleteUserFunc func(userID string, realmName string) error
+	DeleteUserFunc func(ctx context.Context, userID string, realmName string) error
 
 	// DeleteUserClientRoleFunc mocks the DeleteUserClientRole method.
-	DeleteUserClientRoleFunc func(role *v1alpha1.KeycloakUserRole, realmName string, clientID string, userID string) error
+	DeleteUserClientRoleFunc func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, clientID string, userID string) error
+
+	// DeleteUserCredentialFunc mocks the DeleteUserCredential method.
+	DeleteUserCredentialFunc func(ctx context.Context, userID string, credentialID string, realmName string) error
 
 	// DeleteUserFromGroupFunc mocks the DeleteUserFromGroup method.
-	DeleteUserFromGroupFunc func(realmName string, userID string, groupID string) error
+	DeleteUserFromGroupFunc func(ctx context.Context, realmName string, userID string, groupID string) error
 
 	// DeleteUserRealmRoleFunc mocks the DeleteUserRealmRole method.
-	DeleteUserRealmRoleFunc func(role *v1alpha1.KeycloakUserRole, realmName string, userID string) error
+	DeleteUserRealmRoleFunc func(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, userID string) error
+
+	// DeleteUserSessionFunc mocks the DeleteUserSession method.
+	DeleteUserSessionFunc func(ctx context.Context, sessionID string, realmName string) error
+
+	// DownloadClientKeystoreFunc mocks the DownloadClientKeystore method.
+	DownloadClientKeystoreFunc func(ctx context.Context, clientID string, realmName string, attr string, config *KeyStoreConfig) ([]byte, error)
+
+	// EvaluateAuthzPermissionsFunc mocks the EvaluateAuthzPermissions method.
+	EvaluateAuthzPermissionsFunc func(ctx context.Context, clientID string, realmName string, evaluation *PolicyEvaluationRequest) (*PolicyEvaluationResponse, error)
+
+	// ExchangeTokenFunc mocks the ExchangeToken method.
+	ExchangeTokenFunc func(ctx context.Context, realmName string, subjectToken string, audience string, clientID string, clientSecret string) (*TokenResponse, error)
 
 	// FindAuthenticationExecutionForFlowFunc mocks the FindAuthenticationExecutionForFlow method.
-	FindAuthenticationExecutionForFlowFunc func(flowAlias string, realmName string, predicate func(*v1alpha1.AuthenticationExecutionInfo) bool) (*v1alpha1.AuthenticationExecutionInfo, error)
+	FindAuthenticationExecutionForFlowFunc func(ctx context.Context, flowAlias string, realmName string, predicate func(*v1alpha1.AuthenticationExecutionInfo) bool) (*v1alpha1.AuthenticationExecutionInfo, error)
 
 	// FindAvailableGroupClientRoleFunc mocks the FindAvailableGroupClientRole method.
-	FindAvailableGroupClientRoleFunc func(realmName string, clientID string, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error)
+	FindAvailableGroupClientRoleFunc func(ctx context.Context, realmName string, clientID string, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error)
+
+	// FindClientByClientIDFunc mocks the FindClientByClientID method.
+	FindClientByClientIDFunc func(ctx context.Context, clientID string, realmName string) (*v1alpha1.KeycloakAPIClient, error)
+
+	// FindClientScopeByNameFunc mocks the FindClientScopeByName method.
+	FindClientScopeByNameFunc func(ctx context.Context, name string, realmName string) (*ClientScope, error)
 
 	// FindGroupByNameFunc mocks the FindGroupByName method.
-	FindGroupByNameFunc func(groupName string, realmName string) (*Group, error)
+	FindGroupByNameFunc func(ctx context.Context, groupName string, realmName string) (*Group, error)
+
+	// FindGroupByNameInHierarchyFunc mocks the FindGroupByNameInHierarchy method.
+	FindGroupByNameInHierarchyFunc func(ctx context.Context, groupName string, realmName string) (*Group, error)
 
 	// FindGroupClientRoleFunc mocks the FindGroupClientRole method.
-	FindGroupClientRoleFunc func(realmName string, clientID string, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error)
+	FindGroupClientRoleFunc func(ctx context.Context, realmName string, clientID string, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error)
 
 	// FindUserByEmailFunc mocks the FindUserByEmail method.
-	FindUserByEmailFunc func(email string, realm string) (*v1alpha1.KeycloakAPIUser, error)
+	FindUserByEmailFunc func(ctx context.Context, email string, realm string) (*v1alpha1.KeycloakAPIUser, error)
 
 	// FindUserByUsernameFunc mocks the FindUserByUsername method.
-	FindUserByUsernameFunc func(name string, realm string) (*v1alpha1.KeycloakAPIUser, error)
+	FindUserByUsernameFunc func(ctx context.Context, name string, realm string) (*v1alpha1.KeycloakAPIUser, error)
+
+	// GenerateClientCertificateFunc mocks the GenerateClientCertificate method.
+	GenerateClientCertificateFunc func(ctx context.Context, clientID string, realmName string, attr string) (*Certificate, error)
+
+	// GenerateExampleAccessTokenFunc mocks the GenerateExampleAccessToken method.
+	GenerateExampleAccessTokenFunc func(ctx context.Context, clientID string, realmName string, userID string, scope string) (string, error)
+
+	// GetAllGroupMembersFunc mocks the GetAllGroupMembers method.
+	GetAllGroupMembersFunc func(ctx context.Context, groupID string, realmName string, briefRepresentation bool) ([]*v1alpha1.KeycloakAPIUser, error)
 
 	// GetAuthenticatorConfigFunc mocks the GetAuthenticatorConfig method.
-	GetAuthenticatorConfigFunc func(configID string, realmName string) (*v1alpha1.AuthenticatorConfig, error)
+	GetAuthenticatorConfigFunc func(ctx context.Context, configID string, realmName string) (*v1alpha1.AuthenticatorConfig, error)
+
+	// GetAuthzPolicyFunc mocks the GetAuthzPolicy method.
+	GetAuthzPolicyFunc func(ctx context.Context, clientID string, policyID string, realmName string) (*AuthzPolicy, error)
+
+	// GetAuthzResourceFunc mocks the GetAuthzResource method.
+	GetAuthzResourceFunc func(ctx context.Context, clientID string, resourceID string, realmName string) (*AuthzResource, error)
+
+	// GetBruteForceStatusFunc mocks the GetBruteForceStatus method.
+	GetBruteForceStatusFunc func(ctx context.Context, userID string, realmName string) (*BruteForceStatus, error)
 
 	// GetClientFunc mocks the GetClient method.
-	GetClientFunc func(clientID string, realmName string) (*v1alpha1.KeycloakAPIClient, error)
+	GetClientFunc func(ctx context.Context, clientID string, realmName string) (*v1alpha1.KeycloakAPIClient, error)
+
+	// GetClientCertificateFunc mocks the GetClientCertificate method.
+	GetClientCertificateFunc func(ctx context.Context, clientID string, realmName string, attr string) (*Certificate, error)
 
 	// GetClientInstallFunc mocks the GetClientInstall method.
-	GetClientInstallFunc func(clientID string, realmName string) ([]byte, error)
+	GetClientInstallFunc func(ctx context.Context, clientID string, realmName string) ([]byte, error)
+
+	// GetClientInstallationProviderFunc mocks the GetClientInstallationProvider method.
+	GetClientInstallationProviderFunc func(ctx context.Context, clientID string, realmName string, providerID string) (*ClientInstallation, error)
+
+	// GetClientManagementPermissionsFunc mocks the GetClientManagementPermissions method.
+	GetClientManagementPermissionsFunc func(ctx context.Context, clientID string, realmName string) (*ManagementPermissionReference, error)
+
+	// GetClientRoleByNameFunc mocks the GetClientRoleByName method.
+	GetClientRoleByNameFunc func(ctx context.Context, clientID string, roleName string, realmName string) (*v1alpha1.KeycloakUserRole, error)
+
+	// GetClientScopeFunc mocks the GetClientScope method.
+	GetClientScopeFunc func(ctx context.Context, scopeID string, realmName string) (*ClientScope, error)
 
 	// GetClientSecretFunc mocks the GetClientSecret method.
-	GetClientSecretFunc func(clientID string, realmName string) (string, error)
+	GetClientSecretFunc func(ctx context.Context, clientID string, realmName string) (string, error)
+
+	// GetClientServiceAccountUserFunc mocks the GetClientServiceAccountUser method.
+	GetClientServiceAccountUserFunc func(ctx context.Context, clientID string, realmName string) (*v1alpha1.KeycloakAPIUser, error)
+
+	// GetGroupByPathFunc mocks the GetGroupByPath method.
+	GetGroupByPathFunc func(ctx context.Context, path string, realmName string) (*Group, error)
+
+	// GetGroupHierarchyFunc mocks the GetGroupHierarchy method.
+	GetGroupHierarchyFunc func(ctx context.Context, realmName string) ([]*Group, error)
+
+	// GetGroupManagementPermissionsFunc mocks the GetGroupManagementPermissions method.
+	GetGroupManagementPermissionsFunc func(ctx context.Context, groupID string, realmName string) (*ManagementPermissionReference, error)
+
+	// GetGroupMembersFunc mocks the GetGroupMembers method.
+	GetGroupMembersFunc func(ctx context.Context, groupID string, realmName string, first int, max int) ([]*v1alpha1.KeycloakAPIUser, error)
 
 	// GetIdentityProviderFunc mocks the GetIdentityProvider method.
-	GetIdentityProviderFunc func(alias string, realmName string) (*v1alpha1.KeycloakIdentityProvider, error)
+	GetIdentityProviderFunc func(ctx context.Context, alias string, realmName string) (*IdentityProvider, error)
+
+	// GetJWKSFunc mocks the GetJWKS method.
+	GetJWKSFunc func(ctx context.Context, realmName string) (*JWKS, error)
 
 	// GetRealmFunc mocks the GetRealm method.
-	GetRealmFunc func(realmName string) (*v1alpha1.KeycloakRealm, error)
+	GetRealmFunc func(ctx context.Context, realmName string) (*v1alpha1.KeycloakRealm, error)
+
+	// GetRealmAttributeFunc mocks the GetRealmAttribute method.
+	GetRealmAttributeFunc func(ctx context.Context, realmName string, key string) (string, bool, error)
+
+	// GetRealmEventsConfigFunc mocks the GetRealmEventsConfig method.
+	GetRealmEventsConfigFunc func(ctx context.Context, realmName string) (*RealmEventsConfig, error)
+
+	// GetRotatedClientSecretFunc mocks the GetRotatedClientSecret method.
+	GetRotatedClientSecretFunc func(ctx context.Context, clientID string, realmName string) (string, error)
+
+	// GetServerInfoFunc mocks the GetServerInfo method.
+	GetServerInfoFunc func(ctx context.Context) (*ServerInfo, error)
 
 	// GetUserFunc mocks the GetUser method.
-	GetUserFunc func(userID string, realmName string) (*v1alpha1.KeycloakAPIUser, error)
+	GetUserFunc func(ctx context.Context, userID string, realmName string) (*v1alpha1.KeycloakAPIUser, error)
+
+	// GetUserByFederatedIdentityFunc mocks the GetUserByFederatedIdentity method.
+	GetUserByFederatedIdentityFunc func(ctx context.Context, realmName string, providerAlias string, externalUserID string) (*v1alpha1.KeycloakAPIUser, error)
 
 	// GetUserFederatedIdentitiesFunc mocks the GetUserFederatedIdentities method.
-	GetUserFederatedIdentitiesFunc func(userName string, realmName string) ([]v1alpha1.FederatedIdentity, error)
+	GetUserFederatedIdentitiesFunc func(ctx context.Context, userName string, realmName string) ([]v1alpha1.FederatedIdentity, error)
+
+	// GetWellKnownConfigurationFunc mocks the GetWellKnownConfiguration method.
+	GetWellKnownConfigurationFunc func(ctx context.Context, realmName string) (*OIDCConfiguration, error)
+
+	// IntrospectTokenFunc mocks the IntrospectToken method.
+	IntrospectTokenFunc func(ctx context.Context, realmName string, clientID string, clientSecret string, token string) (*TokenIntrospection, error)
+
+	// InvalidateRotatedClientSecretFunc mocks the InvalidateRotatedClientSecret method.
+	InvalidateRotatedClientSecretFunc func(ctx context.Context, clientID string, realmName string) error
+
+	// ListAdminEventsFunc mocks the ListAdminEvents method.
+	ListAdminEventsFunc func(ctx context.Context, realmName string, params *AdminEventListParams) ([]*AdminEvent, error)
 
 	// ListAuthenticationExecutionsForFlowFunc mocks the ListAuthenticationExecutionsForFlow method.
-	ListAuthenticationExecutionsForFlowFunc func(flowAlias string, realmName string) ([]*v1alpha1.AuthenticationExecutionInfo, error)
+	ListAuthenticationExecutionsForFlowFunc func(ctx context.Context, flowAlias string, realmName string) ([]*v1alpha1.AuthenticationExecutionInfo, error)
+
+	// ListAuthzPermissionPoliciesFunc mocks the ListAuthzPermissionPolicies method.
+	ListAuthzPermissionPoliciesFunc func(ctx context.Context, clientID string, permissionID string, realmName string) ([]*AuthzPolicy, error)
+
+	// ListAuthzPermissionResourcesFunc mocks the ListAuthzPermissionResources method.
+	ListAuthzPermissionResourcesFunc func(ctx context.Context, clientID string, permissionID string, realmName string) ([]*AuthzResource, error)
+
+	// ListAuthzPermissionScopesFunc mocks the ListAuthzPermissionScopes method.
+	ListAuthzPermissionScopesFunc func(ctx context.Context, clientID string, permissionID string, realmName string) ([]*AuthzScope, error)
+
+	// ListAuthzPermissionsFunc mocks the ListAuthzPermissions method.
+	ListAuthzPermissionsFunc func(ctx context.Context, clientID string, realmName string) ([]*AuthzPermission, error)
+
+	// ListAuthzPoliciesFunc mocks the ListAuthzPolicies method.
+	ListAuthzPoliciesFunc func(ctx context.Context, clientID string, realmName string) ([]*AuthzPolicy, error)
+
+	// ListAuthzResourcesFunc mocks the ListAuthzResources method.
+	ListAuthzResourcesFunc func(ctx context.Context, clientID string, realmName string, params *AuthzResourceListParams) ([]*AuthzResource, error)
+
+	// ListAuthzScopesFunc mocks the ListAuthzScopes method.
+	ListAuthzScopesFunc func(ctx context.Context, clientID string, realmName string, params *AuthzScopeListParams) ([]*AuthzScope, error)
+
+	// ListAvailableClientScopeClientScopeMappingsFunc mocks the ListAvailableClientScopeClientScopeMappings method.
+	ListAvailableClientScopeClientScopeMappingsFunc func(ctx context.Context, scopeID string, targetClientID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
+
+	// ListAvailableClientScopeRealmScopeMappingsFunc mocks the ListAvailableClientScopeRealmScopeMappings method.
+	ListAvailableClientScopeRealmScopeMappingsFunc func(ctx context.Context, scopeID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
 
 	// ListAvailableGroupClientRolesFunc mocks the ListAvailableGroupClientRoles method.
-	ListAvailableGroupClientRolesFunc func(realmName string, clientID string, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
+	ListAvailableGroupClientRolesFunc func(ctx context.Context, realmName string, clientID string, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
 
 	// ListAvailableGroupRealmRolesFunc mocks the ListAvailableGroupRealmRoles method.
-	ListAvailableGroupRealmRolesFunc func(realmName string, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
+	ListAvailableGroupRealmRolesFunc func(ctx context.Context, realmName string, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
 
 	// ListAvailableUserClientRolesFunc mocks the ListAvailableUserClientRoles method.
-	ListAvailableUserClientRolesFunc func(realmName string, clientID string, userID string) ([]*v1alpha1.KeycloakUserRole, error)
+	ListAvailableUserClientRolesFunc func(ctx context.Context, realmName string, clientID string, userID string) ([]*v1alpha1.KeycloakUserRole, error)
 
 	// ListAvailableUserRealmRolesFunc mocks the ListAvailableUserRealmRoles method.
-	ListAvailableUserRealmRolesFunc func(realmName string, userID string) ([]*v1alpha1.KeycloakUserRole, error)
+	ListAvailableUserRealmRolesFunc func(ctx context.Context, realmName string, userID string) ([]*v1alpha1.KeycloakUserRole, error)
 
-	// ListClientsFunc mocks the ListClients method.
-	ListClientsFunc func(realmName string) ([]*v1alpha1.KeycloakAPIClient, error)
+	// ListClientOfflineSessionsFunc mocks the ListClientOfflineSessions method.
+	ListClientOfflineSessionsFunc func(ctx context.Context, clientID string, realmName string, first int, max int) ([]*UserSession, error)
 
-	// ListDefaultGroupsFunc mocks the ListDefaultGroups method.
-	ListDefaultGroupsFunc func(realmName string) ([]*Group, error)
+	// ListClientRoleClientCompositesFunc mocks the ListClientRoleClientComposites method.
+	ListClientRoleClientCompositesFunc func(ctx context.Context, clientID string, roleName string, otherClientID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
 
-	// ListGroupClientRolesFunc mocks the ListGroupClientRoles method.
-	ListGroupClientRolesFunc func(realmName string, clientID string, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
+	// ListClientRoleCompositesFunc mocks the ListClientRoleComposites method.
+	ListClientRoleCompositesFunc func(ctx context.Context, clientID string, roleName string, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
 
-	// ListGroupRealmRolesFunc mocks the ListGroupRealmRoles method.
-	ListGroupRealmRolesFunc func(realmName string, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
+	// ListClientRoleRealmCompositesFunc mocks the ListClientRoleRealmComposites method.
+	ListClientRoleRealmCompositesFunc func(ctx context.Context, clientID string, roleName string, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
 
-	// ListIdentityProvidersFunc mocks the ListIdentityProviders method.
-	ListIdentityProvidersFunc func(realmName string) ([]*v1alpha1.KeycloakIdentityProvider, error)
+	// ListClientRolesFunc mocks the ListClientRoles method.
+	ListClientRolesFunc func(ctx context.Context, clientID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
 
-	// ListRealmsFunc mocks the ListRealms method.
-	ListRealmsFunc func() ([]*v1alpha1.KeycloakAPIRealm, error)
+	// ListClientScopeClientScopeMappingsFunc mocks the ListClientScopeClientScopeMappings method.
+	ListClientScopeClientScopeMappingsFunc func(ctx context.Context, scopeID string, targetClientID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
 
-	// ListUserClientRolesFunc mocks the ListUserClientRoles method.
-	ListUserClientRolesFunc func(realmName string, clientID string, userID string) ([]*v1alpha1.KeycloakUserRole, error)
+	// ListClientScopeRealmScopeMappingsFunc mocks the ListClientScopeRealmScopeMappings method.
+	ListClientScopeRealmScopeMappingsFunc func(ctx context.Context, scopeID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error)
 
-	// ListUserRealmRolesFunc mocks the ListUserRealmRoles method.
-	ListUserRealmRolesFunc func(realmName string, userID string) ([]*v1alpha1.KeycloakUserRole, error)
+	// ListClientScopesFunc mocks the ListClientScopes method.
+	ListClientScopesFunc func(ctx context.Context, realmName string) ([]*ClientScope, error)
 
-	// ListUsersFunc mocks the ListUsers method.
-	ListUsersFunc func(realmName string) ([]*v1alpha1.KeycloakAPIUser, error)
+	// ListClientSessionsFunc mocks the ListClientSessions method.
+	ListClientSessionsFunc func(ctx context.Context, clientID string, realmName string) ([]*UserSession, error)
 
-	// ListUsersInGroupFunc mocks the ListUsersInGroup method.
-	ListUsersInGroupFunc func(realmName string, groupID string) ([]*v1alpha1.KeycloakAPIUser, error)
+	// ListClientUserSessionsFunc mocks the ListClientUserSessions method.
+	ListClientUserSessionsFunc func(ctx context.Context, clientID string, realmName string, first int, max int) ([]*UserSession, error)
 
-	// MakeGroupDefaultFunc mocks the MakeGroupDefault method.
-	MakeGroupDefaultFunc func(groupID string, realmName string) error
+	// ListClientsFunc mocks the ListClients method.
+	ListClientsFunc func(ctx context.Context, realmName string) ([]*v1alpha1.KeycloakAPIClient, error)
 
-	// PingFunc mocks the Ping method.
-	PingFunc func() error
+	// ListClientsWithParamsFunc mocks the ListClientsWithParams method.
+	ListClientsWithParamsFunc func(ctx context.Context, realmName string, params *ClientListParams) ([]*v1alpha1.KeycloakAPIClient, error)
 
-	// RemoveFederatedIdentityFunc mocks the RemoveFederatedIdentity method.
-	RemoveFederatedIdentityFunc func(fid v1alpha1.FederatedIdentity, userID string, realmName string) error
+	// ListComponentsFunc mocks the ListComponents method.
+	ListComponentsFunc func(ctx context.Context, realmName string, params *ComponentListParams) ([]*Component, error)
 
-	// SetGroupChildFunc mocks the SetGroupChild method.
-	SetGroupChildFunc func(groupID string, realmName string, childGroup *Group) error
+	// ListDefaultClientScopesForClientFunc mocks the ListDefaultClientScopesForClient method.
+	ListDefaultClientScopesForClientFunc func(ctx context.Context, clientID string, realmName string) ([]*ClientScope, error)
 
-	// UpdateAuthenticationExecutionForFlowFunc mocks the UpdateAuthenticationExecutionForFlow method.
-	UpdateAuthenticationExecutionForFlowFunc func(flowAlias string, realmName string, execution *v1alpha1.AuthenticationExecutionInfo) error
+	// ListDefaultGroupsFunc mocks the ListDefaultGroups method.
+	ListDefaultGroupsFunc func(ctx context.Context, realmName string) ([]*Group, error)
 
-	// UpdateAuthenticatorConfigFunc mocks the UpdateAuthenticatorConfig method.
-	UpdateAuthenticatorConfigFunc func(authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string) error
+	// ListEvaluatedProtocolMappersFunc mocks the ListEvaluatedProtocolMappers method.
+	ListEvaluatedProtocolMappersFunc func(ctx context.Context, clientID string, realmName string, scope string) ([]*ProtocolMapperEvaluation, error)
 
-	// UpdateClientFunc mocks the UpdateClient method.
-	UpdateClientFunc func(specClient *v1alpha1.KeycloakAPIClient, realmName string) error
+	// ListGroupClientRolesFunc mocks the ListGroupClientRoles method.
+	ListGroupClientRolesFunc func(ctx context.Context, realmName string, clientID string, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
 
-	// UpdateIdentityProviderFunc mocks the UpdateIdentityProvider method.
-	UpdateIdentityProviderFunc func(specIdentityProvider *v1alpha1.KeycloakIdentityProvider, realmName string) error
+	// ListGroupRealmRolesFunc mocks the ListGroupRealmRoles method.
+	ListGroupRealmRolesFunc func(ctx context.Context, realmName string, groupID string) ([]*v1alpha1.KeycloakUserRole, error)
 
-	// UpdatePasswordFunc mocks the UpdatePassword method.
-	UpdatePasswordFunc func(user *v1alpha1.KeycloakAPIUser, realmName string, newPass string) error
+	// ListGroupsFunc mocks the ListGroups method.
+	ListGroupsFunc func(ctx context.Context, realmName string, search string, first int, max int) ([]*Group, error)
 
-	// UpdateRealmFunc mocks the UpdateRealm method.
-	UpdateRealmFunc func(specRealm *v1alpha1.KeycloakRealm) error
+	// ListIdentityProviderMappersFunc mocks the ListIdentityProviderMappers method.
+	ListIdentityProviderMappersFunc func(ctx context.Context, alias string, realmName string) ([]*IdentityProviderMapper, error)
 
-	// UpdateUserFunc mocks the UpdateUser method.
-	UpdateUserFunc func(specUser *v1alpha1.KeycloakAPIUser, realmName string) error
+	// ListIdentityProvidersFunc mocks the ListIdentityProviders method.
+	ListIdentityProvidersFunc func(ctx context.Context, realmName string) ([]*IdentityProvider, error)
+
+	// ListOfflineSessionsForUserFunc mocks the ListOfflineSessionsForUser method.
+	ListOfflineSessionsForUserFunc func(ctx context.Context, userID string, realmName string) ([]*UserSession, error)
+
+	// ListOptionalClientScopesForClientFunc mocks the ListOptionalClientScopesForClient method.
+	ListOptionalClientScopesForClientFunc func(ctx context.Context, clientID string, realmName string) ([]*ClientScope, error)
+
+	// ListProtocolMappersForClientFunc mocks the ListProtocolMappersForClient method.
+	ListProtocolMappersForClientFunc func(ctx context.Context, clientID string, realmName string) ([]*ProtocolMapper, error)
+
+	// ListProtocolMappersForClientScopeFunc mocks the ListProtocolMappersForClientScope method.
+	ListProtocolMappersForClientScopeFunc func(ctx context.Context, scopeID string, realmName string) ([]*ProtocolMapper, error)
+
+	// ListRealmEventsFunc mocks the ListRealmEvents method.
+	ListRealmEventsFunc func(ctx context.Context, realmName string, params *EventListParams) ([]*RealmEvent, error)
+
+	// ListRealmKeysFunc mocks the ListRealmKeys method.
+	ListRealmKeysFunc func(ctx context.Context, realmName string) (*RealmKeyMetadata, error)
+
+	// ListRealmsFunc mocks the ListRealms method.
+	ListRealmsFunc func(ctx context.Context) ([]*v1alpha1.KeycloakAPIRealm, error)
+
+	// ListRequiredActionsFunc mocks the ListRequiredActions method.
+	ListRequiredActionsFunc func(ctx context.Context, realmName string) ([]*RequiredAction, error)
+
+	// ListUserClientRolesFunc mocks the ListUserClientRoles method.
+	ListUserClientRolesFunc func(ctx context.Context, realmName string, clientID string, userID string) ([]*v1alpha1.KeycloakUserRole, error)
+
+	// ListUserConsentsFunc mocks the ListUserConsents method.
+	ListUserConsentsFunc func(ctx context.Context, userID string, realmName string) ([]*UserConsent, error)
+
+	// ListUserCredentialsFunc mocks the ListUserCredentials method.
+	ListUserCredentialsFunc func(ctx context.Context, userID string, realmName string) ([]*Credential, error)
+
+	// ListUserRealmRolesFunc mocks the ListUserRealmRoles method.
+	ListUserRealmRolesFunc func(ctx context.Context, realmName string, userID string) ([]*v1alpha1.KeycloakUserRole, error)
+
+	// ListUserSessionsFunc mocks the ListUserSessions method.
+	ListUserSessionsFunc func(ctx context.Context, userID string, realmName string) ([]*UserSession, error)
+
+	// ListUsersFunc mocks the ListUsers method.
+	ListUsersFunc func(ctx context.Context, realmName string) ([]*v1alpha1.KeycloakAPIUser, error)
+
+	// ListUsersInClientRoleFunc mocks the ListUsersInClientRole method.
+	ListUsersInClientRoleFunc func(ctx context.Context, clientID string, roleName string, realmName string, first int, max int) ([]*v1alpha1.KeycloakAPIUser, error)
+
+	// ListUsersInGroupFunc mocks the ListUsersInGroup method.
+	ListUsersInGroupFunc func(ctx context.Context, realmName string, groupID string) ([]*v1alpha1.KeycloakAPIUser, error)
+
+	// MakeGroupDefaultFunc mocks the MakeGroupDefault method.
+	MakeGroupDefaultFunc func(ctx context.Context, groupID string, realmName string) error
+
+	// MoveCredentialAfterFunc mocks the MoveCredentialAfter method.
+	MoveCredentialAfterFunc func(ctx context.Context, userID string, credentialID string, newPreviousCredentialID string, realmName string) error
+
+	// MoveCredentialToFirstFunc mocks the MoveCredentialToFirst method.
+	MoveCredentialToFirstFunc func(ctx context.Context, userID string, credentialID string, realmName string) error
+
+	// PatchRealmFunc mocks the PatchRealm method.
+	PatchRealmFunc func(ctx context.Context, realmName string, patch map[string]interface{}) error
+
+	// PingFunc mocks the Ping method.
+	PingFunc func(ctx context.Context) error
+
+	// PushClientRevocationFunc mocks the PushClientRevocation method.
+	PushClientRevocationFunc func(ctx context.Context, clientID string, realmName string) (*GlobalRequestResult, error)
+
+	// RefreshTokenFunc mocks the RefreshToken method.
+	RefreshTokenFunc func(ctx context.Context, realmName string, clientID string, clientSecret string, refreshToken string) (*TokenResponse, error)
+
+	// RegenerateClientRegistrationTokenFunc mocks the RegenerateClientRegistrationToken method.
+	RegenerateClientRegistrationTokenFunc func(ctx context.Context, clientID string, realmName string) (string, error)
+
+	// RegenerateClientSecretFunc mocks the RegenerateClientSecret method.
+	RegenerateClientSecretFunc func(ctx context.Context, clientID string, realmName string) (string, error)
+
+	// RegisterClientNodeFunc mocks the RegisterClientNode method.
+	RegisterClientNodeFunc func(ctx context.Context, clientID string, realmName string, nodeHost string) error
+
+	// RegisterRequiredActionFunc mocks the RegisterRequiredAction method.
+	RegisterRequiredActionFunc func(ctx context.Context, realmName string, providerID string) error
+
+	// RemoveCompositesFromClientRoleFunc mocks the RemoveCompositesFromClientRole method.
+	RemoveCompositesFromClientRoleFunc func(ctx context.Context, clientID string, roleName string, realmName string, composites []*v1alpha1.KeycloakUserRole) error
+
+	// RemoveDefaultClientScopeFromClientFunc mocks the RemoveDefaultClientScopeFromClient method.
+	RemoveDefaultClientScopeFromClientFunc func(ctx context.Context, clientID string, scopeID string, realmName string) error
+
+	// RemoveFederatedIdentityFunc mocks the RemoveFederatedIdentity method.
+	RemoveFederatedIdentityFunc func(ctx context.Context, fid v1alpha1.FederatedIdentity, userID string, realmName string) error
+
+	// RemoveOptionalClientScopeFromClientFunc mocks the RemoveOptionalClientScopeFromClient method.
+	RemoveOptionalClientScopeFromClientFunc func(ctx context.Context, clientID string, scopeID string, realmName string) error
+
+	// ResolveGroupPathFunc mocks the ResolveGroupPath method.
+	ResolveGroupPathFunc func(ctx context.Context, path string, realmName string, createMissing bool) (string, error)
+
+	// RevokeOfflineSessionFunc mocks the RevokeOfflineSession method.
+	RevokeOfflineSessionFunc func(ctx context.Context, userID string, clientID string, realmName string) error
+
+	// RevokeTokenFunc mocks the RevokeToken method.
+	RevokeTokenFunc func(ctx context.Context, realmName string, clientID string, clientSecret string, token string, tokenTypeHint string) error
+
+	// RevokeUserConsentFunc mocks the RevokeUserConsent method.
+	RevokeUserConsentFunc func(ctx context.Context, userID string, clientID string, realmName string) error
+
+	// SetGroupChildFunc mocks the SetGroupChild method.
+	SetGroupChildFunc func(ctx context.Context, groupID string, realmName string, childGroup *Group) error
+
+	// SetRealmAttributeFunc mocks the SetRealmAttribute method.
+	SetRealmAttributeFunc func(ctx context.Context, realmName string, key string, value string) error
+
+	// SetTemporaryPasswordFunc mocks the SetTemporaryPassword method.
+	SetTemporaryPasswordFunc func(ctx context.Context, userID string, realmName string, password string) error
+
+	// TestClientNodesAvailableFunc mocks the TestClientNodesAvailable method.
+	TestClientNodesAvailableFunc func(ctx context.Context, clientID string, realmName string) (*GlobalRequestResult, error)
+
+	// TriggerLDAPSyncFunc mocks the TriggerLDAPSync method.
+	TriggerLDAPSyncFunc func(ctx context.Context, componentID string, realmName string, syncType LDAPSyncType) (*LDAPSyncResult, error)
+
+	// UnlinkAllUsersFromIdPFunc mocks the UnlinkAllUsersFromIdP method.
+	UnlinkAllUsersFromIdPFunc func(ctx context.Context, realmName string, providerAlias string, concurrency int) (int, error)
+
+	// UnlinkUserFromIdPFunc mocks the UnlinkUserFromIdP method.
+	UnlinkUserFromIdPFunc func(ctx context.Context, userID string, realmName string, providerAlias string) error
+
+	// UnregisterClientNodeFunc mocks the UnregisterClientNode method.
+	UnregisterClientNodeFunc func(ctx context.Context, clientID string, realmName string, nodeHost string) error
+
+	// UpdateAuthenticationExecutionForFlowFunc mocks the UpdateAuthenticationExecutionForFlow method.
+	UpdateAuthenticationExecutionForFlowFunc func(ctx context.Context, flowAlias string, realmName string, execution *v1alpha1.AuthenticationExecutionInfo) error
+
+	// UpdateAuthenticatorConfigFunc mocks the UpdateAuthenticatorConfig method.
+	UpdateAuthenticatorConfigFunc func(ctx context.Context, authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string) error
+
+	// UpdateAuthzPolicyFunc mocks the UpdateAuthzPolicy method.
+	UpdateAuthzPolicyFunc func(ctx context.Context, clientID string, realmName string, policyType string, policyID string, policy json.RawMessage) error
+
+	// UpdateAuthzResourceFunc mocks the UpdateAuthzResource method.
+	UpdateAuthzResourceFunc func(ctx context.Context, clientID string, realmName string, resource *AuthzResource) error
+
+	// UpdateAuthzScopeFunc mocks the UpdateAuthzScope method.
+	UpdateAuthzScopeFunc func(ctx context.Context, clientID string, realmName string, scope *AuthzScope) error
+
+	// UpdateClientFunc mocks the UpdateClient method.
+	UpdateClientFunc func(ctx context.Context, specClient *v1alpha1.KeycloakAPIClient, realmName string) error
+
+	// UpdateClientManagementPermissionsFunc mocks the UpdateClientManagementPermissions method.
+	UpdateClientManagementPermissionsFunc func(ctx context.Context, clientID string, realmName string, enabled bool) (*ManagementPermissionReference, error)
+
+	// UpdateClientRoleFunc mocks the UpdateClientRole method.
+	UpdateClientRoleFunc func(ctx context.Context, clientID string, realmName string, role *v1alpha1.KeycloakUserRole) error
+
+	// UpdateClientScopeFunc mocks the UpdateClientScope method.
+	UpdateClientScopeFunc func(ctx context.Context, scope *ClientScope, realmName string) error
+
+	// UpdateComponentFunc mocks the UpdateComponent method.
+	UpdateComponentFunc func(ctx context.Context, component *Component, realmName string) error
+
+	// UpdateCredentialLabelFunc mocks the UpdateCredentialLabel method.
+	UpdateCredentialLabelFunc func(ctx context.Context, userID string, credentialID string, label string, realmName string) error
+
+	// UpdateGroupManagementPermissionsFunc mocks the UpdateGroupManagementPermissions method.
+	UpdateGroupManagementPermissionsFunc func(ctx context.Context, groupID string, realmName string, enabled bool) (*ManagementPermissionReference, error)
+
+	// UpdateIdentityProviderFunc mocks the UpdateIdentityProvider method.
+	UpdateIdentityProviderFunc func(ctx context.Context, specIdentityProvider *IdentityProvider, realmName string) error
+
+	// UpdateIdentityProviderMapperFunc mocks the UpdateIdentityProviderMapper method.
+	UpdateIdentityProviderMapperFunc func(ctx context.Context, alias string, realmName string, mapper *IdentityProviderMapper) error
+
+	// UpdatePasswordFunc mocks the UpdatePassword method.
+	UpdatePasswordFunc func(ctx context.Context, user *v1alpha1.KeycloakAPIUser, realmName string, newPass string) error
+
+	// UpdateProtocolMapperForClientFunc mocks the UpdateProtocolMapperForClient method.
+	UpdateProtocolMapperForClientFunc func(ctx context.Context, clientID string, realmName string, mapper *ProtocolMapper) error
+
+	// UpdateProtocolMapperForClientScopeFunc mocks the UpdateProtocolMapperForClientScope method.
+	UpdateProtocolMapperForClientScopeFunc func(ctx context.Context, scopeID string, realmName string, mapper *ProtocolMapper) error
+
+	// UpdateRealmFunc mocks the UpdateRealm method.
+	UpdateRealmFunc func(ctx context.Context, specRealm *v1alpha1.KeycloakRealm) error
+
+	// UpdateRealmEventsConfigFunc mocks the UpdateRealmEventsConfig method.
+	UpdateRealmEventsConfigFunc func(ctx context.Context, realmName string, config *RealmEventsConfig) error
+
+	// UpdateRequiredActionFunc mocks the UpdateRequiredAction method.
+	UpdateRequiredActionFunc func(ctx context.Context, alias string, realmName string, action *RequiredAction) error
+
+	// UpdateUserFunc mocks the UpdateUser method.
+	UpdateUserFunc func(ctx context.Context, specUser *v1alpha1.KeycloakAPIUser, realmName string) error
+
+	// UploadClientCertificateFunc mocks the UploadClientCertificate method.
+	UploadClientCertificateFunc func(ctx context.Context, clientID string, realmName string, attr string, config *KeyStoreConfig, data []byte) (*Certificate, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AddClientScopeClientScopeMappings holds details about calls to the AddClientScopeClientScopeMappings method.
+		AddClientScopeClientScopeMappings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// TargetClientID is the targetClientID argument value.
+			TargetClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Roles is the roles argument value.
+			Roles []*v1alpha1.KeycloakUserRole
+		}
+		// AddClientScopeRealmScopeMappings holds details about calls to the AddClientScopeRealmScopeMappings method.
+		AddClientScopeRealmScopeMappings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Roles is the roles argument value.
+			Roles []*v1alpha1.KeycloakUserRole
+		}
+		// AddCompositesToClientRole holds details about calls to the AddCompositesToClientRole method.
+		AddCompositesToClientRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RoleName is the roleName argument value.
+			RoleName string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Composites is the composites argument value.
+			Composites []*v1alpha1.KeycloakUserRole
+		}
 		// AddUserToGroup holds details about calls to the AddUserToGroup method.
 		AddUserToGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// RealmName is the realmName argument value.
 			RealmName string
 			// UserID is the userID argument value.
@@ -459,8 +1536,106 @@ type KeycloakInterfaceMock struct {
 			// GroupID is the groupID argument value.
 			GroupID string
 		}
+		// AssignDefaultClientScopeToClient holds details about calls to the AssignDefaultClientScopeToClient method.
+		AssignDefaultClientScopeToClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// AssignOptionalClientScopeToClient holds details about calls to the AssignOptionalClientScopeToClient method.
+		AssignOptionalClientScopeToClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// BulkCreateUsers holds details about calls to the BulkCreateUsers method.
+		BulkCreateUsers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Users is the users argument value.
+			Users []*v1alpha1.KeycloakAPIUser
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Concurrency is the concurrency argument value.
+			Concurrency int
+		}
+		// ClearAdminEvents holds details about calls to the ClearAdminEvents method.
+		ClearAdminEvents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ClearBruteForceForUser holds details about calls to the ClearBruteForceForUser method.
+		ClearBruteForceForUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ClearRealmEvents holds details about calls to the ClearRealmEvents method.
+		ClearRealmEvents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// CopyClientScope holds details about calls to the CopyClientScope method.
+		CopyClientScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SourceScopeID is the sourceScopeID argument value.
+			SourceScopeID string
+			// NewName is the newName argument value.
+			NewName string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// CountClientOfflineSessions holds details about calls to the CountClientOfflineSessions method.
+		CountClientOfflineSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// CountClientSessions holds details about calls to the CountClientSessions method.
+		CountClientSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// CountGroups holds details about calls to the CountGroups method.
+		CountGroups []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Search is the search argument value.
+			Search string
+			// TopLevelOnly is the topLevelOnly argument value.
+			TopLevelOnly bool
+		}
 		// CreateAuthenticatorConfig holds details about calls to the CreateAuthenticatorConfig method.
 		CreateAuthenticatorConfig []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// AuthenticatorConfig is the authenticatorConfig argument value.
 			AuthenticatorConfig *v1alpha1.AuthenticatorConfig
 			// RealmName is the realmName argument value.
@@ -468,15 +1643,116 @@ type KeycloakInterfaceMock struct {
 			// ExecutionID is the executionID argument value.
 			ExecutionID string
 		}
+		// CreateAuthzPolicy holds details about calls to the CreateAuthzPolicy method.
+		CreateAuthzPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// PolicyType is the policyType argument value.
+			PolicyType string
+			// Policy is the policy argument value.
+			Policy json.RawMessage
+		}
+		// CreateAuthzResource holds details about calls to the CreateAuthzResource method.
+		CreateAuthzResource []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Resource is the resource argument value.
+			Resource *AuthzResource
+		}
+		// CreateAuthzResourcePermission holds details about calls to the CreateAuthzResourcePermission method.
+		CreateAuthzResourcePermission []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Permission is the permission argument value.
+			Permission *AuthzPermission
+		}
+		// CreateAuthzScope holds details about calls to the CreateAuthzScope method.
+		CreateAuthzScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Scope is the scope argument value.
+			Scope *AuthzScope
+		}
+		// CreateAuthzScopePermission holds details about calls to the CreateAuthzScopePermission method.
+		CreateAuthzScopePermission []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Permission is the permission argument value.
+			Permission *AuthzPermission
+		}
+		// CreateChildGroup holds details about calls to the CreateChildGroup method.
+		CreateChildGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ParentGroupID is the parentGroupID argument value.
+			ParentGroupID string
+			// Name is the name argument value.
+			Name string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
 		// CreateClient holds details about calls to the CreateClient method.
 		CreateClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Client is the client argument value.
 			Client *v1alpha1.KeycloakAPIClient
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
+		// CreateClientRole holds details about calls to the CreateClientRole method.
+		CreateClientRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Role is the role argument value.
+			Role *v1alpha1.KeycloakUserRole
+		}
+		// CreateClientScope holds details about calls to the CreateClientScope method.
+		CreateClientScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Scope is the scope argument value.
+			Scope *ClientScope
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// CreateComponent holds details about calls to the CreateComponent method.
+		CreateComponent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Component is the component argument value.
+			Component *Component
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
 		// CreateFederatedIdentity holds details about calls to the CreateFederatedIdentity method.
 		CreateFederatedIdentity []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Fid is the fid argument value.
 			Fid v1alpha1.FederatedIdentity
 			// UserID is the userID argument value.
@@ -486,6 +1762,8 @@ type KeycloakInterfaceMock struct {
 		}
 		// CreateGroup holds details about calls to the CreateGroup method.
 		CreateGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Group is the group argument value.
 			Group string
 			// RealmName is the realmName argument value.
@@ -493,6 +1771,8 @@ type KeycloakInterfaceMock struct {
 		}
 		// CreateGroupClientRole holds details about calls to the CreateGroupClientRole method.
 		CreateGroupClientRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Role is the role argument value.
 			Role *v1alpha1.KeycloakUserRole
 			// RealmName is the realmName argument value.
@@ -504,6 +1784,8 @@ type KeycloakInterfaceMock struct {
 		}
 		// CreateGroupRealmRole holds details about calls to the CreateGroupRealmRole method.
 		CreateGroupRealmRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Role is the role argument value.
 			Role *v1alpha1.KeycloakUserRole
 			// RealmName is the realmName argument value.
@@ -513,18 +1795,57 @@ type KeycloakInterfaceMock struct {
 		}
 		// CreateIdentityProvider holds details about calls to the CreateIdentityProvider method.
 		CreateIdentityProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// IdentityProvider is the identityProvider argument value.
-			IdentityProvider *v1alpha1.KeycloakIdentityProvider
+			IdentityProvider *IdentityProvider
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
+		// CreateIdentityProviderMapper holds details about calls to the CreateIdentityProviderMapper method.
+		CreateIdentityProviderMapper []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Alias is the alias argument value.
+			Alias string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Mapper is the mapper argument value.
+			Mapper *IdentityProviderMapper
+		}
+		// CreateProtocolMapperForClient holds details about calls to the CreateProtocolMapperForClient method.
+		CreateProtocolMapperForClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Mapper is the mapper argument value.
+			Mapper *ProtocolMapper
+		}
+		// CreateProtocolMapperForClientScope holds details about calls to the CreateProtocolMapperForClientScope method.
+		CreateProtocolMapperForClientScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Mapper is the mapper argument value.
+			Mapper *ProtocolMapper
+		}
 		// CreateRealm holds details about calls to the CreateRealm method.
 		CreateRealm []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Realm is the realm argument value.
 			Realm *v1alpha1.KeycloakRealm
 		}
 		// CreateUser holds details about calls to the CreateUser method.
 		CreateUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// User is the user argument value.
 			User *v1alpha1.KeycloakAPIUser
 			// RealmName is the realmName argument value.
@@ -532,6 +1853,8 @@ type KeycloakInterfaceMock struct {
 		}
 		// CreateUserClientRole holds details about calls to the CreateUserClientRole method.
 		CreateUserClientRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Role is the role argument value.
 			Role *v1alpha1.KeycloakUserRole
 			// RealmName is the realmName argument value.
@@ -543,6 +1866,8 @@ type KeycloakInterfaceMock struct {
 		}
 		// CreateUserRealmRole holds details about calls to the CreateUserRealmRole method.
 		CreateUserRealmRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Role is the role argument value.
 			Role *v1alpha1.KeycloakUserRole
 			// RealmName is the realmName argument value.
@@ -552,2396 +1877,9925 @@ type KeycloakInterfaceMock struct {
 		}
 		// DeleteAuthenticatorConfig holds details about calls to the DeleteAuthenticatorConfig method.
 		DeleteAuthenticatorConfig []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// ConfigID is the configID argument value.
 			ConfigID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// DeleteClient holds details about calls to the DeleteClient method.
-		DeleteClient []struct {
+		// DeleteAuthzPermission holds details about calls to the DeleteAuthzPermission method.
+		DeleteAuthzPermission []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// ClientID is the clientID argument value.
 			ClientID string
+			// PermissionID is the permissionID argument value.
+			PermissionID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// DeleteIdentityProvider holds details about calls to the DeleteIdentityProvider method.
-		DeleteIdentityProvider []struct {
-			// Alias is the alias argument value.
-			Alias string
+		// DeleteAuthzPolicy holds details about calls to the DeleteAuthzPolicy method.
+		DeleteAuthzPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// PolicyID is the policyID argument value.
+			PolicyID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// DeleteRealm holds details about calls to the DeleteRealm method.
-		DeleteRealm []struct {
+		// DeleteAuthzResource holds details about calls to the DeleteAuthzResource method.
+		DeleteAuthzResource []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// ResourceID is the resourceID argument value.
+			ResourceID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// DeleteUser holds details about calls to the DeleteUser method.
-		DeleteUser []struct {
-			// UserID is the userID argument value.
-			UserID string
+		// DeleteAuthzScope holds details about calls to the DeleteAuthzScope method.
+		DeleteAuthzScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// ScopeID is the scopeID argument value.
+			ScopeID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// DeleteUserClientRole holds details about calls to the DeleteUserClientRole method.
-		DeleteUserClientRole []struct {
-			// Role is the role argument value.
-			Role *v1alpha1.KeycloakUserRole
+		// DeleteClient holds details about calls to the DeleteClient method.
+		DeleteClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
 			// RealmName is the realmName argument value.
 			RealmName string
+		}
+		// DeleteClientRole holds details about calls to the DeleteClientRole method.
+		DeleteClientRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// ClientID is the clientID argument value.
 			ClientID string
-			// UserID is the userID argument value.
-			UserID string
+			// RoleName is the roleName argument value.
+			RoleName string
+			// RealmName is the realmName argument value.
+			RealmName string
 		}
-		// DeleteUserFromGroup holds details about calls to the DeleteUserFromGroup method.
-		DeleteUserFromGroup []struct {
+		// DeleteClientScope holds details about calls to the DeleteClientScope method.
+		DeleteClientScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
 			// RealmName is the realmName argument value.
 			RealmName string
-			// UserID is the userID argument value.
-			UserID string
-			// GroupID is the groupID argument value.
-			GroupID string
 		}
-		// DeleteUserRealmRole holds details about calls to the DeleteUserRealmRole method.
-		DeleteUserRealmRole []struct {
-			// Role is the role argument value.
-			Role *v1alpha1.KeycloakUserRole
+		// DeleteClientScopeClientScopeMappings holds details about calls to the DeleteClientScopeClientScopeMappings method.
+		DeleteClientScopeClientScopeMappings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// TargetClientID is the targetClientID argument value.
+			TargetClientID string
 			// RealmName is the realmName argument value.
 			RealmName string
-			// UserID is the userID argument value.
-			UserID string
+			// Roles is the roles argument value.
+			Roles []*v1alpha1.KeycloakUserRole
 		}
-		// FindAuthenticationExecutionForFlow holds details about calls to the FindAuthenticationExecutionForFlow method.
-		FindAuthenticationExecutionForFlow []struct {
-			// FlowAlias is the flowAlias argument value.
-			FlowAlias string
+		// DeleteClientScopeRealmScopeMappings holds details about calls to the DeleteClientScopeRealmScopeMappings method.
+		DeleteClientScopeRealmScopeMappings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
 			// RealmName is the realmName argument value.
 			RealmName string
-			// Predicate is the predicate argument value.
-			Predicate func(*v1alpha1.AuthenticationExecutionInfo) bool
+			// Roles is the roles argument value.
+			Roles []*v1alpha1.KeycloakUserRole
 		}
-		// FindAvailableGroupClientRole holds details about calls to the FindAvailableGroupClientRole method.
-		FindAvailableGroupClientRole []struct {
+		// DeleteComponent holds details about calls to the DeleteComponent method.
+		DeleteComponent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ComponentID is the componentID argument value.
+			ComponentID string
 			// RealmName is the realmName argument value.
 			RealmName string
-			// ClientID is the clientID argument value.
-			ClientID string
+		}
+		// DeleteGroup holds details about calls to the DeleteGroup method.
+		DeleteGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// GroupID is the groupID argument value.
 			GroupID string
-			// Predicate is the predicate argument value.
-			Predicate func(*v1alpha1.KeycloakUserRole) bool
-		}
-		// FindGroupByName holds details about calls to the FindGroupByName method.
-		FindGroupByName []struct {
-			// GroupName is the groupName argument value.
-			GroupName string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// FindGroupClientRole holds details about calls to the FindGroupClientRole method.
-		FindGroupClientRole []struct {
+		// DeleteGroupClientRole holds details about calls to the DeleteGroupClientRole method.
+		DeleteGroupClientRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Role is the role argument value.
+			Role *v1alpha1.KeycloakUserRole
 			// RealmName is the realmName argument value.
 			RealmName string
 			// ClientID is the clientID argument value.
 			ClientID string
 			// GroupID is the groupID argument value.
 			GroupID string
-			// Predicate is the predicate argument value.
-			Predicate func(*v1alpha1.KeycloakUserRole) bool
 		}
-		// FindUserByEmail holds details about calls to the FindUserByEmail method.
-		FindUserByEmail []struct {
-			// Email is the email argument value.
-			Email string
-			// Realm is the realm argument value.
-			Realm string
-		}
-		// FindUserByUsername holds details about calls to the FindUserByUsername method.
-		FindUserByUsername []struct {
-			// Name is the name argument value.
-			Name string
-			// Realm is the realm argument value.
-			Realm string
-		}
-		// GetAuthenticatorConfig holds details about calls to the GetAuthenticatorConfig method.
-		GetAuthenticatorConfig []struct {
-			// ConfigID is the configID argument value.
-			ConfigID string
+		// DeleteIdentityProvider holds details about calls to the DeleteIdentityProvider method.
+		DeleteIdentityProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Alias is the alias argument value.
+			Alias string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// GetClient holds details about calls to the GetClient method.
-		GetClient []struct {
-			// ClientID is the clientID argument value.
-			ClientID string
+		// DeleteIdentityProviderMapper holds details about calls to the DeleteIdentityProviderMapper method.
+		DeleteIdentityProviderMapper []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Alias is the alias argument value.
+			Alias string
+			// MapperID is the mapperID argument value.
+			MapperID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// GetClientInstall holds details about calls to the GetClientInstall method.
-		GetClientInstall []struct {
+		// DeleteProtocolMapperForClient holds details about calls to the DeleteProtocolMapperForClient method.
+		DeleteProtocolMapperForClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// ClientID is the clientID argument value.
 			ClientID string
+			// MapperID is the mapperID argument value.
+			MapperID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// GetClientSecret holds details about calls to the GetClientSecret method.
-		GetClientSecret []struct {
-			// ClientID is the clientID argument value.
-			ClientID string
+		// DeleteProtocolMapperForClientScope holds details about calls to the DeleteProtocolMapperForClientScope method.
+		DeleteProtocolMapperForClientScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// MapperID is the mapperID argument value.
+			MapperID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// GetIdentityProvider holds details about calls to the GetIdentityProvider method.
-		GetIdentityProvider []struct {
-			// Alias is the alias argument value.
-			Alias string
+		// DeleteRealm holds details about calls to the DeleteRealm method.
+		DeleteRealm []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// GetRealm holds details about calls to the GetRealm method.
-		GetRealm []struct {
+		// DeleteRequiredAction holds details about calls to the DeleteRequiredAction method.
+		DeleteRequiredAction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Alias is the alias argument value.
+			Alias string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// GetUser holds details about calls to the GetUser method.
-		GetUser []struct {
+		// DeleteUser holds details about calls to the DeleteUser method.
+		DeleteUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// UserID is the userID argument value.
 			UserID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// GetUserFederatedIdentities holds details about calls to the GetUserFederatedIdentities method.
-		GetUserFederatedIdentities []struct {
-			// UserName is the userName argument value.
-			UserName string
+		// DeleteUserClientRole holds details about calls to the DeleteUserClientRole method.
+		DeleteUserClientRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Role is the role argument value.
+			Role *v1alpha1.KeycloakUserRole
 			// RealmName is the realmName argument value.
 			RealmName string
+			// ClientID is the clientID argument value.
+			ClientID string
+			// UserID is the userID argument value.
+			UserID string
 		}
-		// ListAuthenticationExecutionsForFlow holds details about calls to the ListAuthenticationExecutionsForFlow method.
-		ListAuthenticationExecutionsForFlow []struct {
-			// FlowAlias is the flowAlias argument value.
-			FlowAlias string
+		// DeleteUserCredential holds details about calls to the DeleteUserCredential method.
+		DeleteUserCredential []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// CredentialID is the credentialID argument value.
+			CredentialID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// ListAvailableGroupClientRoles holds details about calls to the ListAvailableGroupClientRoles method.
-		ListAvailableGroupClientRoles []struct {
+		// DeleteUserFromGroup holds details about calls to the DeleteUserFromGroup method.
+		DeleteUserFromGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// RealmName is the realmName argument value.
 			RealmName string
-			// ClientID is the clientID argument value.
-			ClientID string
+			// UserID is the userID argument value.
+			UserID string
 			// GroupID is the groupID argument value.
 			GroupID string
 		}
-		// ListAvailableGroupRealmRoles holds details about calls to the ListAvailableGroupRealmRoles method.
-		ListAvailableGroupRealmRoles []struct {
+		// DeleteUserRealmRole holds details about calls to the DeleteUserRealmRole method.
+		DeleteUserRealmRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Role is the role argument value.
+			Role *v1alpha1.KeycloakUserRole
 			// RealmName is the realmName argument value.
 			RealmName string
-			// GroupID is the groupID argument value.
-			GroupID string
+			// UserID is the userID argument value.
+			UserID string
 		}
-		// ListAvailableUserClientRoles holds details about calls to the ListAvailableUserClientRoles method.
-		ListAvailableUserClientRoles []struct {
+		// DeleteUserSession holds details about calls to the DeleteUserSession method.
+		DeleteUserSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SessionID is the sessionID argument value.
+			SessionID string
 			// RealmName is the realmName argument value.
 			RealmName string
+		}
+		// DownloadClientKeystore holds details about calls to the DownloadClientKeystore method.
+		DownloadClientKeystore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// ClientID is the clientID argument value.
 			ClientID string
-			// UserID is the userID argument value.
-			UserID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Attr is the attr argument value.
+			Attr string
+			// Config is the config argument value.
+			Config *KeyStoreConfig
 		}
-		// ListAvailableUserRealmRoles holds details about calls to the ListAvailableUserRealmRoles method.
-		ListAvailableUserRealmRoles []struct {
+		// EvaluateAuthzPermissions holds details about calls to the EvaluateAuthzPermissions method.
+		EvaluateAuthzPermissions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
 			// RealmName is the realmName argument value.
 			RealmName string
-			// UserID is the userID argument value.
-			UserID string
+			// Evaluation is the evaluation argument value.
+			Evaluation *PolicyEvaluationRequest
 		}
-		// ListClients holds details about calls to the ListClients method.
-		ListClients []struct {
+		// ExchangeToken holds details about calls to the ExchangeToken method.
+		ExchangeToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// RealmName is the realmName argument value.
 			RealmName string
+			// SubjectToken is the subjectToken argument value.
+			SubjectToken string
+			// Audience is the audience argument value.
+			Audience string
+			// ClientID is the clientID argument value.
+			ClientID string
+			// ClientSecret is the clientSecret argument value.
+			ClientSecret string
 		}
-		// ListDefaultGroups holds details about calls to the ListDefaultGroups method.
-		ListDefaultGroups []struct {
+		// FindAuthenticationExecutionForFlow holds details about calls to the FindAuthenticationExecutionForFlow method.
+		FindAuthenticationExecutionForFlow []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FlowAlias is the flowAlias argument value.
+			FlowAlias string
 			// RealmName is the realmName argument value.
 			RealmName string
+			// Predicate is the predicate argument value.
+			Predicate func(*v1alpha1.AuthenticationExecutionInfo) bool
 		}
-		// ListGroupClientRoles holds details about calls to the ListGroupClientRoles method.
-		ListGroupClientRoles []struct {
+		// FindAvailableGroupClientRole holds details about calls to the FindAvailableGroupClientRole method.
+		FindAvailableGroupClientRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// RealmName is the realmName argument value.
 			RealmName string
 			// ClientID is the clientID argument value.
 			ClientID string
 			// GroupID is the groupID argument value.
 			GroupID string
+			// Predicate is the predicate argument value.
+			Predicate func(*v1alpha1.KeycloakUserRole) bool
 		}
-		// ListGroupRealmRoles holds details about calls to the ListGroupRealmRoles method.
-		ListGroupRealmRoles []struct {
+		// FindClientByClientID holds details about calls to the FindClientByClientID method.
+		FindClientByClientID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
 			// RealmName is the realmName argument value.
 			RealmName string
-			// GroupID is the groupID argument value.
-			GroupID string
 		}
-		// ListIdentityProviders holds details about calls to the ListIdentityProviders method.
-		ListIdentityProviders []struct {
+		// FindClientScopeByName holds details about calls to the FindClientScopeByName method.
+		FindClientScopeByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// ListRealms holds details about calls to the ListRealms method.
-		ListRealms []struct {
+		// FindGroupByName holds details about calls to the FindGroupByName method.
+		FindGroupByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// RealmName is the realmName argument value.
+			RealmName string
 		}
-		// ListUserClientRoles holds details about calls to the ListUserClientRoles method.
-		ListUserClientRoles []struct {
+		// FindGroupByNameInHierarchy holds details about calls to the FindGroupByNameInHierarchy method.
+		FindGroupByNameInHierarchy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// FindGroupClientRole holds details about calls to the FindGroupClientRole method.
+		FindGroupClientRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// RealmName is the realmName argument value.
 			RealmName string
 			// ClientID is the clientID argument value.
 			ClientID string
-			// UserID is the userID argument value.
-			UserID string
+			// GroupID is the groupID argument value.
+			GroupID string
+			// Predicate is the predicate argument value.
+			Predicate func(*v1alpha1.KeycloakUserRole) bool
 		}
-		// ListUserRealmRoles holds details about calls to the ListUserRealmRoles method.
-		ListUserRealmRoles []struct {
+		// FindUserByEmail holds details about calls to the FindUserByEmail method.
+		FindUserByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Email is the email argument value.
+			Email string
+			// Realm is the realm argument value.
+			Realm string
+		}
+		// FindUserByUsername holds details about calls to the FindUserByUsername method.
+		FindUserByUsername []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// Realm is the realm argument value.
+			Realm string
+		}
+		// GenerateClientCertificate holds details about calls to the GenerateClientCertificate method.
+		GenerateClientCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Attr is the attr argument value.
+			Attr string
+		}
+		// GenerateExampleAccessToken holds details about calls to the GenerateExampleAccessToken method.
+		GenerateExampleAccessToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
 			// RealmName is the realmName argument value.
 			RealmName string
 			// UserID is the userID argument value.
 			UserID string
+			// Scope is the scope argument value.
+			Scope string
 		}
-		// ListUsers holds details about calls to the ListUsers method.
-		ListUsers []struct {
+		// GetAllGroupMembers holds details about calls to the GetAllGroupMembers method.
+		GetAllGroupMembers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupID is the groupID argument value.
+			GroupID string
 			// RealmName is the realmName argument value.
 			RealmName string
+			// BriefRepresentation is the briefRepresentation argument value.
+			BriefRepresentation bool
 		}
-		// ListUsersInGroup holds details about calls to the ListUsersInGroup method.
-		ListUsersInGroup []struct {
+		// GetAuthenticatorConfig holds details about calls to the GetAuthenticatorConfig method.
+		GetAuthenticatorConfig []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ConfigID is the configID argument value.
+			ConfigID string
 			// RealmName is the realmName argument value.
 			RealmName string
-			// GroupID is the groupID argument value.
-			GroupID string
 		}
-		// MakeGroupDefault holds details about calls to the MakeGroupDefault method.
-		MakeGroupDefault []struct {
-			// GroupID is the groupID argument value.
-			GroupID string
+		// GetAuthzPolicy holds details about calls to the GetAuthzPolicy method.
+		GetAuthzPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// PolicyID is the policyID argument value.
+			PolicyID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// Ping holds details about calls to the Ping method.
-		Ping []struct {
+		// GetAuthzResource holds details about calls to the GetAuthzResource method.
+		GetAuthzResource []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// RealmName is the realmName argument value.
+			RealmName string
 		}
-		// RemoveFederatedIdentity holds details about calls to the RemoveFederatedIdentity method.
-		RemoveFederatedIdentity []struct {
-			// Fid is the fid argument value.
-			Fid v1alpha1.FederatedIdentity
+		// GetBruteForceStatus holds details about calls to the GetBruteForceStatus method.
+		GetBruteForceStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// UserID is the userID argument value.
 			UserID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// SetGroupChild holds details about calls to the SetGroupChild method.
-		SetGroupChild []struct {
-			// GroupID is the groupID argument value.
-			GroupID string
+		// GetClient holds details about calls to the GetClient method.
+		GetClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
 			// RealmName is the realmName argument value.
 			RealmName string
-			// ChildGroup is the childGroup argument value.
-			ChildGroup *Group
 		}
-		// UpdateAuthenticationExecutionForFlow holds details about calls to the UpdateAuthenticationExecutionForFlow method.
-		UpdateAuthenticationExecutionForFlow []struct {
-			// FlowAlias is the flowAlias argument value.
-			FlowAlias string
+		// GetClientCertificate holds details about calls to the GetClientCertificate method.
+		GetClientCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
 			// RealmName is the realmName argument value.
 			RealmName string
-			// Execution is the execution argument value.
-			Execution *v1alpha1.AuthenticationExecutionInfo
+			// Attr is the attr argument value.
+			Attr string
 		}
-		// UpdateAuthenticatorConfig holds details about calls to the UpdateAuthenticatorConfig method.
-		UpdateAuthenticatorConfig []struct {
-			// AuthenticatorConfig is the authenticatorConfig argument value.
-			AuthenticatorConfig *v1alpha1.AuthenticatorConfig
+		// GetClientInstall holds details about calls to the GetClientInstall method.
+		GetClientInstall []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// UpdateClient holds details about calls to the UpdateClient method.
-		UpdateClient []struct {
-			// SpecClient is the specClient argument value.
-			SpecClient *v1alpha1.KeycloakAPIClient
+		// GetClientInstallationProvider holds details about calls to the GetClientInstallationProvider method.
+		GetClientInstallationProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
 			// RealmName is the realmName argument value.
 			RealmName string
+			// ProviderID is the providerID argument value.
+			ProviderID string
 		}
-		// UpdateIdentityProvider holds details about calls to the UpdateIdentityProvider method.
-		UpdateIdentityProvider []struct {
-			// SpecIdentityProvider is the specIdentityProvider argument value.
-			SpecIdentityProvider *v1alpha1.KeycloakIdentityProvider
+		// GetClientManagementPermissions holds details about calls to the GetClientManagementPermissions method.
+		GetClientManagementPermissions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
-		// UpdatePassword holds details about calls to the UpdatePassword method.
-		UpdatePassword []struct {
-			// User is the user argument value.
-			User *v1alpha1.KeycloakAPIUser
+		// GetClientRoleByName holds details about calls to the GetClientRoleByName method.
+		GetClientRoleByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RoleName is the roleName argument value.
+			RoleName string
 			// RealmName is the realmName argument value.
 			RealmName string
-			// NewPass is the newPass argument value.
-			NewPass string
-		}
-		// UpdateRealm holds details about calls to the UpdateRealm method.
-		UpdateRealm []struct {
-			// SpecRealm is the specRealm argument value.
-			SpecRealm *v1alpha1.KeycloakRealm
 		}
-		// UpdateUser holds details about calls to the UpdateUser method.
-		UpdateUser []struct {
-			// SpecUser is the specUser argument value.
-			SpecUser *v1alpha1.KeycloakAPIUser
+		// GetClientScope holds details about calls to the GetClientScope method.
+		GetClientScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
 			// RealmName is the realmName argument value.
 			RealmName string
 		}
+		// GetClientSecret holds details about calls to the GetClientSecret method.
+		GetClientSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// GetClientServiceAccountUser holds details about calls to the GetClientServiceAccountUser method.
+		GetClientServiceAccountUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// GetGroupByPath holds details about calls to the GetGroupByPath method.
+		GetGroupByPath []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Path is the path argument value.
+			Path string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// GetGroupHierarchy holds details about calls to the GetGroupHierarchy method.
+		GetGroupHierarchy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// GetGroupManagementPermissions holds details about calls to the GetGroupManagementPermissions method.
+		GetGroupManagementPermissions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupID is the groupID argument value.
+			GroupID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// GetGroupMembers holds details about calls to the GetGroupMembers method.
+		GetGroupMembers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupID is the groupID argument value.
+			GroupID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// First is the first argument value.
+			First int
+			// Max is the max argument value.
+			Max int
+		}
+		// GetIdentityProvider holds details about calls to the GetIdentityProvider method.
+		GetIdentityProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Alias is the alias argument value.
+			Alias string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// GetJWKS holds details about calls to the GetJWKS method.
+		GetJWKS []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// GetRealm holds details about calls to the GetRealm method.
+		GetRealm []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// GetRealmAttribute holds details about calls to the GetRealmAttribute method.
+		GetRealmAttribute []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Key is the key argument value.
+			Key string
+		}
+		// GetRealmEventsConfig holds details about calls to the GetRealmEventsConfig method.
+		GetRealmEventsConfig []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// GetRotatedClientSecret holds details about calls to the GetRotatedClientSecret method.
+		GetRotatedClientSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// GetServerInfo holds details about calls to the GetServerInfo method.
+		GetServerInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetUser holds details about calls to the GetUser method.
+		GetUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// GetUserByFederatedIdentity holds details about calls to the GetUserByFederatedIdentity method.
+		GetUserByFederatedIdentity []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// ProviderAlias is the providerAlias argument value.
+			ProviderAlias string
+			// ExternalUserID is the externalUserID argument value.
+			ExternalUserID string
+		}
+		// GetUserFederatedIdentities holds details about calls to the GetUserFederatedIdentities method.
+		GetUserFederatedIdentities []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserName is the userName argument value.
+			UserName string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// GetWellKnownConfiguration holds details about calls to the GetWellKnownConfiguration method.
+		GetWellKnownConfiguration []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// IntrospectToken holds details about calls to the IntrospectToken method.
+		IntrospectToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// ClientID is the clientID argument value.
+			ClientID string
+			// ClientSecret is the clientSecret argument value.
+			ClientSecret string
+			// Token is the token argument value.
+			Token string
+		}
+		// InvalidateRotatedClientSecret holds details about calls to the InvalidateRotatedClientSecret method.
+		InvalidateRotatedClientSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListAdminEvents holds details about calls to the ListAdminEvents method.
+		ListAdminEvents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Params is the params argument value.
+			Params *AdminEventListParams
+		}
+		// ListAuthenticationExecutionsForFlow holds details about calls to the ListAuthenticationExecutionsForFlow method.
+		ListAuthenticationExecutionsForFlow []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FlowAlias is the flowAlias argument value.
+			FlowAlias string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListAuthzPermissionPolicies holds details about calls to the ListAuthzPermissionPolicies method.
+		ListAuthzPermissionPolicies []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// PermissionID is the permissionID argument value.
+			PermissionID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListAuthzPermissionResources holds details about calls to the ListAuthzPermissionResources method.
+		ListAuthzPermissionResources []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// PermissionID is the permissionID argument value.
+			PermissionID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListAuthzPermissionScopes holds details about calls to the ListAuthzPermissionScopes method.
+		ListAuthzPermissionScopes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// PermissionID is the permissionID argument value.
+			PermissionID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListAuthzPermissions holds details about calls to the ListAuthzPermissions method.
+		ListAuthzPermissions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListAuthzPolicies holds details about calls to the ListAuthzPolicies method.
+		ListAuthzPolicies []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListAuthzResources holds details about calls to the ListAuthzResources method.
+		ListAuthzResources []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Params is the params argument value.
+			Params *AuthzResourceListParams
+		}
+		// ListAuthzScopes holds details about calls to the ListAuthzScopes method.
+		ListAuthzScopes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Params is the params argument value.
+			Params *AuthzScopeListParams
+		}
+		// ListAvailableClientScopeClientScopeMappings holds details about calls to the ListAvailableClientScopeClientScopeMappings method.
+		ListAvailableClientScopeClientScopeMappings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// TargetClientID is the targetClientID argument value.
+			TargetClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListAvailableClientScopeRealmScopeMappings holds details about calls to the ListAvailableClientScopeRealmScopeMappings method.
+		ListAvailableClientScopeRealmScopeMappings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListAvailableGroupClientRoles holds details about calls to the ListAvailableGroupClientRoles method.
+		ListAvailableGroupClientRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// ClientID is the clientID argument value.
+			ClientID string
+			// GroupID is the groupID argument value.
+			GroupID string
+		}
+		// ListAvailableGroupRealmRoles holds details about calls to the ListAvailableGroupRealmRoles method.
+		ListAvailableGroupRealmRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// GroupID is the groupID argument value.
+			GroupID string
+		}
+		// ListAvailableUserClientRoles holds details about calls to the ListAvailableUserClientRoles method.
+		ListAvailableUserClientRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// ClientID is the clientID argument value.
+			ClientID string
+			// UserID is the userID argument value.
+			UserID string
+		}
+		// ListAvailableUserRealmRoles holds details about calls to the ListAvailableUserRealmRoles method.
+		ListAvailableUserRealmRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// UserID is the userID argument value.
+			UserID string
+		}
+		// ListClientOfflineSessions holds details about calls to the ListClientOfflineSessions method.
+		ListClientOfflineSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// First is the first argument value.
+			First int
+			// Max is the max argument value.
+			Max int
+		}
+		// ListClientRoleClientComposites holds details about calls to the ListClientRoleClientComposites method.
+		ListClientRoleClientComposites []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RoleName is the roleName argument value.
+			RoleName string
+			// OtherClientID is the otherClientID argument value.
+			OtherClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListClientRoleComposites holds details about calls to the ListClientRoleComposites method.
+		ListClientRoleComposites []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RoleName is the roleName argument value.
+			RoleName string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListClientRoleRealmComposites holds details about calls to the ListClientRoleRealmComposites method.
+		ListClientRoleRealmComposites []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RoleName is the roleName argument value.
+			RoleName string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListClientRoles holds details about calls to the ListClientRoles method.
+		ListClientRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListClientScopeClientScopeMappings holds details about calls to the ListClientScopeClientScopeMappings method.
+		ListClientScopeClientScopeMappings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// TargetClientID is the targetClientID argument value.
+			TargetClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListClientScopeRealmScopeMappings holds details about calls to the ListClientScopeRealmScopeMappings method.
+		ListClientScopeRealmScopeMappings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListClientScopes holds details about calls to the ListClientScopes method.
+		ListClientScopes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListClientSessions holds details about calls to the ListClientSessions method.
+		ListClientSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListClientUserSessions holds details about calls to the ListClientUserSessions method.
+		ListClientUserSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// First is the first argument value.
+			First int
+			// Max is the max argument value.
+			Max int
+		}
+		// ListClients holds details about calls to the ListClients method.
+		ListClients []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListClientsWithParams holds details about calls to the ListClientsWithParams method.
+		ListClientsWithParams []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Params is the params argument value.
+			Params *ClientListParams
+		}
+		// ListComponents holds details about calls to the ListComponents method.
+		ListComponents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Params is the params argument value.
+			Params *ComponentListParams
+		}
+		// ListDefaultClientScopesForClient holds details about calls to the ListDefaultClientScopesForClient method.
+		ListDefaultClientScopesForClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListDefaultGroups holds details about calls to the ListDefaultGroups method.
+		ListDefaultGroups []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListEvaluatedProtocolMappers holds details about calls to the ListEvaluatedProtocolMappers method.
+		ListEvaluatedProtocolMappers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Scope is the scope argument value.
+			Scope string
+		}
+		// ListGroupClientRoles holds details about calls to the ListGroupClientRoles method.
+		ListGroupClientRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// ClientID is the clientID argument value.
+			ClientID string
+			// GroupID is the groupID argument value.
+			GroupID string
+		}
+		// ListGroupRealmRoles holds details about calls to the ListGroupRealmRoles method.
+		ListGroupRealmRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// GroupID is the groupID argument value.
+			GroupID string
+		}
+		// ListGroups holds details about calls to the ListGroups method.
+		ListGroups []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Search is the search argument value.
+			Search string
+			// First is the first argument value.
+			First int
+			// Max is the max argument value.
+			Max int
+		}
+		// ListIdentityProviderMappers holds details about calls to the ListIdentityProviderMappers method.
+		ListIdentityProviderMappers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Alias is the alias argument value.
+			Alias string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListIdentityProviders holds details about calls to the ListIdentityProviders method.
+		ListIdentityProviders []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListOfflineSessionsForUser holds details about calls to the ListOfflineSessionsForUser method.
+		ListOfflineSessionsForUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListOptionalClientScopesForClient holds details about calls to the ListOptionalClientScopesForClient method.
+		ListOptionalClientScopesForClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListProtocolMappersForClient holds details about calls to the ListProtocolMappersForClient method.
+		ListProtocolMappersForClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListProtocolMappersForClientScope holds details about calls to the ListProtocolMappersForClientScope method.
+		ListProtocolMappersForClientScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListRealmEvents holds details about calls to the ListRealmEvents method.
+		ListRealmEvents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Params is the params argument value.
+			Params *EventListParams
+		}
+		// ListRealmKeys holds details about calls to the ListRealmKeys method.
+		ListRealmKeys []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListRealms holds details about calls to the ListRealms method.
+		ListRealms []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListRequiredActions holds details about calls to the ListRequiredActions method.
+		ListRequiredActions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListUserClientRoles holds details about calls to the ListUserClientRoles method.
+		ListUserClientRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// ClientID is the clientID argument value.
+			ClientID string
+			// UserID is the userID argument value.
+			UserID string
+		}
+		// ListUserConsents holds details about calls to the ListUserConsents method.
+		ListUserConsents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListUserCredentials holds details about calls to the ListUserCredentials method.
+		ListUserCredentials []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListUserRealmRoles holds details about calls to the ListUserRealmRoles method.
+		ListUserRealmRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// UserID is the userID argument value.
+			UserID string
+		}
+		// ListUserSessions holds details about calls to the ListUserSessions method.
+		ListUserSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListUsers holds details about calls to the ListUsers method.
+		ListUsers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ListUsersInClientRole holds details about calls to the ListUsersInClientRole method.
+		ListUsersInClientRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RoleName is the roleName argument value.
+			RoleName string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// First is the first argument value.
+			First int
+			// Max is the max argument value.
+			Max int
+		}
+		// ListUsersInGroup holds details about calls to the ListUsersInGroup method.
+		ListUsersInGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// GroupID is the groupID argument value.
+			GroupID string
+		}
+		// MakeGroupDefault holds details about calls to the MakeGroupDefault method.
+		MakeGroupDefault []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupID is the groupID argument value.
+			GroupID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// MoveCredentialAfter holds details about calls to the MoveCredentialAfter method.
+		MoveCredentialAfter []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// CredentialID is the credentialID argument value.
+			CredentialID string
+			// NewPreviousCredentialID is the newPreviousCredentialID argument value.
+			NewPreviousCredentialID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// MoveCredentialToFirst holds details about calls to the MoveCredentialToFirst method.
+		MoveCredentialToFirst []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// CredentialID is the credentialID argument value.
+			CredentialID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// PatchRealm holds details about calls to the PatchRealm method.
+		PatchRealm []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Patch is the patch argument value.
+			Patch map[string]interface{}
+		}
+		// Ping holds details about calls to the Ping method.
+		Ping []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// PushClientRevocation holds details about calls to the PushClientRevocation method.
+		PushClientRevocation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// RefreshToken holds details about calls to the RefreshToken method.
+		RefreshToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// ClientID is the clientID argument value.
+			ClientID string
+			// ClientSecret is the clientSecret argument value.
+			ClientSecret string
+			// RefreshToken is the refreshToken argument value.
+			RefreshToken string
+		}
+		// RegenerateClientRegistrationToken holds details about calls to the RegenerateClientRegistrationToken method.
+		RegenerateClientRegistrationToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// RegenerateClientSecret holds details about calls to the RegenerateClientSecret method.
+		RegenerateClientSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// RegisterClientNode holds details about calls to the RegisterClientNode method.
+		RegisterClientNode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// NodeHost is the nodeHost argument value.
+			NodeHost string
+		}
+		// RegisterRequiredAction holds details about calls to the RegisterRequiredAction method.
+		RegisterRequiredAction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// ProviderID is the providerID argument value.
+			ProviderID string
+		}
+		// RemoveCompositesFromClientRole holds details about calls to the RemoveCompositesFromClientRole method.
+		RemoveCompositesFromClientRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RoleName is the roleName argument value.
+			RoleName string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Composites is the composites argument value.
+			Composites []*v1alpha1.KeycloakUserRole
+		}
+		// RemoveDefaultClientScopeFromClient holds details about calls to the RemoveDefaultClientScopeFromClient method.
+		RemoveDefaultClientScopeFromClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// RemoveFederatedIdentity holds details about calls to the RemoveFederatedIdentity method.
+		RemoveFederatedIdentity []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Fid is the fid argument value.
+			Fid v1alpha1.FederatedIdentity
+			// UserID is the userID argument value.
+			UserID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// RemoveOptionalClientScopeFromClient holds details about calls to the RemoveOptionalClientScopeFromClient method.
+		RemoveOptionalClientScopeFromClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// ResolveGroupPath holds details about calls to the ResolveGroupPath method.
+		ResolveGroupPath []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Path is the path argument value.
+			Path string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// CreateMissing is the createMissing argument value.
+			CreateMissing bool
+		}
+		// RevokeOfflineSession holds details about calls to the RevokeOfflineSession method.
+		RevokeOfflineSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// RevokeToken holds details about calls to the RevokeToken method.
+		RevokeToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// ClientID is the clientID argument value.
+			ClientID string
+			// ClientSecret is the clientSecret argument value.
+			ClientSecret string
+			// Token is the token argument value.
+			Token string
+			// TokenTypeHint is the tokenTypeHint argument value.
+			TokenTypeHint string
+		}
+		// RevokeUserConsent holds details about calls to the RevokeUserConsent method.
+		RevokeUserConsent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// SetGroupChild holds details about calls to the SetGroupChild method.
+		SetGroupChild []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupID is the groupID argument value.
+			GroupID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// ChildGroup is the childGroup argument value.
+			ChildGroup *Group
+		}
+		// SetRealmAttribute holds details about calls to the SetRealmAttribute method.
+		SetRealmAttribute []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Key is the key argument value.
+			Key string
+			// Value is the value argument value.
+			Value string
+		}
+		// SetTemporaryPassword holds details about calls to the SetTemporaryPassword method.
+		SetTemporaryPassword []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Password is the password argument value.
+			Password string
+		}
+		// TestClientNodesAvailable holds details about calls to the TestClientNodesAvailable method.
+		TestClientNodesAvailable []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// TriggerLDAPSync holds details about calls to the TriggerLDAPSync method.
+		TriggerLDAPSync []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ComponentID is the componentID argument value.
+			ComponentID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// SyncType is the syncType argument value.
+			SyncType LDAPSyncType
+		}
+		// UnlinkAllUsersFromIdP holds details about calls to the UnlinkAllUsersFromIdP method.
+		UnlinkAllUsersFromIdP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// ProviderAlias is the providerAlias argument value.
+			ProviderAlias string
+			// Concurrency is the concurrency argument value.
+			Concurrency int
+		}
+		// UnlinkUserFromIdP holds details about calls to the UnlinkUserFromIdP method.
+		UnlinkUserFromIdP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// ProviderAlias is the providerAlias argument value.
+			ProviderAlias string
+		}
+		// UnregisterClientNode holds details about calls to the UnregisterClientNode method.
+		UnregisterClientNode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// NodeHost is the nodeHost argument value.
+			NodeHost string
+		}
+		// UpdateAuthenticationExecutionForFlow holds details about calls to the UpdateAuthenticationExecutionForFlow method.
+		UpdateAuthenticationExecutionForFlow []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FlowAlias is the flowAlias argument value.
+			FlowAlias string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Execution is the execution argument value.
+			Execution *v1alpha1.AuthenticationExecutionInfo
+		}
+		// UpdateAuthenticatorConfig holds details about calls to the UpdateAuthenticatorConfig method.
+		UpdateAuthenticatorConfig []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AuthenticatorConfig is the authenticatorConfig argument value.
+			AuthenticatorConfig *v1alpha1.AuthenticatorConfig
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// UpdateAuthzPolicy holds details about calls to the UpdateAuthzPolicy method.
+		UpdateAuthzPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// PolicyType is the policyType argument value.
+			PolicyType string
+			// PolicyID is the policyID argument value.
+			PolicyID string
+			// Policy is the policy argument value.
+			Policy json.RawMessage
+		}
+		// UpdateAuthzResource holds details about calls to the UpdateAuthzResource method.
+		UpdateAuthzResource []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Resource is the resource argument value.
+			Resource *AuthzResource
+		}
+		// UpdateAuthzScope holds details about calls to the UpdateAuthzScope method.
+		UpdateAuthzScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Scope is the scope argument value.
+			Scope *AuthzScope
+		}
+		// UpdateClient holds details about calls to the UpdateClient method.
+		UpdateClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpecClient is the specClient argument value.
+			SpecClient *v1alpha1.KeycloakAPIClient
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// UpdateClientManagementPermissions holds details about calls to the UpdateClientManagementPermissions method.
+		UpdateClientManagementPermissions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Enabled is the enabled argument value.
+			Enabled bool
+		}
+		// UpdateClientRole holds details about calls to the UpdateClientRole method.
+		UpdateClientRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Role is the role argument value.
+			Role *v1alpha1.KeycloakUserRole
+		}
+		// UpdateClientScope holds details about calls to the UpdateClientScope method.
+		UpdateClientScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Scope is the scope argument value.
+			Scope *ClientScope
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// UpdateComponent holds details about calls to the UpdateComponent method.
+		UpdateComponent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Component is the component argument value.
+			Component *Component
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// UpdateCredentialLabel holds details about calls to the UpdateCredentialLabel method.
+		UpdateCredentialLabel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID string
+			// CredentialID is the credentialID argument value.
+			CredentialID string
+			// Label is the label argument value.
+			Label string
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// UpdateGroupManagementPermissions holds details about calls to the UpdateGroupManagementPermissions method.
+		UpdateGroupManagementPermissions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupID is the groupID argument value.
+			GroupID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Enabled is the enabled argument value.
+			Enabled bool
+		}
+		// UpdateIdentityProvider holds details about calls to the UpdateIdentityProvider method.
+		UpdateIdentityProvider []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpecIdentityProvider is the specIdentityProvider argument value.
+			SpecIdentityProvider *IdentityProvider
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// UpdateIdentityProviderMapper holds details about calls to the UpdateIdentityProviderMapper method.
+		UpdateIdentityProviderMapper []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Alias is the alias argument value.
+			Alias string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Mapper is the mapper argument value.
+			Mapper *IdentityProviderMapper
+		}
+		// UpdatePassword holds details about calls to the UpdatePassword method.
+		UpdatePassword []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// User is the user argument value.
+			User *v1alpha1.KeycloakAPIUser
+			// RealmName is the realmName argument value.
+			RealmName string
+			// NewPass is the newPass argument value.
+			NewPass string
+		}
+		// UpdateProtocolMapperForClient holds details about calls to the UpdateProtocolMapperForClient method.
+		UpdateProtocolMapperForClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Mapper is the mapper argument value.
+			Mapper *ProtocolMapper
+		}
+		// UpdateProtocolMapperForClientScope holds details about calls to the UpdateProtocolMapperForClientScope method.
+		UpdateProtocolMapperForClientScope []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ScopeID is the scopeID argument value.
+			ScopeID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Mapper is the mapper argument value.
+			Mapper *ProtocolMapper
+		}
+		// UpdateRealm holds details about calls to the UpdateRealm method.
+		UpdateRealm []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpecRealm is the specRealm argument value.
+			SpecRealm *v1alpha1.KeycloakRealm
+		}
+		// UpdateRealmEventsConfig holds details about calls to the UpdateRealmEventsConfig method.
+		UpdateRealmEventsConfig []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Config is the config argument value.
+			Config *RealmEventsConfig
+		}
+		// UpdateRequiredAction holds details about calls to the UpdateRequiredAction method.
+		UpdateRequiredAction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Alias is the alias argument value.
+			Alias string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Action is the action argument value.
+			Action *RequiredAction
+		}
+		// UpdateUser holds details about calls to the UpdateUser method.
+		UpdateUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpecUser is the specUser argument value.
+			SpecUser *v1alpha1.KeycloakAPIUser
+			// RealmName is the realmName argument value.
+			RealmName string
+		}
+		// UploadClientCertificate holds details about calls to the UploadClientCertificate method.
+		UploadClientCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClientID is the clientID argument value.
+			ClientID string
+			// RealmName is the realmName argument value.
+			RealmName string
+			// Attr is the attr argument value.
+			Attr string
+			// Config is the config argument value.
+			Config *KeyStoreConfig
+			// Data is the data argument value.
+			Data []byte
+		}
+	}
+}
+
+// AddClientScopeClientScopeMappings calls AddClientScopeClientScopeMappingsFunc.
+func (mock *KeycloakInterfaceMock) AddClientScopeClientScopeMappings(ctx context.Context, scopeID string, targetClientID string, realmName string, roles []*v1alpha1.KeycloakUserRole) error {
+	if mock.AddClientScopeClientScopeMappingsFunc == nil {
+		panic("KeycloakInterfaceMock.AddClientScopeClientScopeMappingsFunc: method is nil but KeycloakInterface.AddClientScopeClientScopeMappings was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		ScopeID        string
+		TargetClientID string
+		RealmName      string
+		Roles          []*v1alpha1.KeycloakUserRole
+	}{
+		Ctx:            ctx,
+		ScopeID:        scopeID,
+		TargetClientID: targetClientID,
+		RealmName:      realmName,
+		Roles:          roles,
+	}
+	lockKeycloakInterfaceMockAddClientScopeClientScopeMappings.Lock()
+	mock.calls.AddClientScopeClientScopeMappings = append(mock.calls.AddClientScopeClientScopeMappings, callInfo)
+	lockKeycloakInterfaceMockAddClientScopeClientScopeMappings.Unlock()
+	return mock.AddClientScopeClientScopeMappingsFunc(ctx, scopeID, targetClientID, realmName, roles)
+}
+
+// AddClientScopeClientScopeMappingsCalls gets all the calls that were made to AddClientScopeClientScopeMappings.
+// Check the length with:
+//     len(mockedKeycloakInterface.AddClientScopeClientScopeMappingsCalls())
+func (mock *KeycloakInterfaceMock) AddClientScopeClientScopeMappingsCalls() []struct {
+	Ctx            context.Context
+	ScopeID        string
+	TargetClientID string
+	RealmName      string
+	Roles          []*v1alpha1.KeycloakUserRole
+} {
+	var calls []struct {
+		Ctx            context.Context
+		ScopeID        string
+		TargetClientID string
+		RealmName      string
+		Roles          []*v1alpha1.KeycloakUserRole
+	}
+	lockKeycloakInterfaceMockAddClientScopeClientScopeMappings.RLock()
+	calls = mock.calls.AddClientScopeClientScopeMappings
+	lockKeycloakInterfaceMockAddClientScopeClientScopeMappings.RUnlock()
+	return calls
+}
+
+// AddClientScopeRealmScopeMappings calls AddClientScopeRealmScopeMappingsFunc.
+func (mock *KeycloakInterfaceMock) AddClientScopeRealmScopeMappings(ctx context.Context, scopeID string, realmName string, roles []*v1alpha1.KeycloakUserRole) error {
+	if mock.AddClientScopeRealmScopeMappingsFunc == nil {
+		panic("KeycloakInterfaceMock.AddClientScopeRealmScopeMappingsFunc: method is nil but KeycloakInterface.AddClientScopeRealmScopeMappings was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+		Roles     []*v1alpha1.KeycloakUserRole
+	}{
+		Ctx:       ctx,
+		ScopeID:   scopeID,
+		RealmName: realmName,
+		Roles:     roles,
+	}
+	lockKeycloakInterfaceMockAddClientScopeRealmScopeMappings.Lock()
+	mock.calls.AddClientScopeRealmScopeMappings = append(mock.calls.AddClientScopeRealmScopeMappings, callInfo)
+	lockKeycloakInterfaceMockAddClientScopeRealmScopeMappings.Unlock()
+	return mock.AddClientScopeRealmScopeMappingsFunc(ctx, scopeID, realmName, roles)
+}
+
+// AddClientScopeRealmScopeMappingsCalls gets all the calls that were made to AddClientScopeRealmScopeMappings.
+// Check the length with:
+//     len(mockedKeycloakInterface.AddClientScopeRealmScopeMappingsCalls())
+func (mock *KeycloakInterfaceMock) AddClientScopeRealmScopeMappingsCalls() []struct {
+	Ctx       context.Context
+	ScopeID   string
+	RealmName string
+	Roles     []*v1alpha1.KeycloakUserRole
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+		Roles     []*v1alpha1.KeycloakUserRole
+	}
+	lockKeycloakInterfaceMockAddClientScopeRealmScopeMappings.RLock()
+	calls = mock.calls.AddClientScopeRealmScopeMappings
+	lockKeycloakInterfaceMockAddClientScopeRealmScopeMappings.RUnlock()
+	return calls
+}
+
+// AddCompositesToClientRole calls AddCompositesToClientRoleFunc.
+func (mock *KeycloakInterfaceMock) AddCompositesToClientRole(ctx context.Context, clientID string, roleName string, realmName string, composites []*v1alpha1.KeycloakUserRole) error {
+	if mock.AddCompositesToClientRoleFunc == nil {
+		panic("KeycloakInterfaceMock.AddCompositesToClientRoleFunc: method is nil but KeycloakInterface.AddCompositesToClientRole was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ClientID   string
+		RoleName   string
+		RealmName  string
+		Composites []*v1alpha1.KeycloakUserRole
+	}{
+		Ctx:        ctx,
+		ClientID:   clientID,
+		RoleName:   roleName,
+		RealmName:  realmName,
+		Composites: composites,
+	}
+	lockKeycloakInterfaceMockAddCompositesToClientRole.Lock()
+	mock.calls.AddCompositesToClientRole = append(mock.calls.AddCompositesToClientRole, callInfo)
+	lockKeycloakInterfaceMockAddCompositesToClientRole.Unlock()
+	return mock.AddCompositesToClientRoleFunc(ctx, clientID, roleName, realmName, composites)
+}
+
+// AddCompositesToClientRoleCalls gets all the calls that were made to AddCompositesToClientRole.
+// Check the length with:
+//     len(mockedKeycloakInterface.AddCompositesToClientRoleCalls())
+func (mock *KeycloakInterfaceMock) AddCompositesToClientRoleCalls() []struct {
+	Ctx        context.Context
+	ClientID   string
+	RoleName   string
+	RealmName  string
+	Composites []*v1alpha1.KeycloakUserRole
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ClientID   string
+		RoleName   string
+		RealmName  string
+		Composites []*v1alpha1.KeycloakUserRole
+	}
+	lockKeycloakInterfaceMockAddCompositesToClientRole.RLock()
+	calls = mock.calls.AddCompositesToClientRole
+	lockKeycloakInterfaceMockAddCompositesToClientRole.RUnlock()
+	return calls
+}
+
+// AddUserToGroup calls AddUserToGroupFunc.
+func (mock *KeycloakInterfaceMock) AddUserToGroup(ctx context.Context, realmName string, userID string, groupID string) error {
+	if mock.AddUserToGroupFunc == nil {
+		panic("KeycloakInterfaceMock.AddUserToGroupFunc: method is nil but KeycloakInterface.AddUserToGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		UserID    string
+		GroupID   string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		UserID:    userID,
+		GroupID:   groupID,
+	}
+	lockKeycloakInterfaceMockAddUserToGroup.Lock()
+	mock.calls.AddUserToGroup = append(mock.calls.AddUserToGroup, callInfo)
+	lockKeycloakInterfaceMockAddUserToGroup.Unlock()
+	return mock.AddUserToGroupFunc(ctx, realmName, userID, groupID)
+}
+
+// AddUserToGroupCalls gets all the calls that were made to AddUserToGroup.
+// Check the length with:
+//     len(mockedKeycloakInterface.AddUserToGroupCalls())
+func (mock *KeycloakInterfaceMock) AddUserToGroupCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	UserID    string
+	GroupID   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		UserID    string
+		GroupID   string
+	}
+	lockKeycloakInterfaceMockAddUserToGroup.RLock()
+	calls = mock.calls.AddUserToGroup
+	lockKeycloakInterfaceMockAddUserToGroup.RUnlock()
+	return calls
+}
+
+// AssignDefaultClientScopeToClient calls AssignDefaultClientScopeToClientFunc.
+func (mock *KeycloakInterfaceMock) AssignDefaultClientScopeToClient(ctx context.Context, clientID string, scopeID string, realmName string) error {
+	if mock.AssignDefaultClientScopeToClientFunc == nil {
+		panic("KeycloakInterfaceMock.AssignDefaultClientScopeToClientFunc: method is nil but KeycloakInterface.AssignDefaultClientScopeToClient was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		ScopeID   string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		ScopeID:   scopeID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockAssignDefaultClientScopeToClient.Lock()
+	mock.calls.AssignDefaultClientScopeToClient = append(mock.calls.AssignDefaultClientScopeToClient, callInfo)
+	lockKeycloakInterfaceMockAssignDefaultClientScopeToClient.Unlock()
+	return mock.AssignDefaultClientScopeToClientFunc(ctx, clientID, scopeID, realmName)
+}
+
+// AssignDefaultClientScopeToClientCalls gets all the calls that were made to AssignDefaultClientScopeToClient.
+// Check the length with:
+//     len(mockedKeycloakInterface.AssignDefaultClientScopeToClientCalls())
+func (mock *KeycloakInterfaceMock) AssignDefaultClientScopeToClientCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	ScopeID   string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		ScopeID   string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockAssignDefaultClientScopeToClient.RLock()
+	calls = mock.calls.AssignDefaultClientScopeToClient
+	lockKeycloakInterfaceMockAssignDefaultClientScopeToClient.RUnlock()
+	return calls
+}
+
+// AssignOptionalClientScopeToClient calls AssignOptionalClientScopeToClientFunc.
+func (mock *KeycloakInterfaceMock) AssignOptionalClientScopeToClient(ctx context.Context, clientID string, scopeID string, realmName string) error {
+	if mock.AssignOptionalClientScopeToClientFunc == nil {
+		panic("KeycloakInterfaceMock.AssignOptionalClientScopeToClientFunc: method is nil but KeycloakInterface.AssignOptionalClientScopeToClient was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		ScopeID   string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		ScopeID:   scopeID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockAssignOptionalClientScopeToClient.Lock()
+	mock.calls.AssignOptionalClientScopeToClient = append(mock.calls.AssignOptionalClientScopeToClient, callInfo)
+	lockKeycloakInterfaceMockAssignOptionalClientScopeToClient.Unlock()
+	return mock.AssignOptionalClientScopeToClientFunc(ctx, clientID, scopeID, realmName)
+}
+
+// AssignOptionalClientScopeToClientCalls gets all the calls that were made to AssignOptionalClientScopeToClient.
+// Check the length with:
+//     len(mockedKeycloakInterface.AssignOptionalClientScopeToClientCalls())
+func (mock *KeycloakInterfaceMock) AssignOptionalClientScopeToClientCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	ScopeID   string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		ScopeID   string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockAssignOptionalClientScopeToClient.RLock()
+	calls = mock.calls.AssignOptionalClientScopeToClient
+	lockKeycloakInterfaceMockAssignOptionalClientScopeToClient.RUnlock()
+	return calls
+}
+
+// BulkCreateUsers calls BulkCreateUsersFunc.
+func (mock *KeycloakInterfaceMock) BulkCreateUsers(ctx context.Context, users []*v1alpha1.KeycloakAPIUser, realmName string, concurrency int) []error {
+	if mock.BulkCreateUsersFunc == nil {
+		panic("KeycloakInterfaceMock.BulkCreateUsersFunc: method is nil but KeycloakInterface.BulkCreateUsers was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Users       []*v1alpha1.KeycloakAPIUser
+		RealmName   string
+		Concurrency int
+	}{
+		Ctx:         ctx,
+		Users:       users,
+		RealmName:   realmName,
+		Concurrency: concurrency,
+	}
+	lockKeycloakInterfaceMockBulkCreateUsers.Lock()
+	mock.calls.BulkCreateUsers = append(mock.calls.BulkCreateUsers, callInfo)
+	lockKeycloakInterfaceMockBulkCreateUsers.Unlock()
+	return mock.BulkCreateUsersFunc(ctx, users, realmName, concurrency)
+}
+
+// BulkCreateUsersCalls gets all the calls that were made to BulkCreateUsers.
+// Check the length with:
+//     len(mockedKeycloakInterface.BulkCreateUsersCalls())
+func (mock *KeycloakInterfaceMock) BulkCreateUsersCalls() []struct {
+	Ctx         context.Context
+	Users       []*v1alpha1.KeycloakAPIUser
+	RealmName   string
+	Concurrency int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Users       []*v1alpha1.KeycloakAPIUser
+		RealmName   string
+		Concurrency int
+	}
+	lockKeycloakInterfaceMockBulkCreateUsers.RLock()
+	calls = mock.calls.BulkCreateUsers
+	lockKeycloakInterfaceMockBulkCreateUsers.RUnlock()
+	return calls
+}
+
+// ClearAdminEvents calls ClearAdminEventsFunc.
+func (mock *KeycloakInterfaceMock) ClearAdminEvents(ctx context.Context, realmName string) error {
+	if mock.ClearAdminEventsFunc == nil {
+		panic("KeycloakInterfaceMock.ClearAdminEventsFunc: method is nil but KeycloakInterface.ClearAdminEvents was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockClearAdminEvents.Lock()
+	mock.calls.ClearAdminEvents = append(mock.calls.ClearAdminEvents, callInfo)
+	lockKeycloakInterfaceMockClearAdminEvents.Unlock()
+	return mock.ClearAdminEventsFunc(ctx, realmName)
+}
+
+// ClearAdminEventsCalls gets all the calls that were made to ClearAdminEvents.
+// Check the length with:
+//     len(mockedKeycloakInterface.ClearAdminEventsCalls())
+func (mock *KeycloakInterfaceMock) ClearAdminEventsCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+	}
+	lockKeycloakInterfaceMockClearAdminEvents.RLock()
+	calls = mock.calls.ClearAdminEvents
+	lockKeycloakInterfaceMockClearAdminEvents.RUnlock()
+	return calls
+}
+
+// ClearBruteForceForUser calls ClearBruteForceForUserFunc.
+func (mock *KeycloakInterfaceMock) ClearBruteForceForUser(ctx context.Context, userID string, realmName string) error {
+	if mock.ClearBruteForceForUserFunc == nil {
+		panic("KeycloakInterfaceMock.ClearBruteForceForUserFunc: method is nil but KeycloakInterface.ClearBruteForceForUser was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		UserID    string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		UserID:    userID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockClearBruteForceForUser.Lock()
+	mock.calls.ClearBruteForceForUser = append(mock.calls.ClearBruteForceForUser, callInfo)
+	lockKeycloakInterfaceMockClearBruteForceForUser.Unlock()
+	return mock.ClearBruteForceForUserFunc(ctx, userID, realmName)
+}
+
+// ClearBruteForceForUserCalls gets all the calls that were made to ClearBruteForceForUser.
+// Check the length with:
+//     len(mockedKeycloakInterface.ClearBruteForceForUserCalls())
+func (mock *KeycloakInterfaceMock) ClearBruteForceForUserCalls() []struct {
+	Ctx       context.Context
+	UserID    string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		UserID    string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockClearBruteForceForUser.RLock()
+	calls = mock.calls.ClearBruteForceForUser
+	lockKeycloakInterfaceMockClearBruteForceForUser.RUnlock()
+	return calls
+}
+
+// ClearRealmEvents calls ClearRealmEventsFunc.
+func (mock *KeycloakInterfaceMock) ClearRealmEvents(ctx context.Context, realmName string) error {
+	if mock.ClearRealmEventsFunc == nil {
+		panic("KeycloakInterfaceMock.ClearRealmEventsFunc: method is nil but KeycloakInterface.ClearRealmEvents was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockClearRealmEvents.Lock()
+	mock.calls.ClearRealmEvents = append(mock.calls.ClearRealmEvents, callInfo)
+	lockKeycloakInterfaceMockClearRealmEvents.Unlock()
+	return mock.ClearRealmEventsFunc(ctx, realmName)
+}
+
+// ClearRealmEventsCalls gets all the calls that were made to ClearRealmEvents.
+// Check the length with:
+//     len(mockedKeycloakInterface.ClearRealmEventsCalls())
+func (mock *KeycloakInterfaceMock) ClearRealmEventsCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+	}
+	lockKeycloakInterfaceMockClearRealmEvents.RLock()
+	calls = mock.calls.ClearRealmEvents
+	lockKeycloakInterfaceMockClearRealmEvents.RUnlock()
+	return calls
+}
+
+// CopyClientScope calls CopyClientScopeFunc.
+func (mock *KeycloakInterfaceMock) CopyClientScope(ctx context.Context, sourceScopeID string, newName string, realmName string) (string, error) {
+	if mock.CopyClientScopeFunc == nil {
+		panic("KeycloakInterfaceMock.CopyClientScopeFunc: method is nil but KeycloakInterface.CopyClientScope was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		SourceScopeID string
+		NewName       string
+		RealmName     string
+	}{
+		Ctx:           ctx,
+		SourceScopeID: sourceScopeID,
+		NewName:       newName,
+		RealmName:     realmName,
+	}
+	lockKeycloakInterfaceMockCopyClientScope.Lock()
+	mock.calls.CopyClientScope = append(mock.calls.CopyClientScope, callInfo)
+	lockKeycloakInterfaceMockCopyClientScope.Unlock()
+	return mock.CopyClientScopeFunc(ctx, sourceScopeID, newName, realmName)
+}
+
+// CopyClientScopeCalls gets all the calls that were made to CopyClientScope.
+// Check the length with:
+//     len(mockedKeycloakInterface.CopyClientScopeCalls())
+func (mock *KeycloakInterfaceMock) CopyClientScopeCalls() []struct {
+	Ctx           context.Context
+	SourceScopeID string
+	NewName       string
+	RealmName     string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		SourceScopeID string
+		NewName       string
+		RealmName     string
+	}
+	lockKeycloakInterfaceMockCopyClientScope.RLock()
+	calls = mock.calls.CopyClientScope
+	lockKeycloakInterfaceMockCopyClientScope.RUnlock()
+	return calls
+}
+
+// CountClientOfflineSessions calls CountClientOfflineSessionsFunc.
+func (mock *KeycloakInterfaceMock) CountClientOfflineSessions(ctx context.Context, clientID string, realmName string) (int, error) {
+	if mock.CountClientOfflineSessionsFunc == nil {
+		panic("KeycloakInterfaceMock.CountClientOfflineSessionsFunc: method is nil but KeycloakInterface.CountClientOfflineSessions was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockCountClientOfflineSessions.Lock()
+	mock.calls.CountClientOfflineSessions = append(mock.calls.CountClientOfflineSessions, callInfo)
+	lockKeycloakInterfaceMockCountClientOfflineSessions.Unlock()
+	return mock.CountClientOfflineSessionsFunc(ctx, clientID, realmName)
+}
+
+// CountClientOfflineSessionsCalls gets all the calls that were made to CountClientOfflineSessions.
+// Check the length with:
+//     len(mockedKeycloakInterface.CountClientOfflineSessionsCalls())
+func (mock *KeycloakInterfaceMock) CountClientOfflineSessionsCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockCountClientOfflineSessions.RLock()
+	calls = mock.calls.CountClientOfflineSessions
+	lockKeycloakInterfaceMockCountClientOfflineSessions.RUnlock()
+	return calls
+}
+
+// CountClientSessions calls CountClientSessionsFunc.
+func (mock *KeycloakInterfaceMock) CountClientSessions(ctx context.Context, clientID string, realmName string) (int, error) {
+	if mock.CountClientSessionsFunc == nil {
+		panic("KeycloakInterfaceMock.CountClientSessionsFunc: method is nil but KeycloakInterface.CountClientSessions was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockCountClientSessions.Lock()
+	mock.calls.CountClientSessions = append(mock.calls.CountClientSessions, callInfo)
+	lockKeycloakInterfaceMockCountClientSessions.Unlock()
+	return mock.CountClientSessionsFunc(ctx, clientID, realmName)
+}
+
+// CountClientSessionsCalls gets all the calls that were made to CountClientSessions.
+// Check the length with:
+//     len(mockedKeycloakInterface.CountClientSessionsCalls())
+func (mock *KeycloakInterfaceMock) CountClientSessionsCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockCountClientSessions.RLock()
+	calls = mock.calls.CountClientSessions
+	lockKeycloakInterfaceMockCountClientSessions.RUnlock()
+	return calls
+}
+
+// CountGroups calls CountGroupsFunc.
+func (mock *KeycloakInterfaceMock) CountGroups(ctx context.Context, realmName string, search string, topLevelOnly bool) (int, error) {
+	if mock.CountGroupsFunc == nil {
+		panic("KeycloakInterfaceMock.CountGroupsFunc: method is nil but KeycloakInterface.CountGroups was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		RealmName    string
+		Search       string
+		TopLevelOnly bool
+	}{
+		Ctx:          ctx,
+		RealmName:    realmName,
+		Search:       search,
+		TopLevelOnly: topLevelOnly,
+	}
+	lockKeycloakInterfaceMockCountGroups.Lock()
+	mock.calls.CountGroups = append(mock.calls.CountGroups, callInfo)
+	lockKeycloakInterfaceMockCountGroups.Unlock()
+	return mock.CountGroupsFunc(ctx, realmName, search, topLevelOnly)
+}
+
+// CountGroupsCalls gets all the calls that were made to CountGroups.
+// Check the length with:
+//     len(mockedKeycloakInterface.CountGroupsCalls())
+func (mock *KeycloakInterfaceMock) CountGroupsCalls() []struct {
+	Ctx          context.Context
+	RealmName    string
+	Search       string
+	TopLevelOnly bool
+} {
+	var calls []struct {
+		Ctx          context.Context
+		RealmName    string
+		Search       string
+		TopLevelOnly bool
+	}
+	lockKeycloakInterfaceMockCountGroups.RLock()
+	calls = mock.calls.CountGroups
+	lockKeycloakInterfaceMockCountGroups.RUnlock()
+	return calls
+}
+
+// CreateAuthenticatorConfig calls CreateAuthenticatorConfigFunc.
+func (mock *KeycloakInterfaceMock) CreateAuthenticatorConfig(ctx context.Context, authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string, executionID string) (string, error) {
+	if mock.CreateAuthenticatorConfigFunc == nil {
+		panic("KeycloakInterfaceMock.CreateAuthenticatorConfigFunc: method is nil but KeycloakInterface.CreateAuthenticatorConfig was just called")
+	}
+	callInfo := struct {
+		Ctx                 context.Context
+		AuthenticatorConfig *v1alpha1.AuthenticatorConfig
+		RealmName           string
+		ExecutionID         string
+	}{
+		Ctx:                 ctx,
+		AuthenticatorConfig: authenticatorConfig,
+		RealmName:           realmName,
+		ExecutionID:         executionID,
+	}
+	lockKeycloakInterfaceMockCreateAuthenticatorConfig.Lock()
+	mock.calls.CreateAuthenticatorConfig = append(mock.calls.CreateAuthenticatorConfig, callInfo)
+	lockKeycloakInterfaceMockCreateAuthenticatorConfig.Unlock()
+	return mock.CreateAuthenticatorConfigFunc(ctx, authenticatorConfig, realmName, executionID)
+}
+
+// CreateAuthenticatorConfigCalls gets all the calls that were made to CreateAuthenticatorConfig.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateAuthenticatorConfigCalls())
+func (mock *KeycloakInterfaceMock) CreateAuthenticatorConfigCalls() []struct {
+	Ctx                 context.Context
+	AuthenticatorConfig *v1alpha1.AuthenticatorConfig
+	RealmName           string
+	ExecutionID         string
+} {
+	var calls []struct {
+		Ctx                 context.Context
+		AuthenticatorConfig *v1alpha1.AuthenticatorConfig
+		RealmName           string
+		ExecutionID         string
+	}
+	lockKeycloakInterfaceMockCreateAuthenticatorConfig.RLock()
+	calls = mock.calls.CreateAuthenticatorConfig
+	lockKeycloakInterfaceMockCreateAuthenticatorConfig.RUnlock()
+	return calls
+}
+
+// CreateAuthzPolicy calls CreateAuthzPolicyFunc.
+func (mock *KeycloakInterfaceMock) CreateAuthzPolicy(ctx context.Context, clientID string, realmName string, policyType string, policy json.RawMessage) (*AuthzPolicy, error) {
+	if mock.CreateAuthzPolicyFunc == nil {
+		panic("KeycloakInterfaceMock.CreateAuthzPolicyFunc: method is nil but KeycloakInterface.CreateAuthzPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ClientID   string
+		RealmName  string
+		PolicyType string
+		Policy     json.RawMessage
+	}{
+		Ctx:        ctx,
+		ClientID:   clientID,
+		RealmName:  realmName,
+		PolicyType: policyType,
+		Policy:     policy,
+	}
+	lockKeycloakInterfaceMockCreateAuthzPolicy.Lock()
+	mock.calls.CreateAuthzPolicy = append(mock.calls.CreateAuthzPolicy, callInfo)
+	lockKeycloakInterfaceMockCreateAuthzPolicy.Unlock()
+	return mock.CreateAuthzPolicyFunc(ctx, clientID, realmName, policyType, policy)
+}
+
+// CreateAuthzPolicyCalls gets all the calls that were made to CreateAuthzPolicy.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateAuthzPolicyCalls())
+func (mock *KeycloakInterfaceMock) CreateAuthzPolicyCalls() []struct {
+	Ctx        context.Context
+	ClientID   string
+	RealmName  string
+	PolicyType string
+	Policy     json.RawMessage
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ClientID   string
+		RealmName  string
+		PolicyType string
+		Policy     json.RawMessage
+	}
+	lockKeycloakInterfaceMockCreateAuthzPolicy.RLock()
+	calls = mock.calls.CreateAuthzPolicy
+	lockKeycloakInterfaceMockCreateAuthzPolicy.RUnlock()
+	return calls
+}
+
+// CreateAuthzResource calls CreateAuthzResourceFunc.
+func (mock *KeycloakInterfaceMock) CreateAuthzResource(ctx context.Context, clientID string, realmName string, resource *AuthzResource) (string, error) {
+	if mock.CreateAuthzResourceFunc == nil {
+		panic("KeycloakInterfaceMock.CreateAuthzResourceFunc: method is nil but KeycloakInterface.CreateAuthzResource was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Resource  *AuthzResource
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Resource:  resource,
+	}
+	lockKeycloakInterfaceMockCreateAuthzResource.Lock()
+	mock.calls.CreateAuthzResource = append(mock.calls.CreateAuthzResource, callInfo)
+	lockKeycloakInterfaceMockCreateAuthzResource.Unlock()
+	return mock.CreateAuthzResourceFunc(ctx, clientID, realmName, resource)
+}
+
+// CreateAuthzResourceCalls gets all the calls that were made to CreateAuthzResource.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateAuthzResourceCalls())
+func (mock *KeycloakInterfaceMock) CreateAuthzResourceCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Resource  *AuthzResource
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Resource  *AuthzResource
+	}
+	lockKeycloakInterfaceMockCreateAuthzResource.RLock()
+	calls = mock.calls.CreateAuthzResource
+	lockKeycloakInterfaceMockCreateAuthzResource.RUnlock()
+	return calls
+}
+
+// CreateAuthzResourcePermission calls CreateAuthzResourcePermissionFunc.
+func (mock *KeycloakInterfaceMock) CreateAuthzResourcePermission(ctx context.Context, clientID string, realmName string, permission *AuthzPermission) (string, error) {
+	if mock.CreateAuthzResourcePermissionFunc == nil {
+		panic("KeycloakInterfaceMock.CreateAuthzResourcePermissionFunc: method is nil but KeycloakInterface.CreateAuthzResourcePermission was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ClientID   string
+		RealmName  string
+		Permission *AuthzPermission
+	}{
+		Ctx:        ctx,
+		ClientID:   clientID,
+		RealmName:  realmName,
+		Permission: permission,
+	}
+	lockKeycloakInterfaceMockCreateAuthzResourcePermission.Lock()
+	mock.calls.CreateAuthzResourcePermission = append(mock.calls.CreateAuthzResourcePermission, callInfo)
+	lockKeycloakInterfaceMockCreateAuthzResourcePermission.Unlock()
+	return mock.CreateAuthzResourcePermissionFunc(ctx, clientID, realmName, permission)
+}
+
+// CreateAuthzResourcePermissionCalls gets all the calls that were made to CreateAuthzResourcePermission.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateAuthzResourcePermissionCalls())
+func (mock *KeycloakInterfaceMock) CreateAuthzResourcePermissionCalls() []struct {
+	Ctx        context.Context
+	ClientID   string
+	RealmName  string
+	Permission *AuthzPermission
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ClientID   string
+		RealmName  string
+		Permission *AuthzPermission
+	}
+	lockKeycloakInterfaceMockCreateAuthzResourcePermission.RLock()
+	calls = mock.calls.CreateAuthzResourcePermission
+	lockKeycloakInterfaceMockCreateAuthzResourcePermission.RUnlock()
+	return calls
+}
+
+// CreateAuthzScope calls CreateAuthzScopeFunc.
+func (mock *KeycloakInterfaceMock) CreateAuthzScope(ctx context.Context, clientID string, realmName string, scope *AuthzScope) (string, error) {
+	if mock.CreateAuthzScopeFunc == nil {
+		panic("KeycloakInterfaceMock.CreateAuthzScopeFunc: method is nil but KeycloakInterface.CreateAuthzScope was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Scope     *AuthzScope
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Scope:     scope,
+	}
+	lockKeycloakInterfaceMockCreateAuthzScope.Lock()
+	mock.calls.CreateAuthzScope = append(mock.calls.CreateAuthzScope, callInfo)
+	lockKeycloakInterfaceMockCreateAuthzScope.Unlock()
+	return mock.CreateAuthzScopeFunc(ctx, clientID, realmName, scope)
+}
+
+// CreateAuthzScopeCalls gets all the calls that were made to CreateAuthzScope.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateAuthzScopeCalls())
+func (mock *KeycloakInterfaceMock) CreateAuthzScopeCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Scope     *AuthzScope
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Scope     *AuthzScope
+	}
+	lockKeycloakInterfaceMockCreateAuthzScope.RLock()
+	calls = mock.calls.CreateAuthzScope
+	lockKeycloakInterfaceMockCreateAuthzScope.RUnlock()
+	return calls
+}
+
+// CreateAuthzScopePermission calls CreateAuthzScopePermissionFunc.
+func (mock *KeycloakInterfaceMock) CreateAuthzScopePermission(ctx context.Context, clientID string, realmName string, permission *AuthzPermission) (string, error) {
+	if mock.CreateAuthzScopePermissionFunc == nil {
+		panic("KeycloakInterfaceMock.CreateAuthzScopePermissionFunc: method is nil but KeycloakInterface.CreateAuthzScopePermission was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ClientID   string
+		RealmName  string
+		Permission *AuthzPermission
+	}{
+		Ctx:        ctx,
+		ClientID:   clientID,
+		RealmName:  realmName,
+		Permission: permission,
+	}
+	lockKeycloakInterfaceMockCreateAuthzScopePermission.Lock()
+	mock.calls.CreateAuthzScopePermission = append(mock.calls.CreateAuthzScopePermission, callInfo)
+	lockKeycloakInterfaceMockCreateAuthzScopePermission.Unlock()
+	return mock.CreateAuthzScopePermissionFunc(ctx, clientID, realmName, permission)
+}
+
+// CreateAuthzScopePermissionCalls gets all the calls that were made to CreateAuthzScopePermission.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateAuthzScopePermissionCalls())
+func (mock *KeycloakInterfaceMock) CreateAuthzScopePermissionCalls() []struct {
+	Ctx        context.Context
+	ClientID   string
+	RealmName  string
+	Permission *AuthzPermission
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ClientID   string
+		RealmName  string
+		Permission *AuthzPermission
+	}
+	lockKeycloakInterfaceMockCreateAuthzScopePermission.RLock()
+	calls = mock.calls.CreateAuthzScopePermission
+	lockKeycloakInterfaceMockCreateAuthzScopePermission.RUnlock()
+	return calls
+}
+
+// CreateChildGroup calls CreateChildGroupFunc.
+func (mock *KeycloakInterfaceMock) CreateChildGroup(ctx context.Context, parentGroupID string, name string, realmName string) (string, error) {
+	if mock.CreateChildGroupFunc == nil {
+		panic("KeycloakInterfaceMock.CreateChildGroupFunc: method is nil but KeycloakInterface.CreateChildGroup was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ParentGroupID string
+		Name          string
+		RealmName     string
+	}{
+		Ctx:           ctx,
+		ParentGroupID: parentGroupID,
+		Name:          name,
+		RealmName:     realmName,
+	}
+	lockKeycloakInterfaceMockCreateChildGroup.Lock()
+	mock.calls.CreateChildGroup = append(mock.calls.CreateChildGroup, callInfo)
+	lockKeycloakInterfaceMockCreateChildGroup.Unlock()
+	return mock.CreateChildGroupFunc(ctx, parentGroupID, name, realmName)
+}
+
+// CreateChildGroupCalls gets all the calls that were made to CreateChildGroup.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateChildGroupCalls())
+func (mock *KeycloakInterfaceMock) CreateChildGroupCalls() []struct {
+	Ctx           context.Context
+	ParentGroupID string
+	Name          string
+	RealmName     string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ParentGroupID string
+		Name          string
+		RealmName     string
+	}
+	lockKeycloakInterfaceMockCreateChildGroup.RLock()
+	calls = mock.calls.CreateChildGroup
+	lockKeycloakInterfaceMockCreateChildGroup.RUnlock()
+	return calls
+}
+
+// CreateClient calls CreateClientFunc.
+func (mock *KeycloakInterfaceMock) CreateClient(ctx context.Context, client *v1alpha1.KeycloakAPIClient, realmName string) (string, error) {
+	if mock.CreateClientFunc == nil {
+		panic("KeycloakInterfaceMock.CreateClientFunc: method is nil but KeycloakInterface.CreateClient was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Client    *v1alpha1.KeycloakAPIClient
+		RealmName string
+	}{
+		Ctx:       ctx,
+		Client:    client,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockCreateClient.Lock()
+	mock.calls.CreateClient = append(mock.calls.CreateClient, callInfo)
+	lockKeycloakInterfaceMockCreateClient.Unlock()
+	return mock.CreateClientFunc(ctx, client, realmName)
+}
+
+// CreateClientCalls gets all the calls that were made to CreateClient.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateClientCalls())
+func (mock *KeycloakInterfaceMock) CreateClientCalls() []struct {
+	Ctx       context.Context
+	Client    *v1alpha1.KeycloakAPIClient
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Client    *v1alpha1.KeycloakAPIClient
+		RealmName string
+	}
+	lockKeycloakInterfaceMockCreateClient.RLock()
+	calls = mock.calls.CreateClient
+	lockKeycloakInterfaceMockCreateClient.RUnlock()
+	return calls
+}
+
+// CreateClientRole calls CreateClientRoleFunc.
+func (mock *KeycloakInterfaceMock) CreateClientRole(ctx context.Context, clientID string, realmName string, role *v1alpha1.KeycloakUserRole) error {
+	if mock.CreateClientRoleFunc == nil {
+		panic("KeycloakInterfaceMock.CreateClientRoleFunc: method is nil but KeycloakInterface.CreateClientRole was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Role      *v1alpha1.KeycloakUserRole
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Role:      role,
+	}
+	lockKeycloakInterfaceMockCreateClientRole.Lock()
+	mock.calls.CreateClientRole = append(mock.calls.CreateClientRole, callInfo)
+	lockKeycloakInterfaceMockCreateClientRole.Unlock()
+	return mock.CreateClientRoleFunc(ctx, clientID, realmName, role)
+}
+
+// CreateClientRoleCalls gets all the calls that were made to CreateClientRole.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateClientRoleCalls())
+func (mock *KeycloakInterfaceMock) CreateClientRoleCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Role      *v1alpha1.KeycloakUserRole
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Role      *v1alpha1.KeycloakUserRole
+	}
+	lockKeycloakInterfaceMockCreateClientRole.RLock()
+	calls = mock.calls.CreateClientRole
+	lockKeycloakInterfaceMockCreateClientRole.RUnlock()
+	return calls
+}
+
+// CreateClientScope calls CreateClientScopeFunc.
+func (mock *KeycloakInterfaceMock) CreateClientScope(ctx context.Context, scope *ClientScope, realmName string) error {
+	if mock.CreateClientScopeFunc == nil {
+		panic("KeycloakInterfaceMock.CreateClientScopeFunc: method is nil but KeycloakInterface.CreateClientScope was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Scope     *ClientScope
+		RealmName string
+	}{
+		Ctx:       ctx,
+		Scope:     scope,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockCreateClientScope.Lock()
+	mock.calls.CreateClientScope = append(mock.calls.CreateClientScope, callInfo)
+	lockKeycloakInterfaceMockCreateClientScope.Unlock()
+	return mock.CreateClientScopeFunc(ctx, scope, realmName)
+}
+
+// CreateClientScopeCalls gets all the calls that were made to CreateClientScope.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateClientScopeCalls())
+func (mock *KeycloakInterfaceMock) CreateClientScopeCalls() []struct {
+	Ctx       context.Context
+	Scope     *ClientScope
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Scope     *ClientScope
+		RealmName string
+	}
+	lockKeycloakInterfaceMockCreateClientScope.RLock()
+	calls = mock.calls.CreateClientScope
+	lockKeycloakInterfaceMockCreateClientScope.RUnlock()
+	return calls
+}
+
+// CreateComponent calls CreateComponentFunc.
+func (mock *KeycloakInterfaceMock) CreateComponent(ctx context.Context, component *Component, realmName string) error {
+	if mock.CreateComponentFunc == nil {
+		panic("KeycloakInterfaceMock.CreateComponentFunc: method is nil but KeycloakInterface.CreateComponent was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Component *Component
+		RealmName string
+	}{
+		Ctx:       ctx,
+		Component: component,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockCreateComponent.Lock()
+	mock.calls.CreateComponent = append(mock.calls.CreateComponent, callInfo)
+	lockKeycloakInterfaceMockCreateComponent.Unlock()
+	return mock.CreateComponentFunc(ctx, component, realmName)
+}
+
+// CreateComponentCalls gets all the calls that were made to CreateComponent.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateComponentCalls())
+func (mock *KeycloakInterfaceMock) CreateComponentCalls() []struct {
+	Ctx       context.Context
+	Component *Component
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Component *Component
+		RealmName string
+	}
+	lockKeycloakInterfaceMockCreateComponent.RLock()
+	calls = mock.calls.CreateComponent
+	lockKeycloakInterfaceMockCreateComponent.RUnlock()
+	return calls
+}
+
+// CreateFederatedIdentity calls CreateFederatedIdentityFunc.
+func (mock *KeycloakInterfaceMock) CreateFederatedIdentity(ctx context.Context, fid v1alpha1.FederatedIdentity, userID string, realmName string) (string, error) {
+	if mock.CreateFederatedIdentityFunc == nil {
+		panic("KeycloakInterfaceMock.CreateFederatedIdentityFunc: method is nil but KeycloakInterface.CreateFederatedIdentity was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Fid       v1alpha1.FederatedIdentity
+		UserID    string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		Fid:       fid,
+		UserID:    userID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockCreateFederatedIdentity.Lock()
+	mock.calls.CreateFederatedIdentity = append(mock.calls.CreateFederatedIdentity, callInfo)
+	lockKeycloakInterfaceMockCreateFederatedIdentity.Unlock()
+	return mock.CreateFederatedIdentityFunc(ctx, fid, userID, realmName)
+}
+
+// CreateFederatedIdentityCalls gets all the calls that were made to CreateFederatedIdentity.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateFederatedIdentityCalls())
+func (mock *KeycloakInterfaceMock) CreateFederatedIdentityCalls() []struct {
+	Ctx       context.Context
+	Fid       v1alpha1.FederatedIdentity
+	UserID    string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Fid       v1alpha1.FederatedIdentity
+		UserID    string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockCreateFederatedIdentity.RLock()
+	calls = mock.calls.CreateFederatedIdentity
+	lockKeycloakInterfaceMockCreateFederatedIdentity.RUnlock()
+	return calls
+}
+
+// CreateGroup calls CreateGroupFunc.
+func (mock *KeycloakInterfaceMock) CreateGroup(ctx context.Context, group string, realmName string) (string, error) {
+	if mock.CreateGroupFunc == nil {
+		panic("KeycloakInterfaceMock.CreateGroupFunc: method is nil but KeycloakInterface.CreateGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Group     string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		Group:     group,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockCreateGroup.Lock()
+	mock.calls.CreateGroup = append(mock.calls.CreateGroup, callInfo)
+	lockKeycloakInterfaceMockCreateGroup.Unlock()
+	return mock.CreateGroupFunc(ctx, group, realmName)
+}
+
+// CreateGroupCalls gets all the calls that were made to CreateGroup.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateGroupCalls())
+func (mock *KeycloakInterfaceMock) CreateGroupCalls() []struct {
+	Ctx       context.Context
+	Group     string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Group     string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockCreateGroup.RLock()
+	calls = mock.calls.CreateGroup
+	lockKeycloakInterfaceMockCreateGroup.RUnlock()
+	return calls
+}
+
+// CreateGroupClientRole calls CreateGroupClientRoleFunc.
+func (mock *KeycloakInterfaceMock) CreateGroupClientRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, clientID string, groupID string) (string, error) {
+	if mock.CreateGroupClientRoleFunc == nil {
+		panic("KeycloakInterfaceMock.CreateGroupClientRoleFunc: method is nil but KeycloakInterface.CreateGroupClientRole was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		ClientID  string
+		GroupID   string
+	}{
+		Ctx:       ctx,
+		Role:      role,
+		RealmName: realmName,
+		ClientID:  clientID,
+		GroupID:   groupID,
+	}
+	lockKeycloakInterfaceMockCreateGroupClientRole.Lock()
+	mock.calls.CreateGroupClientRole = append(mock.calls.CreateGroupClientRole, callInfo)
+	lockKeycloakInterfaceMockCreateGroupClientRole.Unlock()
+	return mock.CreateGroupClientRoleFunc(ctx, role, realmName, clientID, groupID)
+}
+
+// CreateGroupClientRoleCalls gets all the calls that were made to CreateGroupClientRole.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateGroupClientRoleCalls())
+func (mock *KeycloakInterfaceMock) CreateGroupClientRoleCalls() []struct {
+	Ctx       context.Context
+	Role      *v1alpha1.KeycloakUserRole
+	RealmName string
+	ClientID  string
+	GroupID   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		ClientID  string
+		GroupID   string
+	}
+	lockKeycloakInterfaceMockCreateGroupClientRole.RLock()
+	calls = mock.calls.CreateGroupClientRole
+	lockKeycloakInterfaceMockCreateGroupClientRole.RUnlock()
+	return calls
+}
+
+// CreateGroupRealmRole calls CreateGroupRealmRoleFunc.
+func (mock *KeycloakInterfaceMock) CreateGroupRealmRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, groupID string) (string, error) {
+	if mock.CreateGroupRealmRoleFunc == nil {
+		panic("KeycloakInterfaceMock.CreateGroupRealmRoleFunc: method is nil but KeycloakInterface.CreateGroupRealmRole was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		GroupID   string
+	}{
+		Ctx:       ctx,
+		Role:      role,
+		RealmName: realmName,
+		GroupID:   groupID,
+	}
+	lockKeycloakInterfaceMockCreateGroupRealmRole.Lock()
+	mock.calls.CreateGroupRealmRole = append(mock.calls.CreateGroupRealmRole, callInfo)
+	lockKeycloakInterfaceMockCreateGroupRealmRole.Unlock()
+	return mock.CreateGroupRealmRoleFunc(ctx, role, realmName, groupID)
+}
+
+// CreateGroupRealmRoleCalls gets all the calls that were made to CreateGroupRealmRole.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateGroupRealmRoleCalls())
+func (mock *KeycloakInterfaceMock) CreateGroupRealmRoleCalls() []struct {
+	Ctx       context.Context
+	Role      *v1alpha1.KeycloakUserRole
+	RealmName string
+	GroupID   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		GroupID   string
+	}
+	lockKeycloakInterfaceMockCreateGroupRealmRole.RLock()
+	calls = mock.calls.CreateGroupRealmRole
+	lockKeycloakInterfaceMockCreateGroupRealmRole.RUnlock()
+	return calls
+}
+
+// CreateIdentityProvider calls CreateIdentityProviderFunc.
+func (mock *KeycloakInterfaceMock) CreateIdentityProvider(ctx context.Context, identityProvider *IdentityProvider, realmName string) error {
+	if mock.CreateIdentityProviderFunc == nil {
+		panic("KeycloakInterfaceMock.CreateIdentityProviderFunc: method is nil but KeycloakInterface.CreateIdentityProvider was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		IdentityProvider *IdentityProvider
+		RealmName        string
+	}{
+		Ctx:              ctx,
+		IdentityProvider: identityProvider,
+		RealmName:        realmName,
+	}
+	lockKeycloakInterfaceMockCreateIdentityProvider.Lock()
+	mock.calls.CreateIdentityProvider = append(mock.calls.CreateIdentityProvider, callInfo)
+	lockKeycloakInterfaceMockCreateIdentityProvider.Unlock()
+	return mock.CreateIdentityProviderFunc(ctx, identityProvider, realmName)
+}
+
+// CreateIdentityProviderCalls gets all the calls that were made to CreateIdentityProvider.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateIdentityProviderCalls())
+func (mock *KeycloakInterfaceMock) CreateIdentityProviderCalls() []struct {
+	Ctx              context.Context
+	IdentityProvider *IdentityProvider
+	RealmName        string
+} {
+	var calls []struct {
+		Ctx              context.Context
+		IdentityProvider *IdentityProvider
+		RealmName        string
+	}
+	lockKeycloakInterfaceMockCreateIdentityProvider.RLock()
+	calls = mock.calls.CreateIdentityProvider
+	lockKeycloakInterfaceMockCreateIdentityProvider.RUnlock()
+	return calls
+}
+
+// CreateIdentityProviderMapper calls CreateIdentityProviderMapperFunc.
+func (mock *KeycloakInterfaceMock) CreateIdentityProviderMapper(ctx context.Context, alias string, realmName string, mapper *IdentityProviderMapper) error {
+	if mock.CreateIdentityProviderMapperFunc == nil {
+		panic("KeycloakInterfaceMock.CreateIdentityProviderMapperFunc: method is nil but KeycloakInterface.CreateIdentityProviderMapper was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Alias     string
+		RealmName string
+		Mapper    *IdentityProviderMapper
+	}{
+		Ctx:       ctx,
+		Alias:     alias,
+		RealmName: realmName,
+		Mapper:    mapper,
+	}
+	lockKeycloakInterfaceMockCreateIdentityProviderMapper.Lock()
+	mock.calls.CreateIdentityProviderMapper = append(mock.calls.CreateIdentityProviderMapper, callInfo)
+	lockKeycloakInterfaceMockCreateIdentityProviderMapper.Unlock()
+	return mock.CreateIdentityProviderMapperFunc(ctx, alias, realmName, mapper)
+}
+
+// CreateIdentityProviderMapperCalls gets all the calls that were made to CreateIdentityProviderMapper.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateIdentityProviderMapperCalls())
+func (mock *KeycloakInterfaceMock) CreateIdentityProviderMapperCalls() []struct {
+	Ctx       context.Context
+	Alias     string
+	RealmName string
+	Mapper    *IdentityProviderMapper
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Alias     string
+		RealmName string
+		Mapper    *IdentityProviderMapper
+	}
+	lockKeycloakInterfaceMockCreateIdentityProviderMapper.RLock()
+	calls = mock.calls.CreateIdentityProviderMapper
+	lockKeycloakInterfaceMockCreateIdentityProviderMapper.RUnlock()
+	return calls
+}
+
+// CreateProtocolMapperForClient calls CreateProtocolMapperForClientFunc.
+func (mock *KeycloakInterfaceMock) CreateProtocolMapperForClient(ctx context.Context, clientID string, realmName string, mapper *ProtocolMapper) error {
+	if mock.CreateProtocolMapperForClientFunc == nil {
+		panic("KeycloakInterfaceMock.CreateProtocolMapperForClientFunc: method is nil but KeycloakInterface.CreateProtocolMapperForClient was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Mapper    *ProtocolMapper
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Mapper:    mapper,
+	}
+	lockKeycloakInterfaceMockCreateProtocolMapperForClient.Lock()
+	mock.calls.CreateProtocolMapperForClient = append(mock.calls.CreateProtocolMapperForClient, callInfo)
+	lockKeycloakInterfaceMockCreateProtocolMapperForClient.Unlock()
+	return mock.CreateProtocolMapperForClientFunc(ctx, clientID, realmName, mapper)
+}
+
+// CreateProtocolMapperForClientCalls gets all the calls that were made to CreateProtocolMapperForClient.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateProtocolMapperForClientCalls())
+func (mock *KeycloakInterfaceMock) CreateProtocolMapperForClientCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Mapper    *ProtocolMapper
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Mapper    *ProtocolMapper
+	}
+	lockKeycloakInterfaceMockCreateProtocolMapperForClient.RLock()
+	calls = mock.calls.CreateProtocolMapperForClient
+	lockKeycloakInterfaceMockCreateProtocolMapperForClient.RUnlock()
+	return calls
+}
+
+// CreateProtocolMapperForClientScope calls CreateProtocolMapperForClientScopeFunc.
+func (mock *KeycloakInterfaceMock) CreateProtocolMapperForClientScope(ctx context.Context, scopeID string, realmName string, mapper *ProtocolMapper) error {
+	if mock.CreateProtocolMapperForClientScopeFunc == nil {
+		panic("KeycloakInterfaceMock.CreateProtocolMapperForClientScopeFunc: method is nil but KeycloakInterface.CreateProtocolMapperForClientScope was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+		Mapper    *ProtocolMapper
+	}{
+		Ctx:       ctx,
+		ScopeID:   scopeID,
+		RealmName: realmName,
+		Mapper:    mapper,
+	}
+	lockKeycloakInterfaceMockCreateProtocolMapperForClientScope.Lock()
+	mock.calls.CreateProtocolMapperForClientScope = append(mock.calls.CreateProtocolMapperForClientScope, callInfo)
+	lockKeycloakInterfaceMockCreateProtocolMapperForClientScope.Unlock()
+	return mock.CreateProtocolMapperForClientScopeFunc(ctx, scopeID, realmName, mapper)
+}
+
+// CreateProtocolMapperForClientScopeCalls gets all the calls that were made to CreateProtocolMapperForClientScope.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateProtocolMapperForClientScopeCalls())
+func (mock *KeycloakInterfaceMock) CreateProtocolMapperForClientScopeCalls() []struct {
+	Ctx       context.Context
+	ScopeID   string
+	RealmName string
+	Mapper    *ProtocolMapper
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+		Mapper    *ProtocolMapper
+	}
+	lockKeycloakInterfaceMockCreateProtocolMapperForClientScope.RLock()
+	calls = mock.calls.CreateProtocolMapperForClientScope
+	lockKeycloakInterfaceMockCreateProtocolMapperForClientScope.RUnlock()
+	return calls
+}
+
+// CreateRealm calls CreateRealmFunc.
+func (mock *KeycloakInterfaceMock) CreateRealm(ctx context.Context, realm *v1alpha1.KeycloakRealm) (string, error) {
+	if mock.CreateRealmFunc == nil {
+		panic("KeycloakInterfaceMock.CreateRealmFunc: method is nil but KeycloakInterface.CreateRealm was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Realm *v1alpha1.KeycloakRealm
+	}{
+		Ctx:   ctx,
+		Realm: realm,
+	}
+	lockKeycloakInterfaceMockCreateRealm.Lock()
+	mock.calls.CreateRealm = append(mock.calls.CreateRealm, callInfo)
+	lockKeycloakInterfaceMockCreateRealm.Unlock()
+	return mock.CreateRealmFunc(ctx, realm)
+}
+
+// CreateRealmCalls gets all the calls that were made to CreateRealm.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateRealmCalls())
+func (mock *KeycloakInterfaceMock) CreateRealmCalls() []struct {
+	Ctx   context.Context
+	Realm *v1alpha1.KeycloakRealm
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Realm *v1alpha1.KeycloakRealm
+	}
+	lockKeycloakInterfaceMockCreateRealm.RLock()
+	calls = mock.calls.CreateRealm
+	lockKeycloakInterfaceMockCreateRealm.RUnlock()
+	return calls
+}
+
+// CreateUser calls CreateUserFunc.
+func (mock *KeycloakInterfaceMock) CreateUser(ctx context.Context, user *v1alpha1.KeycloakAPIUser, realmName string) (string, error) {
+	if mock.CreateUserFunc == nil {
+		panic("KeycloakInterfaceMock.CreateUserFunc: method is nil but KeycloakInterface.CreateUser was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		User      *v1alpha1.KeycloakAPIUser
+		RealmName string
+	}{
+		Ctx:       ctx,
+		User:      user,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockCreateUser.Lock()
+	mock.calls.CreateUser = append(mock.calls.CreateUser, callInfo)
+	lockKeycloakInterfaceMockCreateUser.Unlock()
+	return mock.CreateUserFunc(ctx, user, realmName)
+}
+
+// CreateUserCalls gets all the calls that were made to CreateUser.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateUserCalls())
+func (mock *KeycloakInterfaceMock) CreateUserCalls() []struct {
+	Ctx       context.Context
+	User      *v1alpha1.KeycloakAPIUser
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		User      *v1alpha1.KeycloakAPIUser
+		RealmName string
+	}
+	lockKeycloakInterfaceMockCreateUser.RLock()
+	calls = mock.calls.CreateUser
+	lockKeycloakInterfaceMockCreateUser.RUnlock()
+	return calls
+}
+
+// CreateUserClientRole calls CreateUserClientRoleFunc.
+func (mock *KeycloakInterfaceMock) CreateUserClientRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, clientID string, userID string) (string, error) {
+	if mock.CreateUserClientRoleFunc == nil {
+		panic("KeycloakInterfaceMock.CreateUserClientRoleFunc: method is nil but KeycloakInterface.CreateUserClientRole was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		ClientID  string
+		UserID    string
+	}{
+		Ctx:       ctx,
+		Role:      role,
+		RealmName: realmName,
+		ClientID:  clientID,
+		UserID:    userID,
+	}
+	lockKeycloakInterfaceMockCreateUserClientRole.Lock()
+	mock.calls.CreateUserClientRole = append(mock.calls.CreateUserClientRole, callInfo)
+	lockKeycloakInterfaceMockCreateUserClientRole.Unlock()
+	return mock.CreateUserClientRoleFunc(ctx, role, realmName, clientID, userID)
+}
+
+// CreateUserClientRoleCalls gets all the calls that were made to CreateUserClientRole.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateUserClientRoleCalls())
+func (mock *KeycloakInterfaceMock) CreateUserClientRoleCalls() []struct {
+	Ctx       context.Context
+	Role      *v1alpha1.KeycloakUserRole
+	RealmName string
+	ClientID  string
+	UserID    string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		ClientID  string
+		UserID    string
+	}
+	lockKeycloakInterfaceMockCreateUserClientRole.RLock()
+	calls = mock.calls.CreateUserClientRole
+	lockKeycloakInterfaceMockCreateUserClientRole.RUnlock()
+	return calls
+}
+
+// CreateUserRealmRole calls CreateUserRealmRoleFunc.
+func (mock *KeycloakInterfaceMock) CreateUserRealmRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, userID string) (string, error) {
+	if mock.CreateUserRealmRoleFunc == nil {
+		panic("KeycloakInterfaceMock.CreateUserRealmRoleFunc: method is nil but KeycloakInterface.CreateUserRealmRole was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		UserID    string
+	}{
+		Ctx:       ctx,
+		Role:      role,
+		RealmName: realmName,
+		UserID:    userID,
+	}
+	lockKeycloakInterfaceMockCreateUserRealmRole.Lock()
+	mock.calls.CreateUserRealmRole = append(mock.calls.CreateUserRealmRole, callInfo)
+	lockKeycloakInterfaceMockCreateUserRealmRole.Unlock()
+	return mock.CreateUserRealmRoleFunc(ctx, role, realmName, userID)
+}
+
+// CreateUserRealmRoleCalls gets all the calls that were made to CreateUserRealmRole.
+// Check the length with:
+//     len(mockedKeycloakInterface.CreateUserRealmRoleCalls())
+func (mock *KeycloakInterfaceMock) CreateUserRealmRoleCalls() []struct {
+	Ctx       context.Context
+	Role      *v1alpha1.KeycloakUserRole
+	RealmName string
+	UserID    string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		UserID    string
+	}
+	lockKeycloakInterfaceMockCreateUserRealmRole.RLock()
+	calls = mock.calls.CreateUserRealmRole
+	lockKeycloakInterfaceMockCreateUserRealmRole.RUnlock()
+	return calls
+}
+
+// DeleteAuthenticatorConfig calls DeleteAuthenticatorConfigFunc.
+func (mock *KeycloakInterfaceMock) DeleteAuthenticatorConfig(ctx context.Context, configID string, realmName string) error {
+	if mock.DeleteAuthenticatorConfigFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteAuthenticatorConfigFunc: method is nil but KeycloakInterface.DeleteAuthenticatorConfig was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ConfigID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ConfigID:  configID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteAuthenticatorConfig.Lock()
+	mock.calls.DeleteAuthenticatorConfig = append(mock.calls.DeleteAuthenticatorConfig, callInfo)
+	lockKeycloakInterfaceMockDeleteAuthenticatorConfig.Unlock()
+	return mock.DeleteAuthenticatorConfigFunc(ctx, configID, realmName)
+}
+
+// DeleteAuthenticatorConfigCalls gets all the calls that were made to DeleteAuthenticatorConfig.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteAuthenticatorConfigCalls())
+func (mock *KeycloakInterfaceMock) DeleteAuthenticatorConfigCalls() []struct {
+	Ctx       context.Context
+	ConfigID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ConfigID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteAuthenticatorConfig.RLock()
+	calls = mock.calls.DeleteAuthenticatorConfig
+	lockKeycloakInterfaceMockDeleteAuthenticatorConfig.RUnlock()
+	return calls
+}
+
+// DeleteAuthzPermission calls DeleteAuthzPermissionFunc.
+func (mock *KeycloakInterfaceMock) DeleteAuthzPermission(ctx context.Context, clientID string, permissionID string, realmName string) error {
+	if mock.DeleteAuthzPermissionFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteAuthzPermissionFunc: method is nil but KeycloakInterface.DeleteAuthzPermission was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ClientID     string
+		PermissionID string
+		RealmName    string
+	}{
+		Ctx:          ctx,
+		ClientID:     clientID,
+		PermissionID: permissionID,
+		RealmName:    realmName,
+	}
+	lockKeycloakInterfaceMockDeleteAuthzPermission.Lock()
+	mock.calls.DeleteAuthzPermission = append(mock.calls.DeleteAuthzPermission, callInfo)
+	lockKeycloakInterfaceMockDeleteAuthzPermission.Unlock()
+	return mock.DeleteAuthzPermissionFunc(ctx, clientID, permissionID, realmName)
+}
+
+// DeleteAuthzPermissionCalls gets all the calls that were made to DeleteAuthzPermission.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteAuthzPermissionCalls())
+func (mock *KeycloakInterfaceMock) DeleteAuthzPermissionCalls() []struct {
+	Ctx          context.Context
+	ClientID     string
+	PermissionID string
+	RealmName    string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ClientID     string
+		PermissionID string
+		RealmName    string
+	}
+	lockKeycloakInterfaceMockDeleteAuthzPermission.RLock()
+	calls = mock.calls.DeleteAuthzPermission
+	lockKeycloakInterfaceMockDeleteAuthzPermission.RUnlock()
+	return calls
+}
+
+// DeleteAuthzPolicy calls DeleteAuthzPolicyFunc.
+func (mock *KeycloakInterfaceMock) DeleteAuthzPolicy(ctx context.Context, clientID string, policyID string, realmName string) error {
+	if mock.DeleteAuthzPolicyFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteAuthzPolicyFunc: method is nil but KeycloakInterface.DeleteAuthzPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		PolicyID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		PolicyID:  policyID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteAuthzPolicy.Lock()
+	mock.calls.DeleteAuthzPolicy = append(mock.calls.DeleteAuthzPolicy, callInfo)
+	lockKeycloakInterfaceMockDeleteAuthzPolicy.Unlock()
+	return mock.DeleteAuthzPolicyFunc(ctx, clientID, policyID, realmName)
+}
+
+// DeleteAuthzPolicyCalls gets all the calls that were made to DeleteAuthzPolicy.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteAuthzPolicyCalls())
+func (mock *KeycloakInterfaceMock) DeleteAuthzPolicyCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	PolicyID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		PolicyID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteAuthzPolicy.RLock()
+	calls = mock.calls.DeleteAuthzPolicy
+	lockKeycloakInterfaceMockDeleteAuthzPolicy.RUnlock()
+	return calls
+}
+
+// DeleteAuthzResource calls DeleteAuthzResourceFunc.
+func (mock *KeycloakInterfaceMock) DeleteAuthzResource(ctx context.Context, clientID string, resourceID string, realmName string) error {
+	if mock.DeleteAuthzResourceFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteAuthzResourceFunc: method is nil but KeycloakInterface.DeleteAuthzResource was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ClientID   string
+		ResourceID string
+		RealmName  string
+	}{
+		Ctx:        ctx,
+		ClientID:   clientID,
+		ResourceID: resourceID,
+		RealmName:  realmName,
+	}
+	lockKeycloakInterfaceMockDeleteAuthzResource.Lock()
+	mock.calls.DeleteAuthzResource = append(mock.calls.DeleteAuthzResource, callInfo)
+	lockKeycloakInterfaceMockDeleteAuthzResource.Unlock()
+	return mock.DeleteAuthzResourceFunc(ctx, clientID, resourceID, realmName)
+}
+
+// DeleteAuthzResourceCalls gets all the calls that were made to DeleteAuthzResource.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteAuthzResourceCalls())
+func (mock *KeycloakInterfaceMock) DeleteAuthzResourceCalls() []struct {
+	Ctx        context.Context
+	ClientID   string
+	ResourceID string
+	RealmName  string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ClientID   string
+		ResourceID string
+		RealmName  string
+	}
+	lockKeycloakInterfaceMockDeleteAuthzResource.RLock()
+	calls = mock.calls.DeleteAuthzResource
+	lockKeycloakInterfaceMockDeleteAuthzResource.RUnlock()
+	return calls
+}
+
+// DeleteAuthzScope calls DeleteAuthzScopeFunc.
+func (mock *KeycloakInterfaceMock) DeleteAuthzScope(ctx context.Context, clientID string, scopeID string, realmName string) error {
+	if mock.DeleteAuthzScopeFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteAuthzScopeFunc: method is nil but KeycloakInterface.DeleteAuthzScope was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		ScopeID   string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		ScopeID:   scopeID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteAuthzScope.Lock()
+	mock.calls.DeleteAuthzScope = append(mock.calls.DeleteAuthzScope, callInfo)
+	lockKeycloakInterfaceMockDeleteAuthzScope.Unlock()
+	return mock.DeleteAuthzScopeFunc(ctx, clientID, scopeID, realmName)
+}
+
+// DeleteAuthzScopeCalls gets all the calls that were made to DeleteAuthzScope.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteAuthzScopeCalls())
+func (mock *KeycloakInterfaceMock) DeleteAuthzScopeCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	ScopeID   string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		ScopeID   string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteAuthzScope.RLock()
+	calls = mock.calls.DeleteAuthzScope
+	lockKeycloakInterfaceMockDeleteAuthzScope.RUnlock()
+	return calls
+}
+
+// DeleteClient calls DeleteClientFunc.
+func (mock *KeycloakInterfaceMock) DeleteClient(ctx context.Context, clientID string, realmName string) error {
+	if mock.DeleteClientFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteClientFunc: method is nil but KeycloakInterface.DeleteClient was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteClient.Lock()
+	mock.calls.DeleteClient = append(mock.calls.DeleteClient, callInfo)
+	lockKeycloakInterfaceMockDeleteClient.Unlock()
+	return mock.DeleteClientFunc(ctx, clientID, realmName)
+}
+
+// DeleteClientCalls gets all the calls that were made to DeleteClient.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteClientCalls())
+func (mock *KeycloakInterfaceMock) DeleteClientCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteClient.RLock()
+	calls = mock.calls.DeleteClient
+	lockKeycloakInterfaceMockDeleteClient.RUnlock()
+	return calls
+}
+
+// DeleteClientRole calls DeleteClientRoleFunc.
+func (mock *KeycloakInterfaceMock) DeleteClientRole(ctx context.Context, clientID string, roleName string, realmName string) error {
+	if mock.DeleteClientRoleFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteClientRoleFunc: method is nil but KeycloakInterface.DeleteClientRole was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RoleName  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RoleName:  roleName,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteClientRole.Lock()
+	mock.calls.DeleteClientRole = append(mock.calls.DeleteClientRole, callInfo)
+	lockKeycloakInterfaceMockDeleteClientRole.Unlock()
+	return mock.DeleteClientRoleFunc(ctx, clientID, roleName, realmName)
+}
+
+// DeleteClientRoleCalls gets all the calls that were made to DeleteClientRole.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteClientRoleCalls())
+func (mock *KeycloakInterfaceMock) DeleteClientRoleCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RoleName  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RoleName  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteClientRole.RLock()
+	calls = mock.calls.DeleteClientRole
+	lockKeycloakInterfaceMockDeleteClientRole.RUnlock()
+	return calls
+}
+
+// DeleteClientScope calls DeleteClientScopeFunc.
+func (mock *KeycloakInterfaceMock) DeleteClientScope(ctx context.Context, scopeID string, realmName string) error {
+	if mock.DeleteClientScopeFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteClientScopeFunc: method is nil but KeycloakInterface.DeleteClientScope was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ScopeID:   scopeID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteClientScope.Lock()
+	mock.calls.DeleteClientScope = append(mock.calls.DeleteClientScope, callInfo)
+	lockKeycloakInterfaceMockDeleteClientScope.Unlock()
+	return mock.DeleteClientScopeFunc(ctx, scopeID, realmName)
+}
+
+// DeleteClientScopeCalls gets all the calls that were made to DeleteClientScope.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteClientScopeCalls())
+func (mock *KeycloakInterfaceMock) DeleteClientScopeCalls() []struct {
+	Ctx       context.Context
+	ScopeID   string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteClientScope.RLock()
+	calls = mock.calls.DeleteClientScope
+	lockKeycloakInterfaceMockDeleteClientScope.RUnlock()
+	return calls
+}
+
+// DeleteClientScopeClientScopeMappings calls DeleteClientScopeClientScopeMappingsFunc.
+func (mock *KeycloakInterfaceMock) DeleteClientScopeClientScopeMappings(ctx context.Context, scopeID string, targetClientID string, realmName string, roles []*v1alpha1.KeycloakUserRole) error {
+	if mock.DeleteClientScopeClientScopeMappingsFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteClientScopeClientScopeMappingsFunc: method is nil but KeycloakInterface.DeleteClientScopeClientScopeMappings was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		ScopeID        string
+		TargetClientID string
+		RealmName      string
+		Roles          []*v1alpha1.KeycloakUserRole
+	}{
+		Ctx:            ctx,
+		ScopeID:        scopeID,
+		TargetClientID: targetClientID,
+		RealmName:      realmName,
+		Roles:          roles,
+	}
+	lockKeycloakInterfaceMockDeleteClientScopeClientScopeMappings.Lock()
+	mock.calls.DeleteClientScopeClientScopeMappings = append(mock.calls.DeleteClientScopeClientScopeMappings, callInfo)
+	lockKeycloakInterfaceMockDeleteClientScopeClientScopeMappings.Unlock()
+	return mock.DeleteClientScopeClientScopeMappingsFunc(ctx, scopeID, targetClientID, realmName, roles)
+}
+
+// DeleteClientScopeClientScopeMappingsCalls gets all the calls that were made to DeleteClientScopeClientScopeMappings.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteClientScopeClientScopeMappingsCalls())
+func (mock *KeycloakInterfaceMock) DeleteClientScopeClientScopeMappingsCalls() []struct {
+	Ctx            context.Context
+	ScopeID        string
+	TargetClientID string
+	RealmName      string
+	Roles          []*v1alpha1.KeycloakUserRole
+} {
+	var calls []struct {
+		Ctx            context.Context
+		ScopeID        string
+		TargetClientID string
+		RealmName      string
+		Roles          []*v1alpha1.KeycloakUserRole
+	}
+	lockKeycloakInterfaceMockDeleteClientScopeClientScopeMappings.RLock()
+	calls = mock.calls.DeleteClientScopeClientScopeMappings
+	lockKeycloakInterfaceMockDeleteClientScopeClientScopeMappings.RUnlock()
+	return calls
+}
+
+// DeleteClientScopeRealmScopeMappings calls DeleteClientScopeRealmScopeMappingsFunc.
+func (mock *KeycloakInterfaceMock) DeleteClientScopeRealmScopeMappings(ctx context.Context, scopeID string, realmName string, roles []*v1alpha1.KeycloakUserRole) error {
+	if mock.DeleteClientScopeRealmScopeMappingsFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteClientScopeRealmScopeMappingsFunc: method is nil but KeycloakInterface.DeleteClientScopeRealmScopeMappings was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+		Roles     []*v1alpha1.KeycloakUserRole
+	}{
+		Ctx:       ctx,
+		ScopeID:   scopeID,
+		RealmName: realmName,
+		Roles:     roles,
+	}
+	lockKeycloakInterfaceMockDeleteClientScopeRealmScopeMappings.Lock()
+	mock.calls.DeleteClientScopeRealmScopeMappings = append(mock.calls.DeleteClientScopeRealmScopeMappings, callInfo)
+	lockKeycloakInterfaceMockDeleteClientScopeRealmScopeMappings.Unlock()
+	return mock.DeleteClientScopeRealmScopeMappingsFunc(ctx, scopeID, realmName, roles)
+}
+
+// DeleteClientScopeRealmScopeMappingsCalls gets all the calls that were made to DeleteClientScopeRealmScopeMappings.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteClientScopeRealmScopeMappingsCalls())
+func (mock *KeycloakInterfaceMock) DeleteClientScopeRealmScopeMappingsCalls() []struct {
+	Ctx       context.Context
+	ScopeID   string
+	RealmName string
+	Roles     []*v1alpha1.KeycloakUserRole
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+		Roles     []*v1alpha1.KeycloakUserRole
+	}
+	lockKeycloakInterfaceMockDeleteClientScopeRealmScopeMappings.RLock()
+	calls = mock.calls.DeleteClientScopeRealmScopeMappings
+	lockKeycloakInterfaceMockDeleteClientScopeRealmScopeMappings.RUnlock()
+	return calls
+}
+
+// DeleteComponent calls DeleteComponentFunc.
+func (mock *KeycloakInterfaceMock) DeleteComponent(ctx context.Context, componentID string, realmName string) error {
+	if mock.DeleteComponentFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteComponentFunc: method is nil but KeycloakInterface.DeleteComponent was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ComponentID string
+		RealmName   string
+	}{
+		Ctx:         ctx,
+		ComponentID: componentID,
+		RealmName:   realmName,
+	}
+	lockKeycloakInterfaceMockDeleteComponent.Lock()
+	mock.calls.DeleteComponent = append(mock.calls.DeleteComponent, callInfo)
+	lockKeycloakInterfaceMockDeleteComponent.Unlock()
+	return mock.DeleteComponentFunc(ctx, componentID, realmName)
+}
+
+// DeleteComponentCalls gets all the calls that were made to DeleteComponent.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteComponentCalls())
+func (mock *KeycloakInterfaceMock) DeleteComponentCalls() []struct {
+	Ctx         context.Context
+	ComponentID string
+	RealmName   string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ComponentID string
+		RealmName   string
+	}
+	lockKeycloakInterfaceMockDeleteComponent.RLock()
+	calls = mock.calls.DeleteComponent
+	lockKeycloakInterfaceMockDeleteComponent.RUnlock()
+	return calls
+}
+
+// DeleteGroup calls DeleteGroupFunc.
+func (mock *KeycloakInterfaceMock) DeleteGroup(ctx context.Context, groupID string, realmName string) error {
+	if mock.DeleteGroupFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteGroupFunc: method is nil but KeycloakInterface.DeleteGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupID   string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		GroupID:   groupID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteGroup.Lock()
+	mock.calls.DeleteGroup = append(mock.calls.DeleteGroup, callInfo)
+	lockKeycloakInterfaceMockDeleteGroup.Unlock()
+	return mock.DeleteGroupFunc(ctx, groupID, realmName)
+}
+
+// DeleteGroupCalls gets all the calls that were made to DeleteGroup.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteGroupCalls())
+func (mock *KeycloakInterfaceMock) DeleteGroupCalls() []struct {
+	Ctx       context.Context
+	GroupID   string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupID   string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteGroup.RLock()
+	calls = mock.calls.DeleteGroup
+	lockKeycloakInterfaceMockDeleteGroup.RUnlock()
+	return calls
+}
+
+// DeleteGroupClientRole calls DeleteGroupClientRoleFunc.
+func (mock *KeycloakInterfaceMock) DeleteGroupClientRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, clientID string, groupID string) error {
+	if mock.DeleteGroupClientRoleFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteGroupClientRoleFunc: method is nil but KeycloakInterface.DeleteGroupClientRole was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		ClientID  string
+		GroupID   string
+	}{
+		Ctx:       ctx,
+		Role:      role,
+		RealmName: realmName,
+		ClientID:  clientID,
+		GroupID:   groupID,
+	}
+	lockKeycloakInterfaceMockDeleteGroupClientRole.Lock()
+	mock.calls.DeleteGroupClientRole = append(mock.calls.DeleteGroupClientRole, callInfo)
+	lockKeycloakInterfaceMockDeleteGroupClientRole.Unlock()
+	return mock.DeleteGroupClientRoleFunc(ctx, role, realmName, clientID, groupID)
+}
+
+// DeleteGroupClientRoleCalls gets all the calls that were made to DeleteGroupClientRole.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteGroupClientRoleCalls())
+func (mock *KeycloakInterfaceMock) DeleteGroupClientRoleCalls() []struct {
+	Ctx       context.Context
+	Role      *v1alpha1.KeycloakUserRole
+	RealmName string
+	ClientID  string
+	GroupID   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		ClientID  string
+		GroupID   string
+	}
+	lockKeycloakInterfaceMockDeleteGroupClientRole.RLock()
+	calls = mock.calls.DeleteGroupClientRole
+	lockKeycloakInterfaceMockDeleteGroupClientRole.RUnlock()
+	return calls
+}
+
+// DeleteIdentityProvider calls DeleteIdentityProviderFunc.
+func (mock *KeycloakInterfaceMock) DeleteIdentityProvider(ctx context.Context, alias string, realmName string) error {
+	if mock.DeleteIdentityProviderFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteIdentityProviderFunc: method is nil but KeycloakInterface.DeleteIdentityProvider was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Alias     string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		Alias:     alias,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteIdentityProvider.Lock()
+	mock.calls.DeleteIdentityProvider = append(mock.calls.DeleteIdentityProvider, callInfo)
+	lockKeycloakInterfaceMockDeleteIdentityProvider.Unlock()
+	return mock.DeleteIdentityProviderFunc(ctx, alias, realmName)
+}
+
+// DeleteIdentityProviderCalls gets all the calls that were made to DeleteIdentityProvider.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteIdentityProviderCalls())
+func (mock *KeycloakInterfaceMock) DeleteIdentityProviderCalls() []struct {
+	Ctx       context.Context
+	Alias     string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Alias     string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteIdentityProvider.RLock()
+	calls = mock.calls.DeleteIdentityProvider
+	lockKeycloakInterfaceMockDeleteIdentityProvider.RUnlock()
+	return calls
+}
+
+// DeleteIdentityProviderMapper calls DeleteIdentityProviderMapperFunc.
+func (mock *KeycloakInterfaceMock) DeleteIdentityProviderMapper(ctx context.Context, alias string, mapperID string, realmName string) error {
+	if mock.DeleteIdentityProviderMapperFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteIdentityProviderMapperFunc: method is nil but KeycloakInterface.DeleteIdentityProviderMapper was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Alias     string
+		MapperID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		Alias:     alias,
+		MapperID:  mapperID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteIdentityProviderMapper.Lock()
+	mock.calls.DeleteIdentityProviderMapper = append(mock.calls.DeleteIdentityProviderMapper, callInfo)
+	lockKeycloakInterfaceMockDeleteIdentityProviderMapper.Unlock()
+	return mock.DeleteIdentityProviderMapperFunc(ctx, alias, mapperID, realmName)
+}
+
+// DeleteIdentityProviderMapperCalls gets all the calls that were made to DeleteIdentityProviderMapper.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteIdentityProviderMapperCalls())
+func (mock *KeycloakInterfaceMock) DeleteIdentityProviderMapperCalls() []struct {
+	Ctx       context.Context
+	Alias     string
+	MapperID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Alias     string
+		MapperID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteIdentityProviderMapper.RLock()
+	calls = mock.calls.DeleteIdentityProviderMapper
+	lockKeycloakInterfaceMockDeleteIdentityProviderMapper.RUnlock()
+	return calls
+}
+
+// DeleteProtocolMapperForClient calls DeleteProtocolMapperForClientFunc.
+func (mock *KeycloakInterfaceMock) DeleteProtocolMapperForClient(ctx context.Context, clientID string, mapperID string, realmName string) error {
+	if mock.DeleteProtocolMapperForClientFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteProtocolMapperForClientFunc: method is nil but KeycloakInterface.DeleteProtocolMapperForClient was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		MapperID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		MapperID:  mapperID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteProtocolMapperForClient.Lock()
+	mock.calls.DeleteProtocolMapperForClient = append(mock.calls.DeleteProtocolMapperForClient, callInfo)
+	lockKeycloakInterfaceMockDeleteProtocolMapperForClient.Unlock()
+	return mock.DeleteProtocolMapperForClientFunc(ctx, clientID, mapperID, realmName)
+}
+
+// DeleteProtocolMapperForClientCalls gets all the calls that were made to DeleteProtocolMapperForClient.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteProtocolMapperForClientCalls())
+func (mock *KeycloakInterfaceMock) DeleteProtocolMapperForClientCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	MapperID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		MapperID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteProtocolMapperForClient.RLock()
+	calls = mock.calls.DeleteProtocolMapperForClient
+	lockKeycloakInterfaceMockDeleteProtocolMapperForClient.RUnlock()
+	return calls
+}
+
+// DeleteProtocolMapperForClientScope calls DeleteProtocolMapperForClientScopeFunc.
+func (mock *KeycloakInterfaceMock) DeleteProtocolMapperForClientScope(ctx context.Context, scopeID string, mapperID string, realmName string) error {
+	if mock.DeleteProtocolMapperForClientScopeFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteProtocolMapperForClientScopeFunc: method is nil but KeycloakInterface.DeleteProtocolMapperForClientScope was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ScopeID   string
+		MapperID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ScopeID:   scopeID,
+		MapperID:  mapperID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteProtocolMapperForClientScope.Lock()
+	mock.calls.DeleteProtocolMapperForClientScope = append(mock.calls.DeleteProtocolMapperForClientScope, callInfo)
+	lockKeycloakInterfaceMockDeleteProtocolMapperForClientScope.Unlock()
+	return mock.DeleteProtocolMapperForClientScopeFunc(ctx, scopeID, mapperID, realmName)
+}
+
+// DeleteProtocolMapperForClientScopeCalls gets all the calls that were made to DeleteProtocolMapperForClientScope.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteProtocolMapperForClientScopeCalls())
+func (mock *KeycloakInterfaceMock) DeleteProtocolMapperForClientScopeCalls() []struct {
+	Ctx       context.Context
+	ScopeID   string
+	MapperID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ScopeID   string
+		MapperID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteProtocolMapperForClientScope.RLock()
+	calls = mock.calls.DeleteProtocolMapperForClientScope
+	lockKeycloakInterfaceMockDeleteProtocolMapperForClientScope.RUnlock()
+	return calls
+}
+
+// DeleteRealm calls DeleteRealmFunc.
+func (mock *KeycloakInterfaceMock) DeleteRealm(ctx context.Context, realmName string) error {
+	if mock.DeleteRealmFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteRealmFunc: method is nil but KeycloakInterface.DeleteRealm was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteRealm.Lock()
+	mock.calls.DeleteRealm = append(mock.calls.DeleteRealm, callInfo)
+	lockKeycloakInterfaceMockDeleteRealm.Unlock()
+	return mock.DeleteRealmFunc(ctx, realmName)
+}
+
+// DeleteRealmCalls gets all the calls that were made to DeleteRealm.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteRealmCalls())
+func (mock *KeycloakInterfaceMock) DeleteRealmCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteRealm.RLock()
+	calls = mock.calls.DeleteRealm
+	lockKeycloakInterfaceMockDeleteRealm.RUnlock()
+	return calls
+}
+
+// DeleteRequiredAction calls DeleteRequiredActionFunc.
+func (mock *KeycloakInterfaceMock) DeleteRequiredAction(ctx context.Context, alias string, realmName string) error {
+	if mock.DeleteRequiredActionFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteRequiredActionFunc: method is nil but KeycloakInterface.DeleteRequiredAction was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Alias     string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		Alias:     alias,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteRequiredAction.Lock()
+	mock.calls.DeleteRequiredAction = append(mock.calls.DeleteRequiredAction, callInfo)
+	lockKeycloakInterfaceMockDeleteRequiredAction.Unlock()
+	return mock.DeleteRequiredActionFunc(ctx, alias, realmName)
+}
+
+// DeleteRequiredActionCalls gets all the calls that were made to DeleteRequiredAction.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteRequiredActionCalls())
+func (mock *KeycloakInterfaceMock) DeleteRequiredActionCalls() []struct {
+	Ctx       context.Context
+	Alias     string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Alias     string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteRequiredAction.RLock()
+	calls = mock.calls.DeleteRequiredAction
+	lockKeycloakInterfaceMockDeleteRequiredAction.RUnlock()
+	return calls
+}
+
+// DeleteUser calls DeleteUserFunc.
+func (mock *KeycloakInterfaceMock) DeleteUser(ctx context.Context, userID string, realmName string) error {
+	if mock.DeleteUserFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteUserFunc: method is nil but KeycloakInterface.DeleteUser was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		UserID    string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		UserID:    userID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteUser.Lock()
+	mock.calls.DeleteUser = append(mock.calls.DeleteUser, callInfo)
+	lockKeycloakInterfaceMockDeleteUser.Unlock()
+	return mock.DeleteUserFunc(ctx, userID, realmName)
+}
+
+// DeleteUserCalls gets all the calls that were made to DeleteUser.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteUserCalls())
+func (mock *KeycloakInterfaceMock) DeleteUserCalls() []struct {
+	Ctx       context.Context
+	UserID    string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		UserID    string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteUser.RLock()
+	calls = mock.calls.DeleteUser
+	lockKeycloakInterfaceMockDeleteUser.RUnlock()
+	return calls
+}
+
+// DeleteUserClientRole calls DeleteUserClientRoleFunc.
+func (mock *KeycloakInterfaceMock) DeleteUserClientRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, clientID string, userID string) error {
+	if mock.DeleteUserClientRoleFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteUserClientRoleFunc: method is nil but KeycloakInterface.DeleteUserClientRole was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		ClientID  string
+		UserID    string
+	}{
+		Ctx:       ctx,
+		Role:      role,
+		RealmName: realmName,
+		ClientID:  clientID,
+		UserID:    userID,
+	}
+	lockKeycloakInterfaceMockDeleteUserClientRole.Lock()
+	mock.calls.DeleteUserClientRole = append(mock.calls.DeleteUserClientRole, callInfo)
+	lockKeycloakInterfaceMockDeleteUserClientRole.Unlock()
+	return mock.DeleteUserClientRoleFunc(ctx, role, realmName, clientID, userID)
+}
+
+// DeleteUserClientRoleCalls gets all the calls that were made to DeleteUserClientRole.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteUserClientRoleCalls())
+func (mock *KeycloakInterfaceMock) DeleteUserClientRoleCalls() []struct {
+	Ctx       context.Context
+	Role      *v1alpha1.KeycloakUserRole
+	RealmName string
+	ClientID  string
+	UserID    string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		ClientID  string
+		UserID    string
+	}
+	lockKeycloakInterfaceMockDeleteUserClientRole.RLock()
+	calls = mock.calls.DeleteUserClientRole
+	lockKeycloakInterfaceMockDeleteUserClientRole.RUnlock()
+	return calls
+}
+
+// DeleteUserCredential calls DeleteUserCredentialFunc.
+func (mock *KeycloakInterfaceMock) DeleteUserCredential(ctx context.Context, userID string, credentialID string, realmName string) error {
+	if mock.DeleteUserCredentialFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteUserCredentialFunc: method is nil but KeycloakInterface.DeleteUserCredential was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UserID       string
+		CredentialID string
+		RealmName    string
+	}{
+		Ctx:          ctx,
+		UserID:       userID,
+		CredentialID: credentialID,
+		RealmName:    realmName,
+	}
+	lockKeycloakInterfaceMockDeleteUserCredential.Lock()
+	mock.calls.DeleteUserCredential = append(mock.calls.DeleteUserCredential, callInfo)
+	lockKeycloakInterfaceMockDeleteUserCredential.Unlock()
+	return mock.DeleteUserCredentialFunc(ctx, userID, credentialID, realmName)
+}
+
+// DeleteUserCredentialCalls gets all the calls that were made to DeleteUserCredential.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteUserCredentialCalls())
+func (mock *KeycloakInterfaceMock) DeleteUserCredentialCalls() []struct {
+	Ctx          context.Context
+	UserID       string
+	CredentialID string
+	RealmName    string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UserID       string
+		CredentialID string
+		RealmName    string
+	}
+	lockKeycloakInterfaceMockDeleteUserCredential.RLock()
+	calls = mock.calls.DeleteUserCredential
+	lockKeycloakInterfaceMockDeleteUserCredential.RUnlock()
+	return calls
+}
+
+// DeleteUserFromGroup calls DeleteUserFromGroupFunc.
+func (mock *KeycloakInterfaceMock) DeleteUserFromGroup(ctx context.Context, realmName string, userID string, groupID string) error {
+	if mock.DeleteUserFromGroupFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteUserFromGroupFunc: method is nil but KeycloakInterface.DeleteUserFromGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		UserID    string
+		GroupID   string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		UserID:    userID,
+		GroupID:   groupID,
+	}
+	lockKeycloakInterfaceMockDeleteUserFromGroup.Lock()
+	mock.calls.DeleteUserFromGroup = append(mock.calls.DeleteUserFromGroup, callInfo)
+	lockKeycloakInterfaceMockDeleteUserFromGroup.Unlock()
+	return mock.DeleteUserFromGroupFunc(ctx, realmName, userID, groupID)
+}
+
+// DeleteUserFromGroupCalls gets all the calls that were made to DeleteUserFromGroup.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteUserFromGroupCalls())
+func (mock *KeycloakInterfaceMock) DeleteUserFromGroupCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	UserID    string
+	GroupID   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		UserID    string
+		GroupID   string
+	}
+	lockKeycloakInterfaceMockDeleteUserFromGroup.RLock()
+	calls = mock.calls.DeleteUserFromGroup
+	lockKeycloakInterfaceMockDeleteUserFromGroup.RUnlock()
+	return calls
+}
+
+// DeleteUserRealmRole calls DeleteUserRealmRoleFunc.
+func (mock *KeycloakInterfaceMock) DeleteUserRealmRole(ctx context.Context, role *v1alpha1.KeycloakUserRole, realmName string, userID string) error {
+	if mock.DeleteUserRealmRoleFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteUserRealmRoleFunc: method is nil but KeycloakInterface.DeleteUserRealmRole was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		UserID    string
+	}{
+		Ctx:       ctx,
+		Role:      role,
+		RealmName: realmName,
+		UserID:    userID,
+	}
+	lockKeycloakInterfaceMockDeleteUserRealmRole.Lock()
+	mock.calls.DeleteUserRealmRole = append(mock.calls.DeleteUserRealmRole, callInfo)
+	lockKeycloakInterfaceMockDeleteUserRealmRole.Unlock()
+	return mock.DeleteUserRealmRoleFunc(ctx, role, realmName, userID)
+}
+
+// DeleteUserRealmRoleCalls gets all the calls that were made to DeleteUserRealmRole.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteUserRealmRoleCalls())
+func (mock *KeycloakInterfaceMock) DeleteUserRealmRoleCalls() []struct {
+	Ctx       context.Context
+	Role      *v1alpha1.KeycloakUserRole
+	RealmName string
+	UserID    string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Role      *v1alpha1.KeycloakUserRole
+		RealmName string
+		UserID    string
+	}
+	lockKeycloakInterfaceMockDeleteUserRealmRole.RLock()
+	calls = mock.calls.DeleteUserRealmRole
+	lockKeycloakInterfaceMockDeleteUserRealmRole.RUnlock()
+	return calls
+}
+
+// DeleteUserSession calls DeleteUserSessionFunc.
+func (mock *KeycloakInterfaceMock) DeleteUserSession(ctx context.Context, sessionID string, realmName string) error {
+	if mock.DeleteUserSessionFunc == nil {
+		panic("KeycloakInterfaceMock.DeleteUserSessionFunc: method is nil but KeycloakInterface.DeleteUserSession was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		SessionID string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		SessionID: sessionID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockDeleteUserSession.Lock()
+	mock.calls.DeleteUserSession = append(mock.calls.DeleteUserSession, callInfo)
+	lockKeycloakInterfaceMockDeleteUserSession.Unlock()
+	return mock.DeleteUserSessionFunc(ctx, sessionID, realmName)
+}
+
+// DeleteUserSessionCalls gets all the calls that were made to DeleteUserSession.
+// Check the length with:
+//     len(mockedKeycloakInterface.DeleteUserSessionCalls())
+func (mock *KeycloakInterfaceMock) DeleteUserSessionCalls() []struct {
+	Ctx       context.Context
+	SessionID string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		SessionID string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockDeleteUserSession.RLock()
+	calls = mock.calls.DeleteUserSession
+	lockKeycloakInterfaceMockDeleteUserSession.RUnlock()
+	return calls
+}
+
+// DownloadClientKeystore calls DownloadClientKeystoreFunc.
+func (mock *KeycloakInterfaceMock) DownloadClientKeystore(ctx context.Context, clientID string, realmName string, attr string, config *KeyStoreConfig) ([]byte, error) {
+	if mock.DownloadClientKeystoreFunc == nil {
+		panic("KeycloakInterfaceMock.DownloadClientKeystoreFunc: method is nil but KeycloakInterface.DownloadClientKeystore was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Attr      string
+		Config    *KeyStoreConfig
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Attr:      attr,
+		Config:    config,
+	}
+	lockKeycloakInterfaceMockDownloadClientKeystore.Lock()
+	mock.calls.DownloadClientKeystore = append(mock.calls.DownloadClientKeystore, callInfo)
+	lockKeycloakInterfaceMockDownloadClientKeystore.Unlock()
+	return mock.DownloadClientKeystoreFunc(ctx, clientID, realmName, attr, config)
+}
+
+// DownloadClientKeystoreCalls gets all the calls that were made to DownloadClientKeystore.
+// Check the length with:
+//     len(mockedKeycloakInterface.DownloadClientKeystoreCalls())
+func (mock *KeycloakInterfaceMock) DownloadClientKeystoreCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Attr      string
+	Config    *KeyStoreConfig
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Attr      string
+		Config    *KeyStoreConfig
+	}
+	lockKeycloakInterfaceMockDownloadClientKeystore.RLock()
+	calls = mock.calls.DownloadClientKeystore
+	lockKeycloakInterfaceMockDownloadClientKeystore.RUnlock()
+	return calls
+}
+
+// EvaluateAuthzPermissions calls EvaluateAuthzPermissionsFunc.
+func (mock *KeycloakInterfaceMock) EvaluateAuthzPermissions(ctx context.Context, clientID string, realmName string, evaluation *PolicyEvaluationRequest) (*PolicyEvaluationResponse, error) {
+	if mock.EvaluateAuthzPermissionsFunc == nil {
+		panic("KeycloakInterfaceMock.EvaluateAuthzPermissionsFunc: method is nil but KeycloakInterface.EvaluateAuthzPermissions was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ClientID   string
+		RealmName  string
+		Evaluation *PolicyEvaluationRequest
+	}{
+		Ctx:        ctx,
+		ClientID:   clientID,
+		RealmName:  realmName,
+		Evaluation: evaluation,
+	}
+	lockKeycloakInterfaceMockEvaluateAuthzPermissions.Lock()
+	mock.calls.EvaluateAuthzPermissions = append(mock.calls.EvaluateAuthzPermissions, callInfo)
+	lockKeycloakInterfaceMockEvaluateAuthzPermissions.Unlock()
+	return mock.EvaluateAuthzPermissionsFunc(ctx, clientID, realmName, evaluation)
+}
+
+// EvaluateAuthzPermissionsCalls gets all the calls that were made to EvaluateAuthzPermissions.
+// Check the length with:
+//     len(mockedKeycloakInterface.EvaluateAuthzPermissionsCalls())
+func (mock *KeycloakInterfaceMock) EvaluateAuthzPermissionsCalls() []struct {
+	Ctx        context.Context
+	ClientID   string
+	RealmName  string
+	Evaluation *PolicyEvaluationRequest
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ClientID   string
+		RealmName  string
+		Evaluation *PolicyEvaluationRequest
+	}
+	lockKeycloakInterfaceMockEvaluateAuthzPermissions.RLock()
+	calls = mock.calls.EvaluateAuthzPermissions
+	lockKeycloakInterfaceMockEvaluateAuthzPermissions.RUnlock()
+	return calls
+}
+
+// ExchangeToken calls ExchangeTokenFunc.
+func (mock *KeycloakInterfaceMock) ExchangeToken(ctx context.Context, realmName string, subjectToken string, audience string, clientID string, clientSecret string) (*TokenResponse, error) {
+	if mock.ExchangeTokenFunc == nil {
+		panic("KeycloakInterfaceMock.ExchangeTokenFunc: method is nil but KeycloakInterface.ExchangeToken was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		RealmName    string
+		SubjectToken string
+		Audience     string
+		ClientID     string
+		ClientSecret string
+	}{
+		Ctx:          ctx,
+		RealmName:    realmName,
+		SubjectToken: subjectToken,
+		Audience:     audience,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	}
+	lockKeycloakInterfaceMockExchangeToken.Lock()
+	mock.calls.ExchangeToken = append(mock.calls.ExchangeToken, callInfo)
+	lockKeycloakInterfaceMockExchangeToken.Unlock()
+	return mock.ExchangeTokenFunc(ctx, realmName, subjectToken, audience, clientID, clientSecret)
+}
+
+// ExchangeTokenCalls gets all the calls that were made to ExchangeToken.
+// Check the length with:
+//     len(mockedKeycloakInterface.ExchangeTokenCalls())
+func (mock *KeycloakInterfaceMock) ExchangeTokenCalls() []struct {
+	Ctx          context.Context
+	RealmName    string
+	SubjectToken string
+	Audience     string
+	ClientID     string
+	ClientSecret string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		RealmName    string
+		SubjectToken string
+		Audience     string
+		ClientID     string
+		ClientSecret string
+	}
+	lockKeycloakInterfaceMockExchangeToken.RLock()
+	calls = mock.calls.ExchangeToken
+	lockKeycloakInterfaceMockExchangeToken.RUnlock()
+	return calls
+}
+
+// FindAuthenticationExecutionForFlow calls FindAuthenticationExecutionForFlowFunc.
+func (mock *KeycloakInterfaceMock) FindAuthenticationExecutionForFlow(ctx context.Context, flowAlias string, realmName string, predicate func(*v1alpha1.AuthenticationExecutionInfo) bool) (*v1alpha1.AuthenticationExecutionInfo, error) {
+	if mock.FindAuthenticationExecutionForFlowFunc == nil {
+		panic("KeycloakInterfaceMock.FindAuthenticationExecutionForFlowFunc: method is nil but KeycloakInterface.FindAuthenticationExecutionForFlow was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		FlowAlias string
+		RealmName string
+		Predicate func(*v1alpha1.AuthenticationExecutionInfo) bool
+	}{
+		Ctx:       ctx,
+		FlowAlias: flowAlias,
+		RealmName: realmName,
+		Predicate: predicate,
+	}
+	lockKeycloakInterfaceMockFindAuthenticationExecutionForFlow.Lock()
+	mock.calls.FindAuthenticationExecutionForFlow = append(mock.calls.FindAuthenticationExecutionForFlow, callInfo)
+	lockKeycloakInterfaceMockFindAuthenticationExecutionForFlow.Unlock()
+	return mock.FindAuthenticationExecutionForFlowFunc(ctx, flowAlias, realmName, predicate)
+}
+
+// FindAuthenticationExecutionForFlowCalls gets all the calls that were made to FindAuthenticationExecutionForFlow.
+// Check the length with:
+//     len(mockedKeycloakInterface.FindAuthenticationExecutionForFlowCalls())
+func (mock *KeycloakInterfaceMock) FindAuthenticationExecutionForFlowCalls() []struct {
+	Ctx       context.Context
+	FlowAlias string
+	RealmName string
+	Predicate func(*v1alpha1.AuthenticationExecutionInfo) bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		FlowAlias string
+		RealmName string
+		Predicate func(*v1alpha1.AuthenticationExecutionInfo) bool
+	}
+	lockKeycloakInterfaceMockFindAuthenticationExecutionForFlow.RLock()
+	calls = mock.calls.FindAuthenticationExecutionForFlow
+	lockKeycloakInterfaceMockFindAuthenticationExecutionForFlow.RUnlock()
+	return calls
+}
+
+// FindAvailableGroupClientRole calls FindAvailableGroupClientRoleFunc.
+func (mock *KeycloakInterfaceMock) FindAvailableGroupClientRole(ctx context.Context, realmName string, clientID string, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error) {
+	if mock.FindAvailableGroupClientRoleFunc == nil {
+		panic("KeycloakInterfaceMock.FindAvailableGroupClientRoleFunc: method is nil but KeycloakInterface.FindAvailableGroupClientRole was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		ClientID  string
+		GroupID   string
+		Predicate func(*v1alpha1.KeycloakUserRole) bool
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		ClientID:  clientID,
+		GroupID:   groupID,
+		Predicate: predicate,
+	}
+	lockKeycloakInterfaceMockFindAvailableGroupClientRole.Lock()
+	mock.calls.FindAvailableGroupClientRole = append(mock.calls.FindAvailableGroupClientRole, callInfo)
+	lockKeycloakInterfaceMockFindAvailableGroupClientRole.Unlock()
+	return mock.FindAvailableGroupClientRoleFunc(ctx, realmName, clientID, groupID, predicate)
+}
+
+// FindAvailableGroupClientRoleCalls gets all the calls that were made to FindAvailableGroupClientRole.
+// Check the length with:
+//     len(mockedKeycloakInterface.FindAvailableGroupClientRoleCalls())
+func (mock *KeycloakInterfaceMock) FindAvailableGroupClientRoleCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	ClientID  string
+	GroupID   string
+	Predicate func(*v1alpha1.KeycloakUserRole) bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		ClientID  string
+		GroupID   string
+		Predicate func(*v1alpha1.KeycloakUserRole) bool
+	}
+	lockKeycloakInterfaceMockFindAvailableGroupClientRole.RLock()
+	calls = mock.calls.FindAvailableGroupClientRole
+	lockKeycloakInterfaceMockFindAvailableGroupClientRole.RUnlock()
+	return calls
+}
+
+// FindClientByClientID calls FindClientByClientIDFunc.
+func (mock *KeycloakInterfaceMock) FindClientByClientID(ctx context.Context, clientID string, realmName string) (*v1alpha1.KeycloakAPIClient, error) {
+	if mock.FindClientByClientIDFunc == nil {
+		panic("KeycloakInterfaceMock.FindClientByClientIDFunc: method is nil but KeycloakInterface.FindClientByClientID was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockFindClientByClientID.Lock()
+	mock.calls.FindClientByClientID = append(mock.calls.FindClientByClientID, callInfo)
+	lockKeycloakInterfaceMockFindClientByClientID.Unlock()
+	return mock.FindClientByClientIDFunc(ctx, clientID, realmName)
+}
+
+// FindClientByClientIDCalls gets all the calls that were made to FindClientByClientID.
+// Check the length with:
+//     len(mockedKeycloakInterface.FindClientByClientIDCalls())
+func (mock *KeycloakInterfaceMock) FindClientByClientIDCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockFindClientByClientID.RLock()
+	calls = mock.calls.FindClientByClientID
+	lockKeycloakInterfaceMockFindClientByClientID.RUnlock()
+	return calls
+}
+
+// FindClientScopeByName calls FindClientScopeByNameFunc.
+func (mock *KeycloakInterfaceMock) FindClientScopeByName(ctx context.Context, name string, realmName string) (*ClientScope, error) {
+	if mock.FindClientScopeByNameFunc == nil {
+		panic("KeycloakInterfaceMock.FindClientScopeByNameFunc: method is nil but KeycloakInterface.FindClientScopeByName was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Name      string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		Name:      name,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockFindClientScopeByName.Lock()
+	mock.calls.FindClientScopeByName = append(mock.calls.FindClientScopeByName, callInfo)
+	lockKeycloakInterfaceMockFindClientScopeByName.Unlock()
+	return mock.FindClientScopeByNameFunc(ctx, name, realmName)
+}
+
+// FindClientScopeByNameCalls gets all the calls that were made to FindClientScopeByName.
+// Check the length with:
+//     len(mockedKeycloakInterface.FindClientScopeByNameCalls())
+func (mock *KeycloakInterfaceMock) FindClientScopeByNameCalls() []struct {
+	Ctx       context.Context
+	Name      string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Name      string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockFindClientScopeByName.RLock()
+	calls = mock.calls.FindClientScopeByName
+	lockKeycloakInterfaceMockFindClientScopeByName.RUnlock()
+	return calls
+}
+
+// FindGroupByName calls FindGroupByNameFunc.
+func (mock *KeycloakInterfaceMock) FindGroupByName(ctx context.Context, groupName string, realmName string) (*Group, error) {
+	if mock.FindGroupByNameFunc == nil {
+		panic("KeycloakInterfaceMock.FindGroupByNameFunc: method is nil but KeycloakInterface.FindGroupByName was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockFindGroupByName.Lock()
+	mock.calls.FindGroupByName = append(mock.calls.FindGroupByName, callInfo)
+	lockKeycloakInterfaceMockFindGroupByName.Unlock()
+	return mock.FindGroupByNameFunc(ctx, groupName, realmName)
+}
+
+// FindGroupByNameCalls gets all the calls that were made to FindGroupByName.
+// Check the length with:
+//     len(mockedKeycloakInterface.FindGroupByNameCalls())
+func (mock *KeycloakInterfaceMock) FindGroupByNameCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockFindGroupByName.RLock()
+	calls = mock.calls.FindGroupByName
+	lockKeycloakInterfaceMockFindGroupByName.RUnlock()
+	return calls
+}
+
+// FindGroupByNameInHierarchy calls FindGroupByNameInHierarchyFunc.
+func (mock *KeycloakInterfaceMock) FindGroupByNameInHierarchy(ctx context.Context, groupName string, realmName string) (*Group, error) {
+	if mock.FindGroupByNameInHierarchyFunc == nil {
+		panic("KeycloakInterfaceMock.FindGroupByNameInHierarchyFunc: method is nil but KeycloakInterface.FindGroupByNameInHierarchy was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockFindGroupByNameInHierarchy.Lock()
+	mock.calls.FindGroupByNameInHierarchy = append(mock.calls.FindGroupByNameInHierarchy, callInfo)
+	lockKeycloakInterfaceMockFindGroupByNameInHierarchy.Unlock()
+	return mock.FindGroupByNameInHierarchyFunc(ctx, groupName, realmName)
+}
+
+// FindGroupByNameInHierarchyCalls gets all the calls that were made to FindGroupByNameInHierarchy.
+// Check the length with:
+//     len(mockedKeycloakInterface.FindGroupByNameInHierarchyCalls())
+func (mock *KeycloakInterfaceMock) FindGroupByNameInHierarchyCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockFindGroupByNameInHierarchy.RLock()
+	calls = mock.calls.FindGroupByNameInHierarchy
+	lockKeycloakInterfaceMockFindGroupByNameInHierarchy.RUnlock()
+	return calls
+}
+
+// FindGroupClientRole calls FindGroupClientRoleFunc.
+func (mock *KeycloakInterfaceMock) FindGroupClientRole(ctx context.Context, realmName string, clientID string, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error) {
+	if mock.FindGroupClientRoleFunc == nil {
+		panic("KeycloakInterfaceMock.FindGroupClientRoleFunc: method is nil but KeycloakInterface.FindGroupClientRole was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		ClientID  string
+		GroupID   string
+		Predicate func(*v1alpha1.KeycloakUserRole) bool
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		ClientID:  clientID,
+		GroupID:   groupID,
+		Predicate: predicate,
+	}
+	lockKeycloakInterfaceMockFindGroupClientRole.Lock()
+	mock.calls.FindGroupClientRole = append(mock.calls.FindGroupClientRole, callInfo)
+	lockKeycloakInterfaceMockFindGroupClientRole.Unlock()
+	return mock.FindGroupClientRoleFunc(ctx, realmName, clientID, groupID, predicate)
+}
+
+// FindGroupClientRoleCalls gets all the calls that were made to FindGroupClientRole.
+// Check the length with:
+//     len(mockedKeycloakInterface.FindGroupClientRoleCalls())
+func (mock *KeycloakInterfaceMock) FindGroupClientRoleCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	ClientID  string
+	GroupID   string
+	Predicate func(*v1alpha1.KeycloakUserRole) bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		ClientID  string
+		GroupID   string
+		Predicate func(*v1alpha1.KeycloakUserRole) bool
+	}
+	lockKeycloakInterfaceMockFindGroupClientRole.RLock()
+	calls = mock.calls.FindGroupClientRole
+	lockKeycloakInterfaceMockFindGroupClientRole.RUnlock()
+	return calls
+}
+
+// FindUserByEmail calls FindUserByEmailFunc.
+func (mock *KeycloakInterfaceMock) FindUserByEmail(ctx context.Context, email string, realm string) (*v1alpha1.KeycloakAPIUser, error) {
+	if mock.FindUserByEmailFunc == nil {
+		panic("KeycloakInterfaceMock.FindUserByEmailFunc: method is nil but KeycloakInterface.FindUserByEmail was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Email string
+		Realm string
+	}{
+		Ctx:   ctx,
+		Email: email,
+		Realm: realm,
+	}
+	lockKeycloakInterfaceMockFindUserByEmail.Lock()
+	mock.calls.FindUserByEmail = append(mock.calls.FindUserByEmail, callInfo)
+	lockKeycloakInterfaceMockFindUserByEmail.Unlock()
+	return mock.FindUserByEmailFunc(ctx, email, realm)
+}
+
+// FindUserByEmailCalls gets all the calls that were made to FindUserByEmail.
+// Check the length with:
+//     len(mockedKeycloakInterface.FindUserByEmailCalls())
+func (mock *KeycloakInterfaceMock) FindUserByEmailCalls() []struct {
+	Ctx   context.Context
+	Email string
+	Realm string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Email string
+		Realm string
+	}
+	lockKeycloakInterfaceMockFindUserByEmail.RLock()
+	calls = mock.calls.FindUserByEmail
+	lockKeycloakInterfaceMockFindUserByEmail.RUnlock()
+	return calls
+}
+
+// FindUserByUsername calls FindUserByUsernameFunc.
+func (mock *KeycloakInterfaceMock) FindUserByUsername(ctx context.Context, name string, realm string) (*v1alpha1.KeycloakAPIUser, error) {
+	if mock.FindUserByUsernameFunc == nil {
+		panic("KeycloakInterfaceMock.FindUserByUsernameFunc: method is nil but KeycloakInterface.FindUserByUsername was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Name  string
+		Realm string
+	}{
+		Ctx:   ctx,
+		Name:  name,
+		Realm: realm,
+	}
+	lockKeycloakInterfaceMockFindUserByUsername.Lock()
+	mock.calls.FindUserByUsername = append(mock.calls.FindUserByUsername, callInfo)
+	lockKeycloakInterfaceMockFindUserByUsername.Unlock()
+	return mock.FindUserByUsernameFunc(ctx, name, realm)
+}
+
+// FindUserByUsernameCalls gets all the calls that were made to FindUserByUsername.
+// Check the length with:
+//     len(mockedKeycloakInterface.FindUserByUsernameCalls())
+func (mock *KeycloakInterfaceMock) FindUserByUsernameCalls() []struct {
+	Ctx   context.Context
+	Name  string
+	Realm string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Name  string
+		Realm string
+	}
+	lockKeycloakInterfaceMockFindUserByUsername.RLock()
+	calls = mock.calls.FindUserByUsername
+	lockKeycloakInterfaceMockFindUserByUsername.RUnlock()
+	return calls
+}
+
+// GenerateClientCertificate calls GenerateClientCertificateFunc.
+func (mock *KeycloakInterfaceMock) GenerateClientCertificate(ctx context.Context, clientID string, realmName string, attr string) (*Certificate, error) {
+	if mock.GenerateClientCertificateFunc == nil {
+		panic("KeycloakInterfaceMock.GenerateClientCertificateFunc: method is nil but KeycloakInterface.GenerateClientCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Attr      string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Attr:      attr,
+	}
+	lockKeycloakInterfaceMockGenerateClientCertificate.Lock()
+	mock.calls.GenerateClientCertificate = append(mock.calls.GenerateClientCertificate, callInfo)
+	lockKeycloakInterfaceMockGenerateClientCertificate.Unlock()
+	return mock.GenerateClientCertificateFunc(ctx, clientID, realmName, attr)
+}
+
+// GenerateClientCertificateCalls gets all the calls that were made to GenerateClientCertificate.
+// Check the length with:
+//     len(mockedKeycloakInterface.GenerateClientCertificateCalls())
+func (mock *KeycloakInterfaceMock) GenerateClientCertificateCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Attr      string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Attr      string
+	}
+	lockKeycloakInterfaceMockGenerateClientCertificate.RLock()
+	calls = mock.calls.GenerateClientCertificate
+	lockKeycloakInterfaceMockGenerateClientCertificate.RUnlock()
+	return calls
+}
+
+// GenerateExampleAccessToken calls GenerateExampleAccessTokenFunc.
+func (mock *KeycloakInterfaceMock) GenerateExampleAccessToken(ctx context.Context, clientID string, realmName string, userID string, scope string) (string, error) {
+	if mock.GenerateExampleAccessTokenFunc == nil {
+		panic("KeycloakInterfaceMock.GenerateExampleAccessTokenFunc: method is nil but KeycloakInterface.GenerateExampleAccessToken was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		UserID    string
+		Scope     string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		UserID:    userID,
+		Scope:     scope,
+	}
+	lockKeycloakInterfaceMockGenerateExampleAccessToken.Lock()
+	mock.calls.GenerateExampleAccessToken = append(mock.calls.GenerateExampleAccessToken, callInfo)
+	lockKeycloakInterfaceMockGenerateExampleAccessToken.Unlock()
+	return mock.GenerateExampleAccessTokenFunc(ctx, clientID, realmName, userID, scope)
+}
+
+// GenerateExampleAccessTokenCalls gets all the calls that were made to GenerateExampleAccessToken.
+// Check the length with:
+//     len(mockedKeycloakInterface.GenerateExampleAccessTokenCalls())
+func (mock *KeycloakInterfaceMock) GenerateExampleAccessTokenCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	UserID    string
+	Scope     string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		UserID    string
+		Scope     string
+	}
+	lockKeycloakInterfaceMockGenerateExampleAccessToken.RLock()
+	calls = mock.calls.GenerateExampleAccessToken
+	lockKeycloakInterfaceMockGenerateExampleAccessToken.RUnlock()
+	return calls
+}
+
+// GetAllGroupMembers calls GetAllGroupMembersFunc.
+func (mock *KeycloakInterfaceMock) GetAllGroupMembers(ctx context.Context, groupID string, realmName string, briefRepresentation bool) ([]*v1alpha1.KeycloakAPIUser, error) {
+	if mock.GetAllGroupMembersFunc == nil {
+		panic("KeycloakInterfaceMock.GetAllGroupMembersFunc: method is nil but KeycloakInterface.GetAllGroupMembers was just called")
+	}
+	callInfo := struct {
+		Ctx                 context.Context
+		GroupID             string
+		RealmName           string
+		BriefRepresentation bool
+	}{
+		Ctx:                 ctx,
+		GroupID:             groupID,
+		RealmName:           realmName,
+		BriefRepresentation: briefRepresentation,
+	}
+	lockKeycloakInterfaceMockGetAllGroupMembers.Lock()
+	mock.calls.GetAllGroupMembers = append(mock.calls.GetAllGroupMembers, callInfo)
+	lockKeycloakInterfaceMockGetAllGroupMembers.Unlock()
+	return mock.GetAllGroupMembersFunc(ctx, groupID, realmName, briefRepresentation)
+}
+
+// GetAllGroupMembersCalls gets all the calls that were made to GetAllGroupMembers.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetAllGroupMembersCalls())
+func (mock *KeycloakInterfaceMock) GetAllGroupMembersCalls() []struct {
+	Ctx                 context.Context
+	GroupID             string
+	RealmName           string
+	BriefRepresentation bool
+} {
+	var calls []struct {
+		Ctx                 context.Context
+		GroupID             string
+		RealmName           string
+		BriefRepresentation bool
+	}
+	lockKeycloakInterfaceMockGetAllGroupMembers.RLock()
+	calls = mock.calls.GetAllGroupMembers
+	lockKeycloakInterfaceMockGetAllGroupMembers.RUnlock()
+	return calls
+}
+
+// GetAuthenticatorConfig calls GetAuthenticatorConfigFunc.
+func (mock *KeycloakInterfaceMock) GetAuthenticatorConfig(ctx context.Context, configID string, realmName string) (*v1alpha1.AuthenticatorConfig, error) {
+	if mock.GetAuthenticatorConfigFunc == nil {
+		panic("KeycloakInterfaceMock.GetAuthenticatorConfigFunc: method is nil but KeycloakInterface.GetAuthenticatorConfig was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ConfigID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ConfigID:  configID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetAuthenticatorConfig.Lock()
+	mock.calls.GetAuthenticatorConfig = append(mock.calls.GetAuthenticatorConfig, callInfo)
+	lockKeycloakInterfaceMockGetAuthenticatorConfig.Unlock()
+	return mock.GetAuthenticatorConfigFunc(ctx, configID, realmName)
+}
+
+// GetAuthenticatorConfigCalls gets all the calls that were made to GetAuthenticatorConfig.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetAuthenticatorConfigCalls())
+func (mock *KeycloakInterfaceMock) GetAuthenticatorConfigCalls() []struct {
+	Ctx       context.Context
+	ConfigID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ConfigID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetAuthenticatorConfig.RLock()
+	calls = mock.calls.GetAuthenticatorConfig
+	lockKeycloakInterfaceMockGetAuthenticatorConfig.RUnlock()
+	return calls
+}
+
+// GetAuthzPolicy calls GetAuthzPolicyFunc.
+func (mock *KeycloakInterfaceMock) GetAuthzPolicy(ctx context.Context, clientID string, policyID string, realmName string) (*AuthzPolicy, error) {
+	if mock.GetAuthzPolicyFunc == nil {
+		panic("KeycloakInterfaceMock.GetAuthzPolicyFunc: method is nil but KeycloakInterface.GetAuthzPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		PolicyID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		PolicyID:  policyID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetAuthzPolicy.Lock()
+	mock.calls.GetAuthzPolicy = append(mock.calls.GetAuthzPolicy, callInfo)
+	lockKeycloakInterfaceMockGetAuthzPolicy.Unlock()
+	return mock.GetAuthzPolicyFunc(ctx, clientID, policyID, realmName)
+}
+
+// GetAuthzPolicyCalls gets all the calls that were made to GetAuthzPolicy.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetAuthzPolicyCalls())
+func (mock *KeycloakInterfaceMock) GetAuthzPolicyCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	PolicyID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		PolicyID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetAuthzPolicy.RLock()
+	calls = mock.calls.GetAuthzPolicy
+	lockKeycloakInterfaceMockGetAuthzPolicy.RUnlock()
+	return calls
+}
+
+// GetAuthzResource calls GetAuthzResourceFunc.
+func (mock *KeycloakInterfaceMock) GetAuthzResource(ctx context.Context, clientID string, resourceID string, realmName string) (*AuthzResource, error) {
+	if mock.GetAuthzResourceFunc == nil {
+		panic("KeycloakInterfaceMock.GetAuthzResourceFunc: method is nil but KeycloakInterface.GetAuthzResource was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ClientID   string
+		ResourceID string
+		RealmName  string
+	}{
+		Ctx:        ctx,
+		ClientID:   clientID,
+		ResourceID: resourceID,
+		RealmName:  realmName,
+	}
+	lockKeycloakInterfaceMockGetAuthzResource.Lock()
+	mock.calls.GetAuthzResource = append(mock.calls.GetAuthzResource, callInfo)
+	lockKeycloakInterfaceMockGetAuthzResource.Unlock()
+	return mock.GetAuthzResourceFunc(ctx, clientID, resourceID, realmName)
+}
+
+// GetAuthzResourceCalls gets all the calls that were made to GetAuthzResource.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetAuthzResourceCalls())
+func (mock *KeycloakInterfaceMock) GetAuthzResourceCalls() []struct {
+	Ctx        context.Context
+	ClientID   string
+	ResourceID string
+	RealmName  string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ClientID   string
+		ResourceID string
+		RealmName  string
+	}
+	lockKeycloakInterfaceMockGetAuthzResource.RLock()
+	calls = mock.calls.GetAuthzResource
+	lockKeycloakInterfaceMockGetAuthzResource.RUnlock()
+	return calls
+}
+
+// GetBruteForceStatus calls GetBruteForceStatusFunc.
+func (mock *KeycloakInterfaceMock) GetBruteForceStatus(ctx context.Context, userID string, realmName string) (*BruteForceStatus, error) {
+	if mock.GetBruteForceStatusFunc == nil {
+		panic("KeycloakInterfaceMock.GetBruteForceStatusFunc: method is nil but KeycloakInterface.GetBruteForceStatus was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		UserID    string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		UserID:    userID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetBruteForceStatus.Lock()
+	mock.calls.GetBruteForceStatus = append(mock.calls.GetBruteForceStatus, callInfo)
+	lockKeycloakInterfaceMockGetBruteForceStatus.Unlock()
+	return mock.GetBruteForceStatusFunc(ctx, userID, realmName)
+}
+
+// GetBruteForceStatusCalls gets all the calls that were made to GetBruteForceStatus.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetBruteForceStatusCalls())
+func (mock *KeycloakInterfaceMock) GetBruteForceStatusCalls() []struct {
+	Ctx       context.Context
+	UserID    string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		UserID    string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetBruteForceStatus.RLock()
+	calls = mock.calls.GetBruteForceStatus
+	lockKeycloakInterfaceMockGetBruteForceStatus.RUnlock()
+	return calls
+}
+
+// GetClient calls GetClientFunc.
+func (mock *KeycloakInterfaceMock) GetClient(ctx context.Context, clientID string, realmName string) (*v1alpha1.KeycloakAPIClient, error) {
+	if mock.GetClientFunc == nil {
+		panic("KeycloakInterfaceMock.GetClientFunc: method is nil but KeycloakInterface.GetClient was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetClient.Lock()
+	mock.calls.GetClient = append(mock.calls.GetClient, callInfo)
+	lockKeycloakInterfaceMockGetClient.Unlock()
+	return mock.GetClientFunc(ctx, clientID, realmName)
+}
+
+// GetClientCalls gets all the calls that were made to GetClient.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetClientCalls())
+func (mock *KeycloakInterfaceMock) GetClientCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetClient.RLock()
+	calls = mock.calls.GetClient
+	lockKeycloakInterfaceMockGetClient.RUnlock()
+	return calls
+}
+
+// GetClientCertificate calls GetClientCertificateFunc.
+func (mock *KeycloakInterfaceMock) GetClientCertificate(ctx context.Context, clientID string, realmName string, attr string) (*Certificate, error) {
+	if mock.GetClientCertificateFunc == nil {
+		panic("KeycloakInterfaceMock.GetClientCertificateFunc: method is nil but KeycloakInterface.GetClientCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Attr      string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Attr:      attr,
+	}
+	lockKeycloakInterfaceMockGetClientCertificate.Lock()
+	mock.calls.GetClientCertificate = append(mock.calls.GetClientCertificate, callInfo)
+	lockKeycloakInterfaceMockGetClientCertificate.Unlock()
+	return mock.GetClientCertificateFunc(ctx, clientID, realmName, attr)
+}
+
+// GetClientCertificateCalls gets all the calls that were made to GetClientCertificate.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetClientCertificateCalls())
+func (mock *KeycloakInterfaceMock) GetClientCertificateCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Attr      string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Attr      string
+	}
+	lockKeycloakInterfaceMockGetClientCertificate.RLock()
+	calls = mock.calls.GetClientCertificate
+	lockKeycloakInterfaceMockGetClientCertificate.RUnlock()
+	return calls
+}
+
+// GetClientInstall calls GetClientInstallFunc.
+func (mock *KeycloakInterfaceMock) GetClientInstall(ctx context.Context, clientID string, realmName string) ([]byte, error) {
+	if mock.GetClientInstallFunc == nil {
+		panic("KeycloakInterfaceMock.GetClientInstallFunc: method is nil but KeycloakInterface.GetClientInstall was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetClientInstall.Lock()
+	mock.calls.GetClientInstall = append(mock.calls.GetClientInstall, callInfo)
+	lockKeycloakInterfaceMockGetClientInstall.Unlock()
+	return mock.GetClientInstallFunc(ctx, clientID, realmName)
+}
+
+// GetClientInstallCalls gets all the calls that were made to GetClientInstall.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetClientInstallCalls())
+func (mock *KeycloakInterfaceMock) GetClientInstallCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetClientInstall.RLock()
+	calls = mock.calls.GetClientInstall
+	lockKeycloakInterfaceMockGetClientInstall.RUnlock()
+	return calls
+}
+
+// GetClientInstallationProvider calls GetClientInstallationProviderFunc.
+func (mock *KeycloakInterfaceMock) GetClientInstallationProvider(ctx context.Context, clientID string, realmName string, providerID string) (*ClientInstallation, error) {
+	if mock.GetClientInstallationProviderFunc == nil {
+		panic("KeycloakInterfaceMock.GetClientInstallationProviderFunc: method is nil but KeycloakInterface.GetClientInstallationProvider was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ClientID   string
+		RealmName  string
+		ProviderID string
+	}{
+		Ctx:        ctx,
+		ClientID:   clientID,
+		RealmName:  realmName,
+		ProviderID: providerID,
+	}
+	lockKeycloakInterfaceMockGetClientInstallationProvider.Lock()
+	mock.calls.GetClientInstallationProvider = append(mock.calls.GetClientInstallationProvider, callInfo)
+	lockKeycloakInterfaceMockGetClientInstallationProvider.Unlock()
+	return mock.GetClientInstallationProviderFunc(ctx, clientID, realmName, providerID)
+}
+
+// GetClientInstallationProviderCalls gets all the calls that were made to GetClientInstallationProvider.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetClientInstallationProviderCalls())
+func (mock *KeycloakInterfaceMock) GetClientInstallationProviderCalls() []struct {
+	Ctx        context.Context
+	ClientID   string
+	RealmName  string
+	ProviderID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ClientID   string
+		RealmName  string
+		ProviderID string
+	}
+	lockKeycloakInterfaceMockGetClientInstallationProvider.RLock()
+	calls = mock.calls.GetClientInstallationProvider
+	lockKeycloakInterfaceMockGetClientInstallationProvider.RUnlock()
+	return calls
+}
+
+// GetClientManagementPermissions calls GetClientManagementPermissionsFunc.
+func (mock *KeycloakInterfaceMock) GetClientManagementPermissions(ctx context.Context, clientID string, realmName string) (*ManagementPermissionReference, error) {
+	if mock.GetClientManagementPermissionsFunc == nil {
+		panic("KeycloakInterfaceMock.GetClientManagementPermissionsFunc: method is nil but KeycloakInterface.GetClientManagementPermissions was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetClientManagementPermissions.Lock()
+	mock.calls.GetClientManagementPermissions = append(mock.calls.GetClientManagementPermissions, callInfo)
+	lockKeycloakInterfaceMockGetClientManagementPermissions.Unlock()
+	return mock.GetClientManagementPermissionsFunc(ctx, clientID, realmName)
+}
+
+// GetClientManagementPermissionsCalls gets all the calls that were made to GetClientManagementPermissions.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetClientManagementPermissionsCalls())
+func (mock *KeycloakInterfaceMock) GetClientManagementPermissionsCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetClientManagementPermissions.RLock()
+	calls = mock.calls.GetClientManagementPermissions
+	lockKeycloakInterfaceMockGetClientManagementPermissions.RUnlock()
+	return calls
+}
+
+// GetClientRoleByName calls GetClientRoleByNameFunc.
+func (mock *KeycloakInterfaceMock) GetClientRoleByName(ctx context.Context, clientID string, roleName string, realmName string) (*v1alpha1.KeycloakUserRole, error) {
+	if mock.GetClientRoleByNameFunc == nil {
+		panic("KeycloakInterfaceMock.GetClientRoleByNameFunc: method is nil but KeycloakInterface.GetClientRoleByName was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RoleName  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RoleName:  roleName,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetClientRoleByName.Lock()
+	mock.calls.GetClientRoleByName = append(mock.calls.GetClientRoleByName, callInfo)
+	lockKeycloakInterfaceMockGetClientRoleByName.Unlock()
+	return mock.GetClientRoleByNameFunc(ctx, clientID, roleName, realmName)
+}
+
+// GetClientRoleByNameCalls gets all the calls that were made to GetClientRoleByName.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetClientRoleByNameCalls())
+func (mock *KeycloakInterfaceMock) GetClientRoleByNameCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RoleName  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RoleName  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetClientRoleByName.RLock()
+	calls = mock.calls.GetClientRoleByName
+	lockKeycloakInterfaceMockGetClientRoleByName.RUnlock()
+	return calls
+}
+
+// GetClientScope calls GetClientScopeFunc.
+func (mock *KeycloakInterfaceMock) GetClientScope(ctx context.Context, scopeID string, realmName string) (*ClientScope, error) {
+	if mock.GetClientScopeFunc == nil {
+		panic("KeycloakInterfaceMock.GetClientScopeFunc: method is nil but KeycloakInterface.GetClientScope was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ScopeID:   scopeID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetClientScope.Lock()
+	mock.calls.GetClientScope = append(mock.calls.GetClientScope, callInfo)
+	lockKeycloakInterfaceMockGetClientScope.Unlock()
+	return mock.GetClientScopeFunc(ctx, scopeID, realmName)
+}
+
+// GetClientScopeCalls gets all the calls that were made to GetClientScope.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetClientScopeCalls())
+func (mock *KeycloakInterfaceMock) GetClientScopeCalls() []struct {
+	Ctx       context.Context
+	ScopeID   string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetClientScope.RLock()
+	calls = mock.calls.GetClientScope
+	lockKeycloakInterfaceMockGetClientScope.RUnlock()
+	return calls
+}
+
+// GetClientSecret calls GetClientSecretFunc.
+func (mock *KeycloakInterfaceMock) GetClientSecret(ctx context.Context, clientID string, realmName string) (string, error) {
+	if mock.GetClientSecretFunc == nil {
+		panic("KeycloakInterfaceMock.GetClientSecretFunc: method is nil but KeycloakInterface.GetClientSecret was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetClientSecret.Lock()
+	mock.calls.GetClientSecret = append(mock.calls.GetClientSecret, callInfo)
+	lockKeycloakInterfaceMockGetClientSecret.Unlock()
+	return mock.GetClientSecretFunc(ctx, clientID, realmName)
+}
+
+// GetClientSecretCalls gets all the calls that were made to GetClientSecret.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetClientSecretCalls())
+func (mock *KeycloakInterfaceMock) GetClientSecretCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetClientSecret.RLock()
+	calls = mock.calls.GetClientSecret
+	lockKeycloakInterfaceMockGetClientSecret.RUnlock()
+	return calls
+}
+
+// GetClientServiceAccountUser calls GetClientServiceAccountUserFunc.
+func (mock *KeycloakInterfaceMock) GetClientServiceAccountUser(ctx context.Context, clientID string, realmName string) (*v1alpha1.KeycloakAPIUser, error) {
+	if mock.GetClientServiceAccountUserFunc == nil {
+		panic("KeycloakInterfaceMock.GetClientServiceAccountUserFunc: method is nil but KeycloakInterface.GetClientServiceAccountUser was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetClientServiceAccountUser.Lock()
+	mock.calls.GetClientServiceAccountUser = append(mock.calls.GetClientServiceAccountUser, callInfo)
+	lockKeycloakInterfaceMockGetClientServiceAccountUser.Unlock()
+	return mock.GetClientServiceAccountUserFunc(ctx, clientID, realmName)
+}
+
+// GetClientServiceAccountUserCalls gets all the calls that were made to GetClientServiceAccountUser.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetClientServiceAccountUserCalls())
+func (mock *KeycloakInterfaceMock) GetClientServiceAccountUserCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetClientServiceAccountUser.RLock()
+	calls = mock.calls.GetClientServiceAccountUser
+	lockKeycloakInterfaceMockGetClientServiceAccountUser.RUnlock()
+	return calls
+}
+
+// GetGroupByPath calls GetGroupByPathFunc.
+func (mock *KeycloakInterfaceMock) GetGroupByPath(ctx context.Context, path string, realmName string) (*Group, error) {
+	if mock.GetGroupByPathFunc == nil {
+		panic("KeycloakInterfaceMock.GetGroupByPathFunc: method is nil but KeycloakInterface.GetGroupByPath was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Path      string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		Path:      path,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetGroupByPath.Lock()
+	mock.calls.GetGroupByPath = append(mock.calls.GetGroupByPath, callInfo)
+	lockKeycloakInterfaceMockGetGroupByPath.Unlock()
+	return mock.GetGroupByPathFunc(ctx, path, realmName)
+}
+
+// GetGroupByPathCalls gets all the calls that were made to GetGroupByPath.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetGroupByPathCalls())
+func (mock *KeycloakInterfaceMock) GetGroupByPathCalls() []struct {
+	Ctx       context.Context
+	Path      string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Path      string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetGroupByPath.RLock()
+	calls = mock.calls.GetGroupByPath
+	lockKeycloakInterfaceMockGetGroupByPath.RUnlock()
+	return calls
+}
+
+// GetGroupHierarchy calls GetGroupHierarchyFunc.
+func (mock *KeycloakInterfaceMock) GetGroupHierarchy(ctx context.Context, realmName string) ([]*Group, error) {
+	if mock.GetGroupHierarchyFunc == nil {
+		panic("KeycloakInterfaceMock.GetGroupHierarchyFunc: method is nil but KeycloakInterface.GetGroupHierarchy was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetGroupHierarchy.Lock()
+	mock.calls.GetGroupHierarchy = append(mock.calls.GetGroupHierarchy, callInfo)
+	lockKeycloakInterfaceMockGetGroupHierarchy.Unlock()
+	return mock.GetGroupHierarchyFunc(ctx, realmName)
+}
+
+// GetGroupHierarchyCalls gets all the calls that were made to GetGroupHierarchy.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetGroupHierarchyCalls())
+func (mock *KeycloakInterfaceMock) GetGroupHierarchyCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetGroupHierarchy.RLock()
+	calls = mock.calls.GetGroupHierarchy
+	lockKeycloakInterfaceMockGetGroupHierarchy.RUnlock()
+	return calls
+}
+
+// GetGroupManagementPermissions calls GetGroupManagementPermissionsFunc.
+func (mock *KeycloakInterfaceMock) GetGroupManagementPermissions(ctx context.Context, groupID string, realmName string) (*ManagementPermissionReference, error) {
+	if mock.GetGroupManagementPermissionsFunc == nil {
+		panic("KeycloakInterfaceMock.GetGroupManagementPermissionsFunc: method is nil but KeycloakInterface.GetGroupManagementPermissions was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupID   string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		GroupID:   groupID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetGroupManagementPermissions.Lock()
+	mock.calls.GetGroupManagementPermissions = append(mock.calls.GetGroupManagementPermissions, callInfo)
+	lockKeycloakInterfaceMockGetGroupManagementPermissions.Unlock()
+	return mock.GetGroupManagementPermissionsFunc(ctx, groupID, realmName)
+}
+
+// GetGroupManagementPermissionsCalls gets all the calls that were made to GetGroupManagementPermissions.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetGroupManagementPermissionsCalls())
+func (mock *KeycloakInterfaceMock) GetGroupManagementPermissionsCalls() []struct {
+	Ctx       context.Context
+	GroupID   string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupID   string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetGroupManagementPermissions.RLock()
+	calls = mock.calls.GetGroupManagementPermissions
+	lockKeycloakInterfaceMockGetGroupManagementPermissions.RUnlock()
+	return calls
+}
+
+// GetGroupMembers calls GetGroupMembersFunc.
+func (mock *KeycloakInterfaceMock) GetGroupMembers(ctx context.Context, groupID string, realmName string, first int, max int) ([]*v1alpha1.KeycloakAPIUser, error) {
+	if mock.GetGroupMembersFunc == nil {
+		panic("KeycloakInterfaceMock.GetGroupMembersFunc: method is nil but KeycloakInterface.GetGroupMembers was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupID   string
+		RealmName string
+		First     int
+		Max       int
+	}{
+		Ctx:       ctx,
+		GroupID:   groupID,
+		RealmName: realmName,
+		First:     first,
+		Max:       max,
+	}
+	lockKeycloakInterfaceMockGetGroupMembers.Lock()
+	mock.calls.GetGroupMembers = append(mock.calls.GetGroupMembers, callInfo)
+	lockKeycloakInterfaceMockGetGroupMembers.Unlock()
+	return mock.GetGroupMembersFunc(ctx, groupID, realmName, first, max)
+}
+
+// GetGroupMembersCalls gets all the calls that were made to GetGroupMembers.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetGroupMembersCalls())
+func (mock *KeycloakInterfaceMock) GetGroupMembersCalls() []struct {
+	Ctx       context.Context
+	GroupID   string
+	RealmName string
+	First     int
+	Max       int
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupID   string
+		RealmName string
+		First     int
+		Max       int
+	}
+	lockKeycloakInterfaceMockGetGroupMembers.RLock()
+	calls = mock.calls.GetGroupMembers
+	lockKeycloakInterfaceMockGetGroupMembers.RUnlock()
+	return calls
+}
+
+// GetIdentityProvider calls GetIdentityProviderFunc.
+func (mock *KeycloakInterfaceMock) GetIdentityProvider(ctx context.Context, alias string, realmName string) (*IdentityProvider, error) {
+	if mock.GetIdentityProviderFunc == nil {
+		panic("KeycloakInterfaceMock.GetIdentityProviderFunc: method is nil but KeycloakInterface.GetIdentityProvider was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Alias     string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		Alias:     alias,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetIdentityProvider.Lock()
+	mock.calls.GetIdentityProvider = append(mock.calls.GetIdentityProvider, callInfo)
+	lockKeycloakInterfaceMockGetIdentityProvider.Unlock()
+	return mock.GetIdentityProviderFunc(ctx, alias, realmName)
+}
+
+// GetIdentityProviderCalls gets all the calls that were made to GetIdentityProvider.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetIdentityProviderCalls())
+func (mock *KeycloakInterfaceMock) GetIdentityProviderCalls() []struct {
+	Ctx       context.Context
+	Alias     string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Alias     string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetIdentityProvider.RLock()
+	calls = mock.calls.GetIdentityProvider
+	lockKeycloakInterfaceMockGetIdentityProvider.RUnlock()
+	return calls
+}
+
+// GetJWKS calls GetJWKSFunc.
+func (mock *KeycloakInterfaceMock) GetJWKS(ctx context.Context, realmName string) (*JWKS, error) {
+	if mock.GetJWKSFunc == nil {
+		panic("KeycloakInterfaceMock.GetJWKSFunc: method is nil but KeycloakInterface.GetJWKS was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetJWKS.Lock()
+	mock.calls.GetJWKS = append(mock.calls.GetJWKS, callInfo)
+	lockKeycloakInterfaceMockGetJWKS.Unlock()
+	return mock.GetJWKSFunc(ctx, realmName)
+}
+
+// GetJWKSCalls gets all the calls that were made to GetJWKS.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetJWKSCalls())
+func (mock *KeycloakInterfaceMock) GetJWKSCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetJWKS.RLock()
+	calls = mock.calls.GetJWKS
+	lockKeycloakInterfaceMockGetJWKS.RUnlock()
+	return calls
+}
+
+// GetRealm calls GetRealmFunc.
+func (mock *KeycloakInterfaceMock) GetRealm(ctx context.Context, realmName string) (*v1alpha1.KeycloakRealm, error) {
+	if mock.GetRealmFunc == nil {
+		panic("KeycloakInterfaceMock.GetRealmFunc: method is nil but KeycloakInterface.GetRealm was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetRealm.Lock()
+	mock.calls.GetRealm = append(mock.calls.GetRealm, callInfo)
+	lockKeycloakInterfaceMockGetRealm.Unlock()
+	return mock.GetRealmFunc(ctx, realmName)
+}
+
+// GetRealmCalls gets all the calls that were made to GetRealm.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetRealmCalls())
+func (mock *KeycloakInterfaceMock) GetRealmCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetRealm.RLock()
+	calls = mock.calls.GetRealm
+	lockKeycloakInterfaceMockGetRealm.RUnlock()
+	return calls
+}
+
+// GetRealmAttribute calls GetRealmAttributeFunc.
+func (mock *KeycloakInterfaceMock) GetRealmAttribute(ctx context.Context, realmName string, key string) (string, bool, error) {
+	if mock.GetRealmAttributeFunc == nil {
+		panic("KeycloakInterfaceMock.GetRealmAttributeFunc: method is nil but KeycloakInterface.GetRealmAttribute was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		Key       string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		Key:       key,
+	}
+	lockKeycloakInterfaceMockGetRealmAttribute.Lock()
+	mock.calls.GetRealmAttribute = append(mock.calls.GetRealmAttribute, callInfo)
+	lockKeycloakInterfaceMockGetRealmAttribute.Unlock()
+	return mock.GetRealmAttributeFunc(ctx, realmName, key)
+}
+
+// GetRealmAttributeCalls gets all the calls that were made to GetRealmAttribute.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetRealmAttributeCalls())
+func (mock *KeycloakInterfaceMock) GetRealmAttributeCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	Key       string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		Key       string
+	}
+	lockKeycloakInterfaceMockGetRealmAttribute.RLock()
+	calls = mock.calls.GetRealmAttribute
+	lockKeycloakInterfaceMockGetRealmAttribute.RUnlock()
+	return calls
+}
+
+// GetRealmEventsConfig calls GetRealmEventsConfigFunc.
+func (mock *KeycloakInterfaceMock) GetRealmEventsConfig(ctx context.Context, realmName string) (*RealmEventsConfig, error) {
+	if mock.GetRealmEventsConfigFunc == nil {
+		panic("KeycloakInterfaceMock.GetRealmEventsConfigFunc: method is nil but KeycloakInterface.GetRealmEventsConfig was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetRealmEventsConfig.Lock()
+	mock.calls.GetRealmEventsConfig = append(mock.calls.GetRealmEventsConfig, callInfo)
+	lockKeycloakInterfaceMockGetRealmEventsConfig.Unlock()
+	return mock.GetRealmEventsConfigFunc(ctx, realmName)
+}
+
+// GetRealmEventsConfigCalls gets all the calls that were made to GetRealmEventsConfig.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetRealmEventsConfigCalls())
+func (mock *KeycloakInterfaceMock) GetRealmEventsConfigCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetRealmEventsConfig.RLock()
+	calls = mock.calls.GetRealmEventsConfig
+	lockKeycloakInterfaceMockGetRealmEventsConfig.RUnlock()
+	return calls
+}
+
+// GetRotatedClientSecret calls GetRotatedClientSecretFunc.
+func (mock *KeycloakInterfaceMock) GetRotatedClientSecret(ctx context.Context, clientID string, realmName string) (string, error) {
+	if mock.GetRotatedClientSecretFunc == nil {
+		panic("KeycloakInterfaceMock.GetRotatedClientSecretFunc: method is nil but KeycloakInterface.GetRotatedClientSecret was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetRotatedClientSecret.Lock()
+	mock.calls.GetRotatedClientSecret = append(mock.calls.GetRotatedClientSecret, callInfo)
+	lockKeycloakInterfaceMockGetRotatedClientSecret.Unlock()
+	return mock.GetRotatedClientSecretFunc(ctx, clientID, realmName)
+}
+
+// GetRotatedClientSecretCalls gets all the calls that were made to GetRotatedClientSecret.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetRotatedClientSecretCalls())
+func (mock *KeycloakInterfaceMock) GetRotatedClientSecretCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetRotatedClientSecret.RLock()
+	calls = mock.calls.GetRotatedClientSecret
+	lockKeycloakInterfaceMockGetRotatedClientSecret.RUnlock()
+	return calls
+}
+
+// GetServerInfo calls GetServerInfoFunc.
+func (mock *KeycloakInterfaceMock) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
+	if mock.GetServerInfoFunc == nil {
+		panic("KeycloakInterfaceMock.GetServerInfoFunc: method is nil but KeycloakInterface.GetServerInfo was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	lockKeycloakInterfaceMockGetServerInfo.Lock()
+	mock.calls.GetServerInfo = append(mock.calls.GetServerInfo, callInfo)
+	lockKeycloakInterfaceMockGetServerInfo.Unlock()
+	return mock.GetServerInfoFunc(ctx)
+}
+
+// GetServerInfoCalls gets all the calls that were made to GetServerInfo.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetServerInfoCalls())
+func (mock *KeycloakInterfaceMock) GetServerInfoCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	lockKeycloakInterfaceMockGetServerInfo.RLock()
+	calls = mock.calls.GetServerInfo
+	lockKeycloakInterfaceMockGetServerInfo.RUnlock()
+	return calls
+}
+
+// GetUser calls GetUserFunc.
+func (mock *KeycloakInterfaceMock) GetUser(ctx context.Context, userID string, realmName string) (*v1alpha1.KeycloakAPIUser, error) {
+	if mock.GetUserFunc == nil {
+		panic("KeycloakInterfaceMock.GetUserFunc: method is nil but KeycloakInterface.GetUser was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		UserID    string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		UserID:    userID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetUser.Lock()
+	mock.calls.GetUser = append(mock.calls.GetUser, callInfo)
+	lockKeycloakInterfaceMockGetUser.Unlock()
+	return mock.GetUserFunc(ctx, userID, realmName)
+}
+
+// GetUserCalls gets all the calls that were made to GetUser.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetUserCalls())
+func (mock *KeycloakInterfaceMock) GetUserCalls() []struct {
+	Ctx       context.Context
+	UserID    string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		UserID    string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetUser.RLock()
+	calls = mock.calls.GetUser
+	lockKeycloakInterfaceMockGetUser.RUnlock()
+	return calls
+}
+
+// GetUserByFederatedIdentity calls GetUserByFederatedIdentityFunc.
+func (mock *KeycloakInterfaceMock) GetUserByFederatedIdentity(ctx context.Context, realmName string, providerAlias string, externalUserID string) (*v1alpha1.KeycloakAPIUser, error) {
+	if mock.GetUserByFederatedIdentityFunc == nil {
+		panic("KeycloakInterfaceMock.GetUserByFederatedIdentityFunc: method is nil but KeycloakInterface.GetUserByFederatedIdentity was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		RealmName      string
+		ProviderAlias  string
+		ExternalUserID string
+	}{
+		Ctx:            ctx,
+		RealmName:      realmName,
+		ProviderAlias:  providerAlias,
+		ExternalUserID: externalUserID,
+	}
+	lockKeycloakInterfaceMockGetUserByFederatedIdentity.Lock()
+	mock.calls.GetUserByFederatedIdentity = append(mock.calls.GetUserByFederatedIdentity, callInfo)
+	lockKeycloakInterfaceMockGetUserByFederatedIdentity.Unlock()
+	return mock.GetUserByFederatedIdentityFunc(ctx, realmName, providerAlias, externalUserID)
+}
+
+// GetUserByFederatedIdentityCalls gets all the calls that were made to GetUserByFederatedIdentity.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetUserByFederatedIdentityCalls())
+func (mock *KeycloakInterfaceMock) GetUserByFederatedIdentityCalls() []struct {
+	Ctx            context.Context
+	RealmName      string
+	ProviderAlias  string
+	ExternalUserID string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		RealmName      string
+		ProviderAlias  string
+		ExternalUserID string
+	}
+	lockKeycloakInterfaceMockGetUserByFederatedIdentity.RLock()
+	calls = mock.calls.GetUserByFederatedIdentity
+	lockKeycloakInterfaceMockGetUserByFederatedIdentity.RUnlock()
+	return calls
+}
+
+// GetUserFederatedIdentities calls GetUserFederatedIdentitiesFunc.
+func (mock *KeycloakInterfaceMock) GetUserFederatedIdentities(ctx context.Context, userName string, realmName string) ([]v1alpha1.FederatedIdentity, error) {
+	if mock.GetUserFederatedIdentitiesFunc == nil {
+		panic("KeycloakInterfaceMock.GetUserFederatedIdentitiesFunc: method is nil but KeycloakInterface.GetUserFederatedIdentities was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		UserName  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		UserName:  userName,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetUserFederatedIdentities.Lock()
+	mock.calls.GetUserFederatedIdentities = append(mock.calls.GetUserFederatedIdentities, callInfo)
+	lockKeycloakInterfaceMockGetUserFederatedIdentities.Unlock()
+	return mock.GetUserFederatedIdentitiesFunc(ctx, userName, realmName)
+}
+
+// GetUserFederatedIdentitiesCalls gets all the calls that were made to GetUserFederatedIdentities.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetUserFederatedIdentitiesCalls())
+func (mock *KeycloakInterfaceMock) GetUserFederatedIdentitiesCalls() []struct {
+	Ctx       context.Context
+	UserName  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		UserName  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetUserFederatedIdentities.RLock()
+	calls = mock.calls.GetUserFederatedIdentities
+	lockKeycloakInterfaceMockGetUserFederatedIdentities.RUnlock()
+	return calls
+}
+
+// GetWellKnownConfiguration calls GetWellKnownConfigurationFunc.
+func (mock *KeycloakInterfaceMock) GetWellKnownConfiguration(ctx context.Context, realmName string) (*OIDCConfiguration, error) {
+	if mock.GetWellKnownConfigurationFunc == nil {
+		panic("KeycloakInterfaceMock.GetWellKnownConfigurationFunc: method is nil but KeycloakInterface.GetWellKnownConfiguration was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockGetWellKnownConfiguration.Lock()
+	mock.calls.GetWellKnownConfiguration = append(mock.calls.GetWellKnownConfiguration, callInfo)
+	lockKeycloakInterfaceMockGetWellKnownConfiguration.Unlock()
+	return mock.GetWellKnownConfigurationFunc(ctx, realmName)
+}
+
+// GetWellKnownConfigurationCalls gets all the calls that were made to GetWellKnownConfiguration.
+// Check the length with:
+//     len(mockedKeycloakInterface.GetWellKnownConfigurationCalls())
+func (mock *KeycloakInterfaceMock) GetWellKnownConfigurationCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+	}
+	lockKeycloakInterfaceMockGetWellKnownConfiguration.RLock()
+	calls = mock.calls.GetWellKnownConfiguration
+	lockKeycloakInterfaceMockGetWellKnownConfiguration.RUnlock()
+	return calls
+}
+
+// IntrospectToken calls IntrospectTokenFunc.
+func (mock *KeycloakInterfaceMock) IntrospectToken(ctx context.Context, realmName string, clientID string, clientSecret string, token string) (*TokenIntrospection, error) {
+	if mock.IntrospectTokenFunc == nil {
+		panic("KeycloakInterfaceMock.IntrospectTokenFunc: method is nil but KeycloakInterface.IntrospectToken was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		RealmName    string
+		ClientID     string
+		ClientSecret string
+		Token        string
+	}{
+		Ctx:          ctx,
+		RealmName:    realmName,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Token:        token,
+	}
+	lockKeycloakInterfaceMockIntrospectToken.Lock()
+	mock.calls.IntrospectToken = append(mock.calls.IntrospectToken, callInfo)
+	lockKeycloakInterfaceMockIntrospectToken.Unlock()
+	return mock.IntrospectTokenFunc(ctx, realmName, clientID, clientSecret, token)
+}
+
+// IntrospectTokenCalls gets all the calls that were made to IntrospectToken.
+// Check the length with:
+//     len(mockedKeycloakInterface.IntrospectTokenCalls())
+func (mock *KeycloakInterfaceMock) IntrospectTokenCalls() []struct {
+	Ctx          context.Context
+	RealmName    string
+	ClientID     string
+	ClientSecret string
+	Token        string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		RealmName    string
+		ClientID     string
+		ClientSecret string
+		Token        string
+	}
+	lockKeycloakInterfaceMockIntrospectToken.RLock()
+	calls = mock.calls.IntrospectToken
+	lockKeycloakInterfaceMockIntrospectToken.RUnlock()
+	return calls
+}
+
+// InvalidateRotatedClientSecret calls InvalidateRotatedClientSecretFunc.
+func (mock *KeycloakInterfaceMock) InvalidateRotatedClientSecret(ctx context.Context, clientID string, realmName string) error {
+	if mock.InvalidateRotatedClientSecretFunc == nil {
+		panic("KeycloakInterfaceMock.InvalidateRotatedClientSecretFunc: method is nil but KeycloakInterface.InvalidateRotatedClientSecret was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockInvalidateRotatedClientSecret.Lock()
+	mock.calls.InvalidateRotatedClientSecret = append(mock.calls.InvalidateRotatedClientSecret, callInfo)
+	lockKeycloakInterfaceMockInvalidateRotatedClientSecret.Unlock()
+	return mock.InvalidateRotatedClientSecretFunc(ctx, clientID, realmName)
+}
+
+// InvalidateRotatedClientSecretCalls gets all the calls that were made to InvalidateRotatedClientSecret.
+// Check the length with:
+//     len(mockedKeycloakInterface.InvalidateRotatedClientSecretCalls())
+func (mock *KeycloakInterfaceMock) InvalidateRotatedClientSecretCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockInvalidateRotatedClientSecret.RLock()
+	calls = mock.calls.InvalidateRotatedClientSecret
+	lockKeycloakInterfaceMockInvalidateRotatedClientSecret.RUnlock()
+	return calls
+}
+
+// ListAdminEvents calls ListAdminEventsFunc.
+func (mock *KeycloakInterfaceMock) ListAdminEvents(ctx context.Context, realmName string, params *AdminEventListParams) ([]*AdminEvent, error) {
+	if mock.ListAdminEventsFunc == nil {
+		panic("KeycloakInterfaceMock.ListAdminEventsFunc: method is nil but KeycloakInterface.ListAdminEvents was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		Params    *AdminEventListParams
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		Params:    params,
+	}
+	lockKeycloakInterfaceMockListAdminEvents.Lock()
+	mock.calls.ListAdminEvents = append(mock.calls.ListAdminEvents, callInfo)
+	lockKeycloakInterfaceMockListAdminEvents.Unlock()
+	return mock.ListAdminEventsFunc(ctx, realmName, params)
+}
+
+// ListAdminEventsCalls gets all the calls that were made to ListAdminEvents.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAdminEventsCalls())
+func (mock *KeycloakInterfaceMock) ListAdminEventsCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	Params    *AdminEventListParams
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		Params    *AdminEventListParams
+	}
+	lockKeycloakInterfaceMockListAdminEvents.RLock()
+	calls = mock.calls.ListAdminEvents
+	lockKeycloakInterfaceMockListAdminEvents.RUnlock()
+	return calls
+}
+
+// ListAuthenticationExecutionsForFlow calls ListAuthenticationExecutionsForFlowFunc.
+func (mock *KeycloakInterfaceMock) ListAuthenticationExecutionsForFlow(ctx context.Context, flowAlias string, realmName string) ([]*v1alpha1.AuthenticationExecutionInfo, error) {
+	if mock.ListAuthenticationExecutionsForFlowFunc == nil {
+		panic("KeycloakInterfaceMock.ListAuthenticationExecutionsForFlowFunc: method is nil but KeycloakInterface.ListAuthenticationExecutionsForFlow was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		FlowAlias string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		FlowAlias: flowAlias,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListAuthenticationExecutionsForFlow.Lock()
+	mock.calls.ListAuthenticationExecutionsForFlow = append(mock.calls.ListAuthenticationExecutionsForFlow, callInfo)
+	lockKeycloakInterfaceMockListAuthenticationExecutionsForFlow.Unlock()
+	return mock.ListAuthenticationExecutionsForFlowFunc(ctx, flowAlias, realmName)
+}
+
+// ListAuthenticationExecutionsForFlowCalls gets all the calls that were made to ListAuthenticationExecutionsForFlow.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAuthenticationExecutionsForFlowCalls())
+func (mock *KeycloakInterfaceMock) ListAuthenticationExecutionsForFlowCalls() []struct {
+	Ctx       context.Context
+	FlowAlias string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		FlowAlias string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListAuthenticationExecutionsForFlow.RLock()
+	calls = mock.calls.ListAuthenticationExecutionsForFlow
+	lockKeycloakInterfaceMockListAuthenticationExecutionsForFlow.RUnlock()
+	return calls
+}
+
+// ListAuthzPermissionPolicies calls ListAuthzPermissionPoliciesFunc.
+func (mock *KeycloakInterfaceMock) ListAuthzPermissionPolicies(ctx context.Context, clientID string, permissionID string, realmName string) ([]*AuthzPolicy, error) {
+	if mock.ListAuthzPermissionPoliciesFunc == nil {
+		panic("KeycloakInterfaceMock.ListAuthzPermissionPoliciesFunc: method is nil but KeycloakInterface.ListAuthzPermissionPolicies was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ClientID     string
+		PermissionID string
+		RealmName    string
+	}{
+		Ctx:          ctx,
+		ClientID:     clientID,
+		PermissionID: permissionID,
+		RealmName:    realmName,
+	}
+	lockKeycloakInterfaceMockListAuthzPermissionPolicies.Lock()
+	mock.calls.ListAuthzPermissionPolicies = append(mock.calls.ListAuthzPermissionPolicies, callInfo)
+	lockKeycloakInterfaceMockListAuthzPermissionPolicies.Unlock()
+	return mock.ListAuthzPermissionPoliciesFunc(ctx, clientID, permissionID, realmName)
+}
+
+// ListAuthzPermissionPoliciesCalls gets all the calls that were made to ListAuthzPermissionPolicies.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAuthzPermissionPoliciesCalls())
+func (mock *KeycloakInterfaceMock) ListAuthzPermissionPoliciesCalls() []struct {
+	Ctx          context.Context
+	ClientID     string
+	PermissionID string
+	RealmName    string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ClientID     string
+		PermissionID string
+		RealmName    string
+	}
+	lockKeycloakInterfaceMockListAuthzPermissionPolicies.RLock()
+	calls = mock.calls.ListAuthzPermissionPolicies
+	lockKeycloakInterfaceMockListAuthzPermissionPolicies.RUnlock()
+	return calls
+}
+
+// ListAuthzPermissionResources calls ListAuthzPermissionResourcesFunc.
+func (mock *KeycloakInterfaceMock) ListAuthzPermissionResources(ctx context.Context, clientID string, permissionID string, realmName string) ([]*AuthzResource, error) {
+	if mock.ListAuthzPermissionResourcesFunc == nil {
+		panic("KeycloakInterfaceMock.ListAuthzPermissionResourcesFunc: method is nil but KeycloakInterface.ListAuthzPermissionResources was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ClientID     string
+		PermissionID string
+		RealmName    string
+	}{
+		Ctx:          ctx,
+		ClientID:     clientID,
+		PermissionID: permissionID,
+		RealmName:    realmName,
+	}
+	lockKeycloakInterfaceMockListAuthzPermissionResources.Lock()
+	mock.calls.ListAuthzPermissionResources = append(mock.calls.ListAuthzPermissionResources, callInfo)
+	lockKeycloakInterfaceMockListAuthzPermissionResources.Unlock()
+	return mock.ListAuthzPermissionResourcesFunc(ctx, clientID, permissionID, realmName)
+}
+
+// ListAuthzPermissionResourcesCalls gets all the calls that were made to ListAuthzPermissionResources.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAuthzPermissionResourcesCalls())
+func (mock *KeycloakInterfaceMock) ListAuthzPermissionResourcesCalls() []struct {
+	Ctx          context.Context
+	ClientID     string
+	PermissionID string
+	RealmName    string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ClientID     string
+		PermissionID string
+		RealmName    string
+	}
+	lockKeycloakInterfaceMockListAuthzPermissionResources.RLock()
+	calls = mock.calls.ListAuthzPermissionResources
+	lockKeycloakInterfaceMockListAuthzPermissionResources.RUnlock()
+	return calls
+}
+
+// ListAuthzPermissionScopes calls ListAuthzPermissionScopesFunc.
+func (mock *KeycloakInterfaceMock) ListAuthzPermissionScopes(ctx context.Context, clientID string, permissionID string, realmName string) ([]*AuthzScope, error) {
+	if mock.ListAuthzPermissionScopesFunc == nil {
+		panic("KeycloakInterfaceMock.ListAuthzPermissionScopesFunc: method is nil but KeycloakInterface.ListAuthzPermissionScopes was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ClientID     string
+		PermissionID string
+		RealmName    string
+	}{
+		Ctx:          ctx,
+		ClientID:     clientID,
+		PermissionID: permissionID,
+		RealmName:    realmName,
+	}
+	lockKeycloakInterfaceMockListAuthzPermissionScopes.Lock()
+	mock.calls.ListAuthzPermissionScopes = append(mock.calls.ListAuthzPermissionScopes, callInfo)
+	lockKeycloakInterfaceMockListAuthzPermissionScopes.Unlock()
+	return mock.ListAuthzPermissionScopesFunc(ctx, clientID, permissionID, realmName)
+}
+
+// ListAuthzPermissionScopesCalls gets all the calls that were made to ListAuthzPermissionScopes.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAuthzPermissionScopesCalls())
+func (mock *KeycloakInterfaceMock) ListAuthzPermissionScopesCalls() []struct {
+	Ctx          context.Context
+	ClientID     string
+	PermissionID string
+	RealmName    string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ClientID     string
+		PermissionID string
+		RealmName    string
+	}
+	lockKeycloakInterfaceMockListAuthzPermissionScopes.RLock()
+	calls = mock.calls.ListAuthzPermissionScopes
+	lockKeycloakInterfaceMockListAuthzPermissionScopes.RUnlock()
+	return calls
+}
+
+// ListAuthzPermissions calls ListAuthzPermissionsFunc.
+func (mock *KeycloakInterfaceMock) ListAuthzPermissions(ctx context.Context, clientID string, realmName string) ([]*AuthzPermission, error) {
+	if mock.ListAuthzPermissionsFunc == nil {
+		panic("KeycloakInterfaceMock.ListAuthzPermissionsFunc: method is nil but KeycloakInterface.ListAuthzPermissions was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListAuthzPermissions.Lock()
+	mock.calls.ListAuthzPermissions = append(mock.calls.ListAuthzPermissions, callInfo)
+	lockKeycloakInterfaceMockListAuthzPermissions.Unlock()
+	return mock.ListAuthzPermissionsFunc(ctx, clientID, realmName)
+}
+
+// ListAuthzPermissionsCalls gets all the calls that were made to ListAuthzPermissions.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAuthzPermissionsCalls())
+func (mock *KeycloakInterfaceMock) ListAuthzPermissionsCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListAuthzPermissions.RLock()
+	calls = mock.calls.ListAuthzPermissions
+	lockKeycloakInterfaceMockListAuthzPermissions.RUnlock()
+	return calls
+}
+
+// ListAuthzPolicies calls ListAuthzPoliciesFunc.
+func (mock *KeycloakInterfaceMock) ListAuthzPolicies(ctx context.Context, clientID string, realmName string) ([]*AuthzPolicy, error) {
+	if mock.ListAuthzPoliciesFunc == nil {
+		panic("KeycloakInterfaceMock.ListAuthzPoliciesFunc: method is nil but KeycloakInterface.ListAuthzPolicies was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListAuthzPolicies.Lock()
+	mock.calls.ListAuthzPolicies = append(mock.calls.ListAuthzPolicies, callInfo)
+	lockKeycloakInterfaceMockListAuthzPolicies.Unlock()
+	return mock.ListAuthzPoliciesFunc(ctx, clientID, realmName)
+}
+
+// ListAuthzPoliciesCalls gets all the calls that were made to ListAuthzPolicies.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAuthzPoliciesCalls())
+func (mock *KeycloakInterfaceMock) ListAuthzPoliciesCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListAuthzPolicies.RLock()
+	calls = mock.calls.ListAuthzPolicies
+	lockKeycloakInterfaceMockListAuthzPolicies.RUnlock()
+	return calls
+}
+
+// ListAuthzResources calls ListAuthzResourcesFunc.
+func (mock *KeycloakInterfaceMock) ListAuthzResources(ctx context.Context, clientID string, realmName string, params *AuthzResourceListParams) ([]*AuthzResource, error) {
+	if mock.ListAuthzResourcesFunc == nil {
+		panic("KeycloakInterfaceMock.ListAuthzResourcesFunc: method is nil but KeycloakInterface.ListAuthzResources was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Params    *AuthzResourceListParams
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Params:    params,
+	}
+	lockKeycloakInterfaceMockListAuthzResources.Lock()
+	mock.calls.ListAuthzResources = append(mock.calls.ListAuthzResources, callInfo)
+	lockKeycloakInterfaceMockListAuthzResources.Unlock()
+	return mock.ListAuthzResourcesFunc(ctx, clientID, realmName, params)
+}
+
+// ListAuthzResourcesCalls gets all the calls that were made to ListAuthzResources.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAuthzResourcesCalls())
+func (mock *KeycloakInterfaceMock) ListAuthzResourcesCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Params    *AuthzResourceListParams
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Params    *AuthzResourceListParams
+	}
+	lockKeycloakInterfaceMockListAuthzResources.RLock()
+	calls = mock.calls.ListAuthzResources
+	lockKeycloakInterfaceMockListAuthzResources.RUnlock()
+	return calls
+}
+
+// ListAuthzScopes calls ListAuthzScopesFunc.
+func (mock *KeycloakInterfaceMock) ListAuthzScopes(ctx context.Context, clientID string, realmName string, params *AuthzScopeListParams) ([]*AuthzScope, error) {
+	if mock.ListAuthzScopesFunc == nil {
+		panic("KeycloakInterfaceMock.ListAuthzScopesFunc: method is nil but KeycloakInterface.ListAuthzScopes was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Params    *AuthzScopeListParams
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Params:    params,
+	}
+	lockKeycloakInterfaceMockListAuthzScopes.Lock()
+	mock.calls.ListAuthzScopes = append(mock.calls.ListAuthzScopes, callInfo)
+	lockKeycloakInterfaceMockListAuthzScopes.Unlock()
+	return mock.ListAuthzScopesFunc(ctx, clientID, realmName, params)
+}
+
+// ListAuthzScopesCalls gets all the calls that were made to ListAuthzScopes.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAuthzScopesCalls())
+func (mock *KeycloakInterfaceMock) ListAuthzScopesCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Params    *AuthzScopeListParams
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Params    *AuthzScopeListParams
+	}
+	lockKeycloakInterfaceMockListAuthzScopes.RLock()
+	calls = mock.calls.ListAuthzScopes
+	lockKeycloakInterfaceMockListAuthzScopes.RUnlock()
+	return calls
+}
+
+// ListAvailableClientScopeClientScopeMappings calls ListAvailableClientScopeClientScopeMappingsFunc.
+func (mock *KeycloakInterfaceMock) ListAvailableClientScopeClientScopeMappings(ctx context.Context, scopeID string, targetClientID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListAvailableClientScopeClientScopeMappingsFunc == nil {
+		panic("KeycloakInterfaceMock.ListAvailableClientScopeClientScopeMappingsFunc: method is nil but KeycloakInterface.ListAvailableClientScopeClientScopeMappings was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		ScopeID        string
+		TargetClientID string
+		RealmName      string
+	}{
+		Ctx:            ctx,
+		ScopeID:        scopeID,
+		TargetClientID: targetClientID,
+		RealmName:      realmName,
+	}
+	lockKeycloakInterfaceMockListAvailableClientScopeClientScopeMappings.Lock()
+	mock.calls.ListAvailableClientScopeClientScopeMappings = append(mock.calls.ListAvailableClientScopeClientScopeMappings, callInfo)
+	lockKeycloakInterfaceMockListAvailableClientScopeClientScopeMappings.Unlock()
+	return mock.ListAvailableClientScopeClientScopeMappingsFunc(ctx, scopeID, targetClientID, realmName)
+}
+
+// ListAvailableClientScopeClientScopeMappingsCalls gets all the calls that were made to ListAvailableClientScopeClientScopeMappings.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAvailableClientScopeClientScopeMappingsCalls())
+func (mock *KeycloakInterfaceMock) ListAvailableClientScopeClientScopeMappingsCalls() []struct {
+	Ctx            context.Context
+	ScopeID        string
+	TargetClientID string
+	RealmName      string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		ScopeID        string
+		TargetClientID string
+		RealmName      string
+	}
+	lockKeycloakInterfaceMockListAvailableClientScopeClientScopeMappings.RLock()
+	calls = mock.calls.ListAvailableClientScopeClientScopeMappings
+	lockKeycloakInterfaceMockListAvailableClientScopeClientScopeMappings.RUnlock()
+	return calls
+}
+
+// ListAvailableClientScopeRealmScopeMappings calls ListAvailableClientScopeRealmScopeMappingsFunc.
+func (mock *KeycloakInterfaceMock) ListAvailableClientScopeRealmScopeMappings(ctx context.Context, scopeID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListAvailableClientScopeRealmScopeMappingsFunc == nil {
+		panic("KeycloakInterfaceMock.ListAvailableClientScopeRealmScopeMappingsFunc: method is nil but KeycloakInterface.ListAvailableClientScopeRealmScopeMappings was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ScopeID:   scopeID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListAvailableClientScopeRealmScopeMappings.Lock()
+	mock.calls.ListAvailableClientScopeRealmScopeMappings = append(mock.calls.ListAvailableClientScopeRealmScopeMappings, callInfo)
+	lockKeycloakInterfaceMockListAvailableClientScopeRealmScopeMappings.Unlock()
+	return mock.ListAvailableClientScopeRealmScopeMappingsFunc(ctx, scopeID, realmName)
+}
+
+// ListAvailableClientScopeRealmScopeMappingsCalls gets all the calls that were made to ListAvailableClientScopeRealmScopeMappings.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAvailableClientScopeRealmScopeMappingsCalls())
+func (mock *KeycloakInterfaceMock) ListAvailableClientScopeRealmScopeMappingsCalls() []struct {
+	Ctx       context.Context
+	ScopeID   string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListAvailableClientScopeRealmScopeMappings.RLock()
+	calls = mock.calls.ListAvailableClientScopeRealmScopeMappings
+	lockKeycloakInterfaceMockListAvailableClientScopeRealmScopeMappings.RUnlock()
+	return calls
+}
+
+// ListAvailableGroupClientRoles calls ListAvailableGroupClientRolesFunc.
+func (mock *KeycloakInterfaceMock) ListAvailableGroupClientRoles(ctx context.Context, realmName string, clientID string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListAvailableGroupClientRolesFunc == nil {
+		panic("KeycloakInterfaceMock.ListAvailableGroupClientRolesFunc: method is nil but KeycloakInterface.ListAvailableGroupClientRoles was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		ClientID  string
+		GroupID   string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		ClientID:  clientID,
+		GroupID:   groupID,
+	}
+	lockKeycloakInterfaceMockListAvailableGroupClientRoles.Lock()
+	mock.calls.ListAvailableGroupClientRoles = append(mock.calls.ListAvailableGroupClientRoles, callInfo)
+	lockKeycloakInterfaceMockListAvailableGroupClientRoles.Unlock()
+	return mock.ListAvailableGroupClientRolesFunc(ctx, realmName, clientID, groupID)
+}
+
+// ListAvailableGroupClientRolesCalls gets all the calls that were made to ListAvailableGroupClientRoles.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAvailableGroupClientRolesCalls())
+func (mock *KeycloakInterfaceMock) ListAvailableGroupClientRolesCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	ClientID  string
+	GroupID   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		ClientID  string
+		GroupID   string
+	}
+	lockKeycloakInterfaceMockListAvailableGroupClientRoles.RLock()
+	calls = mock.calls.ListAvailableGroupClientRoles
+	lockKeycloakInterfaceMockListAvailableGroupClientRoles.RUnlock()
+	return calls
+}
+
+// ListAvailableGroupRealmRoles calls ListAvailableGroupRealmRolesFunc.
+func (mock *KeycloakInterfaceMock) ListAvailableGroupRealmRoles(ctx context.Context, realmName string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListAvailableGroupRealmRolesFunc == nil {
+		panic("KeycloakInterfaceMock.ListAvailableGroupRealmRolesFunc: method is nil but KeycloakInterface.ListAvailableGroupRealmRoles was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		GroupID   string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		GroupID:   groupID,
+	}
+	lockKeycloakInterfaceMockListAvailableGroupRealmRoles.Lock()
+	mock.calls.ListAvailableGroupRealmRoles = append(mock.calls.ListAvailableGroupRealmRoles, callInfo)
+	lockKeycloakInterfaceMockListAvailableGroupRealmRoles.Unlock()
+	return mock.ListAvailableGroupRealmRolesFunc(ctx, realmName, groupID)
+}
+
+// ListAvailableGroupRealmRolesCalls gets all the calls that were made to ListAvailableGroupRealmRoles.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAvailableGroupRealmRolesCalls())
+func (mock *KeycloakInterfaceMock) ListAvailableGroupRealmRolesCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	GroupID   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		GroupID   string
+	}
+	lockKeycloakInterfaceMockListAvailableGroupRealmRoles.RLock()
+	calls = mock.calls.ListAvailableGroupRealmRoles
+	lockKeycloakInterfaceMockListAvailableGroupRealmRoles.RUnlock()
+	return calls
+}
+
+// ListAvailableUserClientRoles calls ListAvailableUserClientRolesFunc.
+func (mock *KeycloakInterfaceMock) ListAvailableUserClientRoles(ctx context.Context, realmName string, clientID string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListAvailableUserClientRolesFunc == nil {
+		panic("KeycloakInterfaceMock.ListAvailableUserClientRolesFunc: method is nil but KeycloakInterface.ListAvailableUserClientRoles was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		ClientID  string
+		UserID    string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		ClientID:  clientID,
+		UserID:    userID,
+	}
+	lockKeycloakInterfaceMockListAvailableUserClientRoles.Lock()
+	mock.calls.ListAvailableUserClientRoles = append(mock.calls.ListAvailableUserClientRoles, callInfo)
+	lockKeycloakInterfaceMockListAvailableUserClientRoles.Unlock()
+	return mock.ListAvailableUserClientRolesFunc(ctx, realmName, clientID, userID)
+}
+
+// ListAvailableUserClientRolesCalls gets all the calls that were made to ListAvailableUserClientRoles.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAvailableUserClientRolesCalls())
+func (mock *KeycloakInterfaceMock) ListAvailableUserClientRolesCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	ClientID  string
+	UserID    string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		ClientID  string
+		UserID    string
+	}
+	lockKeycloakInterfaceMockListAvailableUserClientRoles.RLock()
+	calls = mock.calls.ListAvailableUserClientRoles
+	lockKeycloakInterfaceMockListAvailableUserClientRoles.RUnlock()
+	return calls
+}
+
+// ListAvailableUserRealmRoles calls ListAvailableUserRealmRolesFunc.
+func (mock *KeycloakInterfaceMock) ListAvailableUserRealmRoles(ctx context.Context, realmName string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListAvailableUserRealmRolesFunc == nil {
+		panic("KeycloakInterfaceMock.ListAvailableUserRealmRolesFunc: method is nil but KeycloakInterface.ListAvailableUserRealmRoles was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		UserID    string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		UserID:    userID,
+	}
+	lockKeycloakInterfaceMockListAvailableUserRealmRoles.Lock()
+	mock.calls.ListAvailableUserRealmRoles = append(mock.calls.ListAvailableUserRealmRoles, callInfo)
+	lockKeycloakInterfaceMockListAvailableUserRealmRoles.Unlock()
+	return mock.ListAvailableUserRealmRolesFunc(ctx, realmName, userID)
+}
+
+// ListAvailableUserRealmRolesCalls gets all the calls that were made to ListAvailableUserRealmRoles.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListAvailableUserRealmRolesCalls())
+func (mock *KeycloakInterfaceMock) ListAvailableUserRealmRolesCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	UserID    string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		UserID    string
+	}
+	lockKeycloakInterfaceMockListAvailableUserRealmRoles.RLock()
+	calls = mock.calls.ListAvailableUserRealmRoles
+	lockKeycloakInterfaceMockListAvailableUserRealmRoles.RUnlock()
+	return calls
+}
+
+// ListClientOfflineSessions calls ListClientOfflineSessionsFunc.
+func (mock *KeycloakInterfaceMock) ListClientOfflineSessions(ctx context.Context, clientID string, realmName string, first int, max int) ([]*UserSession, error) {
+	if mock.ListClientOfflineSessionsFunc == nil {
+		panic("KeycloakInterfaceMock.ListClientOfflineSessionsFunc: method is nil but KeycloakInterface.ListClientOfflineSessions was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		First     int
+		Max       int
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		First:     first,
+		Max:       max,
+	}
+	lockKeycloakInterfaceMockListClientOfflineSessions.Lock()
+	mock.calls.ListClientOfflineSessions = append(mock.calls.ListClientOfflineSessions, callInfo)
+	lockKeycloakInterfaceMockListClientOfflineSessions.Unlock()
+	return mock.ListClientOfflineSessionsFunc(ctx, clientID, realmName, first, max)
+}
+
+// ListClientOfflineSessionsCalls gets all the calls that were made to ListClientOfflineSessions.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListClientOfflineSessionsCalls())
+func (mock *KeycloakInterfaceMock) ListClientOfflineSessionsCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	First     int
+	Max       int
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		First     int
+		Max       int
+	}
+	lockKeycloakInterfaceMockListClientOfflineSessions.RLock()
+	calls = mock.calls.ListClientOfflineSessions
+	lockKeycloakInterfaceMockListClientOfflineSessions.RUnlock()
+	return calls
+}
+
+// ListClientRoleClientComposites calls ListClientRoleClientCompositesFunc.
+func (mock *KeycloakInterfaceMock) ListClientRoleClientComposites(ctx context.Context, clientID string, roleName string, otherClientID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListClientRoleClientCompositesFunc == nil {
+		panic("KeycloakInterfaceMock.ListClientRoleClientCompositesFunc: method is nil but KeycloakInterface.ListClientRoleClientComposites was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ClientID      string
+		RoleName      string
+		OtherClientID string
+		RealmName     string
+	}{
+		Ctx:           ctx,
+		ClientID:      clientID,
+		RoleName:      roleName,
+		OtherClientID: otherClientID,
+		RealmName:     realmName,
+	}
+	lockKeycloakInterfaceMockListClientRoleClientComposites.Lock()
+	mock.calls.ListClientRoleClientComposites = append(mock.calls.ListClientRoleClientComposites, callInfo)
+	lockKeycloakInterfaceMockListClientRoleClientComposites.Unlock()
+	return mock.ListClientRoleClientCompositesFunc(ctx, clientID, roleName, otherClientID, realmName)
+}
+
+// ListClientRoleClientCompositesCalls gets all the calls that were made to ListClientRoleClientComposites.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListClientRoleClientCompositesCalls())
+func (mock *KeycloakInterfaceMock) ListClientRoleClientCompositesCalls() []struct {
+	Ctx           context.Context
+	ClientID      string
+	RoleName      string
+	OtherClientID string
+	RealmName     string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ClientID      string
+		RoleName      string
+		OtherClientID string
+		RealmName     string
+	}
+	lockKeycloakInterfaceMockListClientRoleClientComposites.RLock()
+	calls = mock.calls.ListClientRoleClientComposites
+	lockKeycloakInterfaceMockListClientRoleClientComposites.RUnlock()
+	return calls
+}
+
+// ListClientRoleComposites calls ListClientRoleCompositesFunc.
+func (mock *KeycloakInterfaceMock) ListClientRoleComposites(ctx context.Context, clientID string, roleName string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListClientRoleCompositesFunc == nil {
+		panic("KeycloakInterfaceMock.ListClientRoleCompositesFunc: method is nil but KeycloakInterface.ListClientRoleComposites was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RoleName  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RoleName:  roleName,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListClientRoleComposites.Lock()
+	mock.calls.ListClientRoleComposites = append(mock.calls.ListClientRoleComposites, callInfo)
+	lockKeycloakInterfaceMockListClientRoleComposites.Unlock()
+	return mock.ListClientRoleCompositesFunc(ctx, clientID, roleName, realmName)
+}
+
+// ListClientRoleCompositesCalls gets all the calls that were made to ListClientRoleComposites.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListClientRoleCompositesCalls())
+func (mock *KeycloakInterfaceMock) ListClientRoleCompositesCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RoleName  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RoleName  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListClientRoleComposites.RLock()
+	calls = mock.calls.ListClientRoleComposites
+	lockKeycloakInterfaceMockListClientRoleComposites.RUnlock()
+	return calls
+}
+
+// ListClientRoleRealmComposites calls ListClientRoleRealmCompositesFunc.
+func (mock *KeycloakInterfaceMock) ListClientRoleRealmComposites(ctx context.Context, clientID string, roleName string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListClientRoleRealmCompositesFunc == nil {
+		panic("KeycloakInterfaceMock.ListClientRoleRealmCompositesFunc: method is nil but KeycloakInterface.ListClientRoleRealmComposites was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RoleName  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RoleName:  roleName,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListClientRoleRealmComposites.Lock()
+	mock.calls.ListClientRoleRealmComposites = append(mock.calls.ListClientRoleRealmComposites, callInfo)
+	lockKeycloakInterfaceMockListClientRoleRealmComposites.Unlock()
+	return mock.ListClientRoleRealmCompositesFunc(ctx, clientID, roleName, realmName)
+}
+
+// ListClientRoleRealmCompositesCalls gets all the calls that were made to ListClientRoleRealmComposites.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListClientRoleRealmCompositesCalls())
+func (mock *KeycloakInterfaceMock) ListClientRoleRealmCompositesCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RoleName  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RoleName  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListClientRoleRealmComposites.RLock()
+	calls = mock.calls.ListClientRoleRealmComposites
+	lockKeycloakInterfaceMockListClientRoleRealmComposites.RUnlock()
+	return calls
+}
+
+// ListClientRoles calls ListClientRolesFunc.
+func (mock *KeycloakInterfaceMock) ListClientRoles(ctx context.Context, clientID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListClientRolesFunc == nil {
+		panic("KeycloakInterfaceMock.ListClientRolesFunc: method is nil but KeycloakInterface.ListClientRoles was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListClientRoles.Lock()
+	mock.calls.ListClientRoles = append(mock.calls.ListClientRoles, callInfo)
+	lockKeycloakInterfaceMockListClientRoles.Unlock()
+	return mock.ListClientRolesFunc(ctx, clientID, realmName)
+}
+
+// ListClientRolesCalls gets all the calls that were made to ListClientRoles.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListClientRolesCalls())
+func (mock *KeycloakInterfaceMock) ListClientRolesCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListClientRoles.RLock()
+	calls = mock.calls.ListClientRoles
+	lockKeycloakInterfaceMockListClientRoles.RUnlock()
+	return calls
+}
+
+// ListClientScopeClientScopeMappings calls ListClientScopeClientScopeMappingsFunc.
+func (mock *KeycloakInterfaceMock) ListClientScopeClientScopeMappings(ctx context.Context, scopeID string, targetClientID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListClientScopeClientScopeMappingsFunc == nil {
+		panic("KeycloakInterfaceMock.ListClientScopeClientScopeMappingsFunc: method is nil but KeycloakInterface.ListClientScopeClientScopeMappings was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		ScopeID        string
+		TargetClientID string
+		RealmName      string
+	}{
+		Ctx:            ctx,
+		ScopeID:        scopeID,
+		TargetClientID: targetClientID,
+		RealmName:      realmName,
+	}
+	lockKeycloakInterfaceMockListClientScopeClientScopeMappings.Lock()
+	mock.calls.ListClientScopeClientScopeMappings = append(mock.calls.ListClientScopeClientScopeMappings, callInfo)
+	lockKeycloakInterfaceMockListClientScopeClientScopeMappings.Unlock()
+	return mock.ListClientScopeClientScopeMappingsFunc(ctx, scopeID, targetClientID, realmName)
+}
+
+// ListClientScopeClientScopeMappingsCalls gets all the calls that were made to ListClientScopeClientScopeMappings.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListClientScopeClientScopeMappingsCalls())
+func (mock *KeycloakInterfaceMock) ListClientScopeClientScopeMappingsCalls() []struct {
+	Ctx            context.Context
+	ScopeID        string
+	TargetClientID string
+	RealmName      string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		ScopeID        string
+		TargetClientID string
+		RealmName      string
+	}
+	lockKeycloakInterfaceMockListClientScopeClientScopeMappings.RLock()
+	calls = mock.calls.ListClientScopeClientScopeMappings
+	lockKeycloakInterfaceMockListClientScopeClientScopeMappings.RUnlock()
+	return calls
+}
+
+// ListClientScopeRealmScopeMappings calls ListClientScopeRealmScopeMappingsFunc.
+func (mock *KeycloakInterfaceMock) ListClientScopeRealmScopeMappings(ctx context.Context, scopeID string, realmName string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListClientScopeRealmScopeMappingsFunc == nil {
+		panic("KeycloakInterfaceMock.ListClientScopeRealmScopeMappingsFunc: method is nil but KeycloakInterface.ListClientScopeRealmScopeMappings was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ScopeID:   scopeID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListClientScopeRealmScopeMappings.Lock()
+	mock.calls.ListClientScopeRealmScopeMappings = append(mock.calls.ListClientScopeRealmScopeMappings, callInfo)
+	lockKeycloakInterfaceMockListClientScopeRealmScopeMappings.Unlock()
+	return mock.ListClientScopeRealmScopeMappingsFunc(ctx, scopeID, realmName)
+}
+
+// ListClientScopeRealmScopeMappingsCalls gets all the calls that were made to ListClientScopeRealmScopeMappings.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListClientScopeRealmScopeMappingsCalls())
+func (mock *KeycloakInterfaceMock) ListClientScopeRealmScopeMappingsCalls() []struct {
+	Ctx       context.Context
+	ScopeID   string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListClientScopeRealmScopeMappings.RLock()
+	calls = mock.calls.ListClientScopeRealmScopeMappings
+	lockKeycloakInterfaceMockListClientScopeRealmScopeMappings.RUnlock()
+	return calls
+}
+
+// ListClientScopes calls ListClientScopesFunc.
+func (mock *KeycloakInterfaceMock) ListClientScopes(ctx context.Context, realmName string) ([]*ClientScope, error) {
+	if mock.ListClientScopesFunc == nil {
+		panic("KeycloakInterfaceMock.ListClientScopesFunc: method is nil but KeycloakInterface.ListClientScopes was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListClientScopes.Lock()
+	mock.calls.ListClientScopes = append(mock.calls.ListClientScopes, callInfo)
+	lockKeycloakInterfaceMockListClientScopes.Unlock()
+	return mock.ListClientScopesFunc(ctx, realmName)
+}
+
+// ListClientScopesCalls gets all the calls that were made to ListClientScopes.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListClientScopesCalls())
+func (mock *KeycloakInterfaceMock) ListClientScopesCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListClientScopes.RLock()
+	calls = mock.calls.ListClientScopes
+	lockKeycloakInterfaceMockListClientScopes.RUnlock()
+	return calls
+}
+
+// ListClientSessions calls ListClientSessionsFunc.
+func (mock *KeycloakInterfaceMock) ListClientSessions(ctx context.Context, clientID string, realmName string) ([]*UserSession, error) {
+	if mock.ListClientSessionsFunc == nil {
+		panic("KeycloakInterfaceMock.ListClientSessionsFunc: method is nil but KeycloakInterface.ListClientSessions was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListClientSessions.Lock()
+	mock.calls.ListClientSessions = append(mock.calls.ListClientSessions, callInfo)
+	lockKeycloakInterfaceMockListClientSessions.Unlock()
+	return mock.ListClientSessionsFunc(ctx, clientID, realmName)
+}
+
+// ListClientSessionsCalls gets all the calls that were made to ListClientSessions.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListClientSessionsCalls())
+func (mock *KeycloakInterfaceMock) ListClientSessionsCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListClientSessions.RLock()
+	calls = mock.calls.ListClientSessions
+	lockKeycloakInterfaceMockListClientSessions.RUnlock()
+	return calls
+}
+
+// ListClientUserSessions calls ListClientUserSessionsFunc.
+func (mock *KeycloakInterfaceMock) ListClientUserSessions(ctx context.Context, clientID string, realmName string, first int, max int) ([]*UserSession, error) {
+	if mock.ListClientUserSessionsFunc == nil {
+		panic("KeycloakInterfaceMock.ListClientUserSessionsFunc: method is nil but KeycloakInterface.ListClientUserSessions was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		First     int
+		Max       int
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		First:     first,
+		Max:       max,
+	}
+	lockKeycloakInterfaceMockListClientUserSessions.Lock()
+	mock.calls.ListClientUserSessions = append(mock.calls.ListClientUserSessions, callInfo)
+	lockKeycloakInterfaceMockListClientUserSessions.Unlock()
+	return mock.ListClientUserSessionsFunc(ctx, clientID, realmName, first, max)
+}
+
+// ListClientUserSessionsCalls gets all the calls that were made to ListClientUserSessions.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListClientUserSessionsCalls())
+func (mock *KeycloakInterfaceMock) ListClientUserSessionsCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	First     int
+	Max       int
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		First     int
+		Max       int
+	}
+	lockKeycloakInterfaceMockListClientUserSessions.RLock()
+	calls = mock.calls.ListClientUserSessions
+	lockKeycloakInterfaceMockListClientUserSessions.RUnlock()
+	return calls
+}
+
+// ListClients calls ListClientsFunc.
+func (mock *KeycloakInterfaceMock) ListClients(ctx context.Context, realmName string) ([]*v1alpha1.KeycloakAPIClient, error) {
+	if mock.ListClientsFunc == nil {
+		panic("KeycloakInterfaceMock.ListClientsFunc: method is nil but KeycloakInterface.ListClients was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListClients.Lock()
+	mock.calls.ListClients = append(mock.calls.ListClients, callInfo)
+	lockKeycloakInterfaceMockListClients.Unlock()
+	return mock.ListClientsFunc(ctx, realmName)
+}
+
+// ListClientsCalls gets all the calls that were made to ListClients.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListClientsCalls())
+func (mock *KeycloakInterfaceMock) ListClientsCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListClients.RLock()
+	calls = mock.calls.ListClients
+	lockKeycloakInterfaceMockListClients.RUnlock()
+	return calls
+}
+
+// ListClientsWithParams calls ListClientsWithParamsFunc.
+func (mock *KeycloakInterfaceMock) ListClientsWithParams(ctx context.Context, realmName string, params *ClientListParams) ([]*v1alpha1.KeycloakAPIClient, error) {
+	if mock.ListClientsWithParamsFunc == nil {
+		panic("KeycloakInterfaceMock.ListClientsWithParamsFunc: method is nil but KeycloakInterface.ListClientsWithParams was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		Params    *ClientListParams
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		Params:    params,
+	}
+	lockKeycloakInterfaceMockListClientsWithParams.Lock()
+	mock.calls.ListClientsWithParams = append(mock.calls.ListClientsWithParams, callInfo)
+	lockKeycloakInterfaceMockListClientsWithParams.Unlock()
+	return mock.ListClientsWithParamsFunc(ctx, realmName, params)
+}
+
+// ListClientsWithParamsCalls gets all the calls that were made to ListClientsWithParams.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListClientsWithParamsCalls())
+func (mock *KeycloakInterfaceMock) ListClientsWithParamsCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	Params    *ClientListParams
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		Params    *ClientListParams
+	}
+	lockKeycloakInterfaceMockListClientsWithParams.RLock()
+	calls = mock.calls.ListClientsWithParams
+	lockKeycloakInterfaceMockListClientsWithParams.RUnlock()
+	return calls
+}
+
+// ListComponents calls ListComponentsFunc.
+func (mock *KeycloakInterfaceMock) ListComponents(ctx context.Context, realmName string, params *ComponentListParams) ([]*Component, error) {
+	if mock.ListComponentsFunc == nil {
+		panic("KeycloakInterfaceMock.ListComponentsFunc: method is nil but KeycloakInterface.ListComponents was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		Params    *ComponentListParams
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		Params:    params,
+	}
+	lockKeycloakInterfaceMockListComponents.Lock()
+	mock.calls.ListComponents = append(mock.calls.ListComponents, callInfo)
+	lockKeycloakInterfaceMockListComponents.Unlock()
+	return mock.ListComponentsFunc(ctx, realmName, params)
+}
+
+// ListComponentsCalls gets all the calls that were made to ListComponents.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListComponentsCalls())
+func (mock *KeycloakInterfaceMock) ListComponentsCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	Params    *ComponentListParams
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		Params    *ComponentListParams
+	}
+	lockKeycloakInterfaceMockListComponents.RLock()
+	calls = mock.calls.ListComponents
+	lockKeycloakInterfaceMockListComponents.RUnlock()
+	return calls
+}
+
+// ListDefaultClientScopesForClient calls ListDefaultClientScopesForClientFunc.
+func (mock *KeycloakInterfaceMock) ListDefaultClientScopesForClient(ctx context.Context, clientID string, realmName string) ([]*ClientScope, error) {
+	if mock.ListDefaultClientScopesForClientFunc == nil {
+		panic("KeycloakInterfaceMock.ListDefaultClientScopesForClientFunc: method is nil but KeycloakInterface.ListDefaultClientScopesForClient was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListDefaultClientScopesForClient.Lock()
+	mock.calls.ListDefaultClientScopesForClient = append(mock.calls.ListDefaultClientScopesForClient, callInfo)
+	lockKeycloakInterfaceMockListDefaultClientScopesForClient.Unlock()
+	return mock.ListDefaultClientScopesForClientFunc(ctx, clientID, realmName)
+}
+
+// ListDefaultClientScopesForClientCalls gets all the calls that were made to ListDefaultClientScopesForClient.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListDefaultClientScopesForClientCalls())
+func (mock *KeycloakInterfaceMock) ListDefaultClientScopesForClientCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListDefaultClientScopesForClient.RLock()
+	calls = mock.calls.ListDefaultClientScopesForClient
+	lockKeycloakInterfaceMockListDefaultClientScopesForClient.RUnlock()
+	return calls
+}
+
+// ListDefaultGroups calls ListDefaultGroupsFunc.
+func (mock *KeycloakInterfaceMock) ListDefaultGroups(ctx context.Context, realmName string) ([]*Group, error) {
+	if mock.ListDefaultGroupsFunc == nil {
+		panic("KeycloakInterfaceMock.ListDefaultGroupsFunc: method is nil but KeycloakInterface.ListDefaultGroups was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+	}
+	lockKeycloakInterfaceMockListDefaultGroups.Lock()
+	mock.calls.ListDefaultGroups = append(mock.calls.ListDefaultGroups, callInfo)
+	lockKeycloakInterfaceMockListDefaultGroups.Unlock()
+	return mock.ListDefaultGroupsFunc(ctx, realmName)
+}
+
+// ListDefaultGroupsCalls gets all the calls that were made to ListDefaultGroups.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListDefaultGroupsCalls())
+func (mock *KeycloakInterfaceMock) ListDefaultGroupsCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+	}
+	lockKeycloakInterfaceMockListDefaultGroups.RLock()
+	calls = mock.calls.ListDefaultGroups
+	lockKeycloakInterfaceMockListDefaultGroups.RUnlock()
+	return calls
+}
+
+// ListEvaluatedProtocolMappers calls ListEvaluatedProtocolMappersFunc.
+func (mock *KeycloakInterfaceMock) ListEvaluatedProtocolMappers(ctx context.Context, clientID string, realmName string, scope string) ([]*ProtocolMapperEvaluation, error) {
+	if mock.ListEvaluatedProtocolMappersFunc == nil {
+		panic("KeycloakInterfaceMock.ListEvaluatedProtocolMappersFunc: method is nil but KeycloakInterface.ListEvaluatedProtocolMappers was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Scope     string
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Scope:     scope,
+	}
+	lockKeycloakInterfaceMockListEvaluatedProtocolMappers.Lock()
+	mock.calls.ListEvaluatedProtocolMappers = append(mock.calls.ListEvaluatedProtocolMappers, callInfo)
+	lockKeycloakInterfaceMockListEvaluatedProtocolMappers.Unlock()
+	return mock.ListEvaluatedProtocolMappersFunc(ctx, clientID, realmName, scope)
+}
+
+// ListEvaluatedProtocolMappersCalls gets all the calls that were made to ListEvaluatedProtocolMappers.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListEvaluatedProtocolMappersCalls())
+func (mock *KeycloakInterfaceMock) ListEvaluatedProtocolMappersCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Scope     string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Scope     string
+	}
+	lockKeycloakInterfaceMockListEvaluatedProtocolMappers.RLock()
+	calls = mock.calls.ListEvaluatedProtocolMappers
+	lockKeycloakInterfaceMockListEvaluatedProtocolMappers.RUnlock()
+	return calls
+}
+
+// ListGroupClientRoles calls ListGroupClientRolesFunc.
+func (mock *KeycloakInterfaceMock) ListGroupClientRoles(ctx context.Context, realmName string, clientID string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListGroupClientRolesFunc == nil {
+		panic("KeycloakInterfaceMock.ListGroupClientRolesFunc: method is nil but KeycloakInterface.ListGroupClientRoles was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		ClientID  string
+		GroupID   string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		ClientID:  clientID,
+		GroupID:   groupID,
+	}
+	lockKeycloakInterfaceMockListGroupClientRoles.Lock()
+	mock.calls.ListGroupClientRoles = append(mock.calls.ListGroupClientRoles, callInfo)
+	lockKeycloakInterfaceMockListGroupClientRoles.Unlock()
+	return mock.ListGroupClientRolesFunc(ctx, realmName, clientID, groupID)
+}
+
+// ListGroupClientRolesCalls gets all the calls that were made to ListGroupClientRoles.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListGroupClientRolesCalls())
+func (mock *KeycloakInterfaceMock) ListGroupClientRolesCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	ClientID  string
+	GroupID   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		ClientID  string
+		GroupID   string
+	}
+	lockKeycloakInterfaceMockListGroupClientRoles.RLock()
+	calls = mock.calls.ListGroupClientRoles
+	lockKeycloakInterfaceMockListGroupClientRoles.RUnlock()
+	return calls
+}
+
+// ListGroupRealmRoles calls ListGroupRealmRolesFunc.
+func (mock *KeycloakInterfaceMock) ListGroupRealmRoles(ctx context.Context, realmName string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListGroupRealmRolesFunc == nil {
+		panic("KeycloakInterfaceMock.ListGroupRealmRolesFunc: method is nil but KeycloakInterface.ListGroupRealmRoles was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		GroupID   string
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		GroupID:   groupID,
+	}
+	lockKeycloakInterfaceMockListGroupRealmRoles.Lock()
+	mock.calls.ListGroupRealmRoles = append(mock.calls.ListGroupRealmRoles, callInfo)
+	lockKeycloakInterfaceMockListGroupRealmRoles.Unlock()
+	return mock.ListGroupRealmRolesFunc(ctx, realmName, groupID)
+}
+
+// ListGroupRealmRolesCalls gets all the calls that were made to ListGroupRealmRoles.
+// Check the length with:
+//     len(mockedKeycloakInterface.ListGroupRealmRolesCalls())
+func (mock *KeycloakInterfaceMock) ListGroupRealmRolesCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	GroupID   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		GroupID   string
 	}
+	lockKeycloakInterfaceMockListGroupRealmRoles.RLock()
+	calls = mock.calls.ListGroupRealmRoles
+	lockKeycloakInterfaceMockListGroupRealmRoles.RUnlock()
+	return calls
 }
 
-// AddUserToGroup calls AddUserToGroupFunc.
-func (mock *KeycloakInterfaceMock) AddUserToGroup(realmName string, userID string, groupID string) error {
-	if mock.AddUserToGroupFunc == nil {
-		panic("KeycloakInterfaceMock.AddUserToGroupFunc: method is nil but KeycloakInterface.AddUserToGroup was just called")
+// ListGroups calls ListGroupsFunc.
+func (mock *KeycloakInterfaceMock) ListGroups(ctx context.Context, realmName string, search string, first int, max int) ([]*Group, error) {
+	if mock.ListGroupsFunc == nil {
+		panic("KeycloakInterfaceMock.ListGroupsFunc: method is nil but KeycloakInterface.ListGroups was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
 		RealmName string
-		UserID    string
-		GroupID   string
+		Search    string
+		First     int
+		Max       int
 	}{
+		Ctx:       ctx,
 		RealmName: realmName,
-		UserID:    userID,
-		GroupID:   groupID,
-	}
-	lockKeycloakInterfaceMockAddUserToGroup.Lock()
-	mock.calls.AddUserToGroup = append(mock.calls.AddUserToGroup, callInfo)
-	lockKeycloakInterfaceMockAddUserToGroup.Unlock()
-	return mock.AddUserToGroupFunc(realmName, userID, groupID)
+		Search:    search,
+		First:     first,
+		Max:       max,
+	}
+	lockKeycloakInterfaceMockListGroups.Lock()
+	mock.calls.ListGroups = append(mock.calls.ListGroups, callInfo)
+	lockKeycloakInterfaceMockListGroups.Unlock()
+	return mock.ListGroupsFunc(ctx, realmName, search, first, max)
 }
 
-// AddUserToGroupCalls gets all the calls that were made to AddUserToGroup.
+// ListGroupsCalls gets all the calls that were made to ListGroups.
 // Check the length with:
-//     len(mockedKeycloakInterface.AddUserToGroupCalls())
-func (mock *KeycloakInterfaceMock) AddUserToGroupCalls() []struct {
+//     len(mockedKeycloakInterface.ListGroupsCalls())
+func (mock *KeycloakInterfaceMock) ListGroupsCalls() []struct {
+	Ctx       context.Context
 	RealmName string
-	UserID    string
-	GroupID   string
+	Search    string
+	First     int
+	Max       int
 } {
 	var calls []struct {
+		Ctx       context.Context
 		RealmName string
-		UserID    string
-		GroupID   string
+		Search    string
+		First     int
+		Max       int
 	}
-	lockKeycloakInterfaceMockAddUserToGroup.RLock()
-	calls = mock.calls.AddUserToGroup
-	lockKeycloakInterfaceMockAddUserToGroup.RUnlock()
+	lockKeycloakInterfaceMockListGroups.RLock()
+	calls = mock.calls.ListGroups
+	lockKeycloakInterfaceMockListGroups.RUnlock()
 	return calls
 }
 
-// CreateAuthenticatorConfig calls CreateAuthenticatorConfigFunc.
-func (mock *KeycloakInterfaceMock) CreateAuthenticatorConfig(authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string, executionID string) (string, error) {
-	if mock.CreateAuthenticatorConfigFunc == nil {
-		panic("KeycloakInterfaceMock.CreateAuthenticatorConfigFunc: method is nil but KeycloakInterface.CreateAuthenticatorConfig was just called")
+// ListIdentityProviderMappers calls ListIdentityProviderMappersFunc.
+func (mock *KeycloakInterfaceMock) ListIdentityProviderMappers(ctx context.Context, alias string, realmName string) ([]*IdentityProviderMapper, error) {
+	if mock.ListIdentityProviderMappersFunc == nil {
+		panic("KeycloakInterfaceMock.ListIdentityProviderMappersFunc: method is nil but KeycloakInterface.ListIdentityProviderMappers was just called")
 	}
 	callInfo := struct {
-		AuthenticatorConfig *v1alpha1.AuthenticatorConfig
-		RealmName           string
-		ExecutionID         string
+		Ctx       context.Context
+		Alias     string
+		RealmName string
 	}{
-		AuthenticatorConfig: authenticatorConfig,
-		RealmName:           realmName,
-		ExecutionID:         executionID,
+		Ctx:       ctx,
+		Alias:     alias,
+		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockCreateAuthenticatorConfig.Lock()
-	mock.calls.CreateAuthenticatorConfig = append(mock.calls.CreateAuthenticatorConfig, callInfo)
-	lockKeycloakInterfaceMockCreateAuthenticatorConfig.Unlock()
-	return mock.CreateAuthenticatorConfigFunc(authenticatorConfig, realmName, executionID)
+	lockKeycloakInterfaceMockListIdentityProviderMappers.Lock()
+	mock.calls.ListIdentityProviderMappers = append(mock.calls.ListIdentityProviderMappers, callInfo)
+	lockKeycloakInterfaceMockListIdentityProviderMappers.Unlock()
+	return mock.ListIdentityProviderMappersFunc(ctx, alias, realmName)
 }
 
-// CreateAuthenticatorConfigCalls gets all the calls that were made to CreateAuthenticatorConfig.
+// ListIdentityProviderMappersCalls gets all the calls that were made to ListIdentityProviderMappers.
 // Check the length with:
-//     len(mockedKeycloakInterface.CreateAuthenticatorConfigCalls())
-func (mock *KeycloakInterfaceMock) CreateAuthenticatorConfigCalls() []struct {
-	AuthenticatorConfig *v1alpha1.AuthenticatorConfig
-	RealmName           string
-	ExecutionID         string
+//     len(mockedKeycloakInterface.ListIdentityProviderMappersCalls())
+func (mock *KeycloakInterfaceMock) ListIdentityProviderMappersCalls() []struct {
+	Ctx       context.Context
+	Alias     string
+	RealmName string
 } {
 	var calls []struct {
-		AuthenticatorConfig *v1alpha1.AuthenticatorConfig
-		RealmName           string
-		ExecutionID         string
+		Ctx       context.Context
+		Alias     string
+		RealmName string
 	}
-	lockKeycloakInterfaceMockCreateAuthenticatorConfig.RLock()
-	calls = mock.calls.CreateAuthenticatorConfig
-	lockKeycloakInterfaceMockCreateAuthenticatorConfig.RUnlock()
+	lockKeycloakInterfaceMockListIdentityProviderMappers.RLock()
+	calls = mock.calls.ListIdentityProviderMappers
+	lockKeycloakInterfaceMockListIdentityProviderMappers.RUnlock()
 	return calls
 }
 
-// CreateClient calls CreateClientFunc.
-func (mock *KeycloakInterfaceMock) CreateClient(client *v1alpha1.KeycloakAPIClient, realmName string) (string, error) {
-	if mock.CreateClientFunc == nil {
-		panic("KeycloakInterfaceMock.CreateClientFunc: method is nil but KeycloakInterface.CreateClient was just called")
+// ListIdentityProviders calls ListIdentityProvidersFunc.
+func (mock *KeycloakInterfaceMock) ListIdentityProviders(ctx context.Context, realmName string) ([]*IdentityProvider, error) {
+	if mock.ListIdentityProvidersFunc == nil {
+		panic("KeycloakInterfaceMock.ListIdentityProvidersFunc: method is nil but KeycloakInterface.ListIdentityProviders was just called")
 	}
 	callInfo := struct {
-		Client    *v1alpha1.KeycloakAPIClient
+		Ctx       context.Context
 		RealmName string
 	}{
-		Client:    client,
+		Ctx:       ctx,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockCreateClient.Lock()
-	mock.calls.CreateClient = append(mock.calls.CreateClient, callInfo)
-	lockKeycloakInterfaceMockCreateClient.Unlock()
-	return mock.CreateClientFunc(client, realmName)
+	lockKeycloakInterfaceMockListIdentityProviders.Lock()
+	mock.calls.ListIdentityProviders = append(mock.calls.ListIdentityProviders, callInfo)
+	lockKeycloakInterfaceMockListIdentityProviders.Unlock()
+	return mock.ListIdentityProvidersFunc(ctx, realmName)
 }
 
-// CreateClientCalls gets all the calls that were made to CreateClient.
+// ListIdentityProvidersCalls gets all the calls that were made to ListIdentityProviders.
 // Check the length with:
-//     len(mockedKeycloakInterface.CreateClientCalls())
-func (mock *KeycloakInterfaceMock) CreateClientCalls() []struct {
-	Client    *v1alpha1.KeycloakAPIClient
+//     len(mockedKeycloakInterface.ListIdentityProvidersCalls())
+func (mock *KeycloakInterfaceMock) ListIdentityProvidersCalls() []struct {
+	Ctx       context.Context
 	RealmName string
 } {
 	var calls []struct {
-		Client    *v1alpha1.KeycloakAPIClient
+		Ctx       context.Context
 		RealmName string
 	}
-	lockKeycloakInterfaceMockCreateClient.RLock()
-	calls = mock.calls.CreateClient
-	lockKeycloakInterfaceMockCreateClient.RUnlock()
+	lockKeycloakInterfaceMockListIdentityProviders.RLock()
+	calls = mock.calls.ListIdentityProviders
+	lockKeycloakInterfaceMockListIdentityProviders.RUnlock()
 	return calls
 }
 
-// CreateFederatedIdentity calls CreateFederatedIdentityFunc.
-func (mock *KeycloakInterfaceMock) CreateFederatedIdentity(fid v1alpha1.FederatedIdentity, userID string, realmName string) (string, error) {
-	if mock.CreateFederatedIdentityFunc == nil {
-		panic("KeycloakInterfaceMock.CreateFederatedIdentityFunc: method is nil but KeycloakInterface.CreateFederatedIdentity was just called")
+// ListOfflineSessionsForUser calls ListOfflineSessionsForUserFunc.
+func (mock *KeycloakInterfaceMock) ListOfflineSessionsForUser(ctx context.Context, userID string, realmName string) ([]*UserSession, error) {
+	if mock.ListOfflineSessionsForUserFunc == nil {
+		panic("KeycloakInterfaceMock.ListOfflineSessionsForUserFunc: method is nil but KeycloakInterface.ListOfflineSessionsForUser was just called")
 	}
 	callInfo := struct {
-		Fid       v1alpha1.FederatedIdentity
+		Ctx       context.Context
 		UserID    string
 		RealmName string
 	}{
-		Fid:       fid,
+		Ctx:       ctx,
 		UserID:    userID,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockCreateFederatedIdentity.Lock()
-	mock.calls.CreateFederatedIdentity = append(mock.calls.CreateFederatedIdentity, callInfo)
-	lockKeycloakInterfaceMockCreateFederatedIdentity.Unlock()
-	return mock.CreateFederatedIdentityFunc(fid, userID, realmName)
+	lockKeycloakInterfaceMockListOfflineSessionsForUser.Lock()
+	mock.calls.ListOfflineSessionsForUser = append(mock.calls.ListOfflineSessionsForUser, callInfo)
+	lockKeycloakInterfaceMockListOfflineSessionsForUser.Unlock()
+	return mock.ListOfflineSessionsForUserFunc(ctx, userID, realmName)
 }
 
-// CreateFederatedIdentityCalls gets all the calls that were made to CreateFederatedIdentity.
+// ListOfflineSessionsForUserCalls gets all the calls that were made to ListOfflineSessionsForUser.
 // Check the length with:
-//     len(mockedKeycloakInterface.CreateFederatedIdentityCalls())
-func (mock *KeycloakInterfaceMock) CreateFederatedIdentityCalls() []struct {
-	Fid       v1alpha1.FederatedIdentity
+//     len(mockedKeycloakInterface.ListOfflineSessionsForUserCalls())
+func (mock *KeycloakInterfaceMock) ListOfflineSessionsForUserCalls() []struct {
+	Ctx       context.Context
 	UserID    string
 	RealmName string
 } {
 	var calls []struct {
-		Fid       v1alpha1.FederatedIdentity
+		Ctx       context.Context
 		UserID    string
 		RealmName string
 	}
-	lockKeycloakInterfaceMockCreateFederatedIdentity.RLock()
-	calls = mock.calls.CreateFederatedIdentity
-	lockKeycloakInterfaceMockCreateFederatedIdentity.RUnlock()
+	lockKeycloakInterfaceMockListOfflineSessionsForUser.RLock()
+	calls = mock.calls.ListOfflineSessionsForUser
+	lockKeycloakInterfaceMockListOfflineSessionsForUser.RUnlock()
 	return calls
 }
 
-// CreateGroup calls CreateGroupFunc.
-func (mock *KeycloakInterfaceMock) CreateGroup(group string, realmName string) (string, error) {
-	if mock.CreateGroupFunc == nil {
-		panic("KeycloakInterfaceMock.CreateGroupFunc: method is nil but KeycloakInterface.CreateGroup was just called")
+// ListOptionalClientScopesForClient calls ListOptionalClientScopesForClientFunc.
+func (mock *KeycloakInterfaceMock) ListOptionalClientScopesForClient(ctx context.Context, clientID string, realmName string) ([]*ClientScope, error) {
+	if mock.ListOptionalClientScopesForClientFunc == nil {
+		panic("KeycloakInterfaceMock.ListOptionalClientScopesForClientFunc: method is nil but KeycloakInterface.ListOptionalClientScopesForClient was just called")
 	}
 	callInfo := struct {
-		Group     string
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
 	}{
-		Group:     group,
+		Ctx:       ctx,
+		ClientID:  clientID,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockCreateGroup.Lock()
-	mock.calls.CreateGroup = append(mock.calls.CreateGroup, callInfo)
-	lockKeycloakInterfaceMockCreateGroup.Unlock()
-	return mock.CreateGroupFunc(group, realmName)
+	lockKeycloakInterfaceMockListOptionalClientScopesForClient.Lock()
+	mock.calls.ListOptionalClientScopesForClient = append(mock.calls.ListOptionalClientScopesForClient, callInfo)
+	lockKeycloakInterfaceMockListOptionalClientScopesForClient.Unlock()
+	return mock.ListOptionalClientScopesForClientFunc(ctx, clientID, realmName)
 }
 
-// CreateGroupCalls gets all the calls that were made to CreateGroup.
+// ListOptionalClientScopesForClientCalls gets all the calls that were made to ListOptionalClientScopesForClient.
 // Check the length with:
-//     len(mockedKeycloakInterface.CreateGroupCalls())
-func (mock *KeycloakInterfaceMock) CreateGroupCalls() []struct {
-	Group     string
+//     len(mockedKeycloakInterface.ListOptionalClientScopesForClientCalls())
+func (mock *KeycloakInterfaceMock) ListOptionalClientScopesForClientCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
 	RealmName string
 } {
 	var calls []struct {
-		Group     string
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
 	}
-	lockKeycloakInterfaceMockCreateGroup.RLock()
-	calls = mock.calls.CreateGroup
-	lockKeycloakInterfaceMockCreateGroup.RUnlock()
+	lockKeycloakInterfaceMockListOptionalClientScopesForClient.RLock()
+	calls = mock.calls.ListOptionalClientScopesForClient
+	lockKeycloakInterfaceMockListOptionalClientScopesForClient.RUnlock()
 	return calls
 }
 
-// CreateGroupClientRole calls CreateGroupClientRoleFunc.
-func (mock *KeycloakInterfaceMock) CreateGroupClientRole(role *v1alpha1.KeycloakUserRole, realmName string, clientID string, groupID string) (string, error) {
-	if mock.CreateGroupClientRoleFunc == nil {
-		panic("KeycloakInterfaceMock.CreateGroupClientRoleFunc: method is nil but KeycloakInterface.CreateGroupClientRole was just called")
+// ListProtocolMappersForClient calls ListProtocolMappersForClientFunc.
+func (mock *KeycloakInterfaceMock) ListProtocolMappersForClient(ctx context.Context, clientID string, realmName string) ([]*ProtocolMapper, error) {
+	if mock.ListProtocolMappersForClientFunc == nil {
+		panic("KeycloakInterfaceMock.ListProtocolMappersForClientFunc: method is nil but KeycloakInterface.ListProtocolMappersForClient was just called")
 	}
 	callInfo := struct {
-		Role      *v1alpha1.KeycloakUserRole
-		RealmName string
+		Ctx       context.Context
 		ClientID  string
-		GroupID   string
+		RealmName string
 	}{
-		Role:      role,
-		RealmName: realmName,
+		Ctx:       ctx,
 		ClientID:  clientID,
-		GroupID:   groupID,
+		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockCreateGroupClientRole.Lock()
-	mock.calls.CreateGroupClientRole = append(mock.calls.CreateGroupClientRole, callInfo)
-	lockKeycloakInterfaceMockCreateGroupClientRole.Unlock()
-	return mock.CreateGroupClientRoleFunc(role, realmName, clientID, groupID)
+	lockKeycloakInterfaceMockListProtocolMappersForClient.Lock()
+	mock.calls.ListProtocolMappersForClient = append(mock.calls.ListProtocolMappersForClient, callInfo)
+	lockKeycloakInterfaceMockListProtocolMappersForClient.Unlock()
+	return mock.ListProtocolMappersForClientFunc(ctx, clientID, realmName)
 }
 
-// CreateGroupClientRoleCalls gets all the calls that were made to CreateGroupClientRole.
+// ListProtocolMappersForClientCalls gets all the calls that were made to ListProtocolMappersForClient.
 // Check the length with:
-//     len(mockedKeycloakInterface.CreateGroupClientRoleCalls())
-func (mock *KeycloakInterfaceMock) CreateGroupClientRoleCalls() []struct {
-	Role      *v1alpha1.KeycloakUserRole
-	RealmName string
+//     len(mockedKeycloakInterface.ListProtocolMappersForClientCalls())
+func (mock *KeycloakInterfaceMock) ListProtocolMappersForClientCalls() []struct {
+	Ctx       context.Context
 	ClientID  string
-	GroupID   string
+	RealmName string
 } {
 	var calls []struct {
-		Role      *v1alpha1.KeycloakUserRole
-		RealmName string
+		Ctx       context.Context
 		ClientID  string
-		GroupID   string
+		RealmName string
 	}
-	lockKeycloakInterfaceMockCreateGroupClientRole.RLock()
-	calls = mock.calls.CreateGroupClientRole
-	lockKeycloakInterfaceMockCreateGroupClientRole.RUnlock()
+	lockKeycloakInterfaceMockListProtocolMappersForClient.RLock()
+	calls = mock.calls.ListProtocolMappersForClient
+	lockKeycloakInterfaceMockListProtocolMappersForClient.RUnlock()
 	return calls
 }
 
-// CreateGroupRealmRole calls CreateGroupRealmRoleFunc.
-func (mock *KeycloakInterfaceMock) CreateGroupRealmRole(role *v1alpha1.KeycloakUserRole, realmName string, groupID string) (string, error) {
-	if mock.CreateGroupRealmRoleFunc == nil {
-		panic("KeycloakInterfaceMock.CreateGroupRealmRoleFunc: method is nil but KeycloakInterface.CreateGroupRealmRole was just called")
+// ListProtocolMappersForClientScope calls ListProtocolMappersForClientScopeFunc.
+func (mock *KeycloakInterfaceMock) ListProtocolMappersForClientScope(ctx context.Context, scopeID string, realmName string) ([]*ProtocolMapper, error) {
+	if mock.ListProtocolMappersForClientScopeFunc == nil {
+		panic("KeycloakInterfaceMock.ListProtocolMappersForClientScopeFunc: method is nil but KeycloakInterface.ListProtocolMappersForClientScope was just called")
 	}
 	callInfo := struct {
-		Role      *v1alpha1.KeycloakUserRole
+		Ctx       context.Context
+		ScopeID   string
 		RealmName string
-		GroupID   string
 	}{
-		Role:      role,
+		Ctx:       ctx,
+		ScopeID:   scopeID,
 		RealmName: realmName,
-		GroupID:   groupID,
 	}
-	lockKeycloakInterfaceMockCreateGroupRealmRole.Lock()
-	mock.calls.CreateGroupRealmRole = append(mock.calls.CreateGroupRealmRole, callInfo)
-	lockKeycloakInterfaceMockCreateGroupRealmRole.Unlock()
-	return mock.CreateGroupRealmRoleFunc(role, realmName, groupID)
+	lockKeycloakInterfaceMockListProtocolMappersForClientScope.Lock()
+	mock.calls.ListProtocolMappersForClientScope = append(mock.calls.ListProtocolMappersForClientScope, callInfo)
+	lockKeycloakInterfaceMockListProtocolMappersForClientScope.Unlock()
+	return mock.ListProtocolMappersForClientScopeFunc(ctx, scopeID, realmName)
 }
 
-// CreateGroupRealmRoleCalls gets all the calls that were made to CreateGroupRealmRole.
+// ListProtocolMappersForClientScopeCalls gets all the calls that were made to ListProtocolMappersForClientScope.
 // Check the length with:
-//     len(mockedKeycloakInterface.CreateGroupRealmRoleCalls())
-func (mock *KeycloakInterfaceMock) CreateGroupRealmRoleCalls() []struct {
-	Role      *v1alpha1.KeycloakUserRole
+//     len(mockedKeycloakInterface.ListProtocolMappersForClientScopeCalls())
+func (mock *KeycloakInterfaceMock) ListProtocolMappersForClientScopeCalls() []struct {
+	Ctx       context.Context
+	ScopeID   string
 	RealmName string
-	GroupID   string
 } {
 	var calls []struct {
-		Role      *v1alpha1.KeycloakUserRole
+		Ctx       context.Context
+		ScopeID   string
 		RealmName string
-		GroupID   string
 	}
-	lockKeycloakInterfaceMockCreateGroupRealmRole.RLock()
-	calls = mock.calls.CreateGroupRealmRole
-	lockKeycloakInterfaceMockCreateGroupRealmRole.RUnlock()
+	lockKeycloakInterfaceMockListProtocolMappersForClientScope.RLock()
+	calls = mock.calls.ListProtocolMappersForClientScope
+	lockKeycloakInterfaceMockListProtocolMappersForClientScope.RUnlock()
 	return calls
 }
 
-// CreateIdentityProvider calls CreateIdentityProviderFunc.
-func (mock *KeycloakInterfaceMock) CreateIdentityProvider(identityProvider *v1alpha1.KeycloakIdentityProvider, realmName string) (string, error) {
-	if mock.CreateIdentityProviderFunc == nil {
-		panic("KeycloakInterfaceMock.CreateIdentityProviderFunc: method is nil but KeycloakInterface.CreateIdentityProvider was just called")
+// ListRealmEvents calls ListRealmEventsFunc.
+func (mock *KeycloakInterfaceMock) ListRealmEvents(ctx context.Context, realmName string, params *EventListParams) ([]*RealmEvent, error) {
+	if mock.ListRealmEventsFunc == nil {
+		panic("KeycloakInterfaceMock.ListRealmEventsFunc: method is nil but KeycloakInterface.ListRealmEvents was just called")
 	}
 	callInfo := struct {
-		IdentityProvider *v1alpha1.KeycloakIdentityProvider
-		RealmName        string
+		Ctx       context.Context
+		RealmName string
+		Params    *EventListParams
 	}{
-		IdentityProvider: identityProvider,
-		RealmName:        realmName,
+		Ctx:       ctx,
+		RealmName: realmName,
+		Params:    params,
 	}
-	lockKeycloakInterfaceMockCreateIdentityProvider.Lock()
-	mock.calls.CreateIdentityProvider = append(mock.calls.CreateIdentityProvider, callInfo)
-	lockKeycloakInterfaceMockCreateIdentityProvider.Unlock()
-	return mock.CreateIdentityProviderFunc(identityProvider, realmName)
+	lockKeycloakInterfaceMockListRealmEvents.Lock()
+	mock.calls.ListRealmEvents = append(mock.calls.ListRealmEvents, callInfo)
+	lockKeycloakInterfaceMockListRealmEvents.Unlock()
+	return mock.ListRealmEventsFunc(ctx, realmName, params)
 }
 
-// CreateIdentityProviderCalls gets all the calls that were made to CreateIdentityProvider.
+// ListRealmEventsCalls gets all the calls that were made to ListRealmEvents.
 // Check the length with:
-//     len(mockedKeycloakInterface.CreateIdentityProviderCalls())
-func (mock *KeycloakInterfaceMock) CreateIdentityProviderCalls() []struct {
-	IdentityProvider *v1alpha1.KeycloakIdentityProvider
-	RealmName        string
+//     len(mockedKeycloakInterface.ListRealmEventsCalls())
+func (mock *KeycloakInterfaceMock) ListRealmEventsCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	Params    *EventListParams
 } {
 	var calls []struct {
-		IdentityProvider *v1alpha1.KeycloakIdentityProvider
-		RealmName        string
+		Ctx       context.Context
+		RealmName string
+		Params    *EventListParams
 	}
-	lockKeycloakInterfaceMockCreateIdentityProvider.RLock()
-	calls = mock.calls.CreateIdentityProvider
-	lockKeycloakInterfaceMockCreateIdentityProvider.RUnlock()
+	lockKeycloakInterfaceMockListRealmEvents.RLock()
+	calls = mock.calls.ListRealmEvents
+	lockKeycloakInterfaceMockListRealmEvents.RUnlock()
 	return calls
 }
 
-// CreateRealm calls CreateRealmFunc.
-func (mock *KeycloakInterfaceMock) CreateRealm(realm *v1alpha1.KeycloakRealm) (string, error) {
-	if mock.CreateRealmFunc == nil {
-		panic("KeycloakInterfaceMock.CreateRealmFunc: method is nil but KeycloakInterface.CreateRealm was just called")
+// ListRealmKeys calls ListRealmKeysFunc.
+func (mock *KeycloakInterfaceMock) ListRealmKeys(ctx context.Context, realmName string) (*RealmKeyMetadata, error) {
+	if mock.ListRealmKeysFunc == nil {
+		panic("KeycloakInterfaceMock.ListRealmKeysFunc: method is nil but KeycloakInterface.ListRealmKeys was just called")
 	}
 	callInfo := struct {
-		Realm *v1alpha1.KeycloakRealm
+		Ctx       context.Context
+		RealmName string
 	}{
-		Realm: realm,
+		Ctx:       ctx,
+		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockCreateRealm.Lock()
-	mock.calls.CreateRealm = append(mock.calls.CreateRealm, callInfo)
-	lockKeycloakInterfaceMockCreateRealm.Unlock()
-	return mock.CreateRealmFunc(realm)
+	lockKeycloakInterfaceMockListRealmKeys.Lock()
+	mock.calls.ListRealmKeys = append(mock.calls.ListRealmKeys, callInfo)
+	lockKeycloakInterfaceMockListRealmKeys.Unlock()
+	return mock.ListRealmKeysFunc(ctx, realmName)
 }
 
-// CreateRealmCalls gets all the calls that were made to CreateRealm.
+// ListRealmKeysCalls gets all the calls that were made to ListRealmKeys.
 // Check the length with:
-//     len(mockedKeycloakInterface.CreateRealmCalls())
-func (mock *KeycloakInterfaceMock) CreateRealmCalls() []struct {
-	Realm *v1alpha1.KeycloakRealm
+//     len(mockedKeycloakInterface.ListRealmKeysCalls())
+func (mock *KeycloakInterfaceMock) ListRealmKeysCalls() []struct {
+	Ctx       context.Context
+	RealmName string
 } {
 	var calls []struct {
-		Realm *v1alpha1.KeycloakRealm
+		Ctx       context.Context
+		RealmName string
 	}
-	lockKeycloakInterfaceMockCreateRealm.RLock()
-	calls = mock.calls.CreateRealm
-	lockKeycloakInterfaceMockCreateRealm.RUnlock()
+	lockKeycloakInterfaceMockListRealmKeys.RLock()
+	calls = mock.calls.ListRealmKeys
+	lockKeycloakInterfaceMockListRealmKeys.RUnlock()
 	return calls
 }
 
-// CreateUser calls CreateUserFunc.
-func (mock *KeycloakInterfaceMock) CreateUser(user *v1alpha1.KeycloakAPIUser, realmName string) (string, error) {
-	if mock.CreateUserFunc == nil {
-		panic("KeycloakInterfaceMock.CreateUserFunc: method is nil but KeycloakInterface.CreateUser was just called")
+// ListRealms calls ListRealmsFunc.
+func (mock *KeycloakInterfaceMock) ListRealms(ctx context.Context) ([]*v1alpha1.KeycloakAPIRealm, error) {
+	if mock.ListRealmsFunc == nil {
+		panic("KeycloakInterfaceMock.ListRealmsFunc: method is nil but KeycloakInterface.ListRealms was just called")
 	}
 	callInfo := struct {
-		User      *v1alpha1.KeycloakAPIUser
-		RealmName string
+		Ctx context.Context
 	}{
-		User:      user,
-		RealmName: realmName,
+		Ctx: ctx,
 	}
-	lockKeycloakInterfaceMockCreateUser.Lock()
-	mock.calls.CreateUser = append(mock.calls.CreateUser, callInfo)
-	lockKeycloakInterfaceMockCreateUser.Unlock()
-	return mock.CreateUserFunc(user, realmName)
+	lockKeycloakInterfaceMockListRealms.Lock()
+	mock.calls.ListRealms = append(mock.calls.ListRealms, callInfo)
+	lockKeycloakInterfaceMockListRealms.Unlock()
+	return mock.ListRealmsFunc(ctx)
 }
 
-// CreateUserCalls gets all the calls that were made to CreateUser.
+// ListRealmsCalls gets all the calls that were made to ListRealms.
 // Check the length with:
-//     len(mockedKeycloakInterface.CreateUserCalls())
-func (mock *KeycloakInterfaceMock) CreateUserCalls() []struct {
-	User      *v1alpha1.KeycloakAPIUser
-	RealmName string
+//     len(mockedKeycloakInterface.ListRealmsCalls())
+func (mock *KeycloakInterfaceMock) ListRealmsCalls() []struct {
+	Ctx context.Context
 } {
 	var calls []struct {
-		User      *v1alpha1.KeycloakAPIUser
-		RealmName string
+		Ctx context.Context
 	}
-	lockKeycloakInterfaceMockCreateUser.RLock()
-	calls = mock.calls.CreateUser
-	lockKeycloakInterfaceMockCreateUser.RUnlock()
+	lockKeycloakInterfaceMockListRealms.RLock()
+	calls = mock.calls.ListRealms
+	lockKeycloakInterfaceMockListRealms.RUnlock()
 	return calls
 }
 
-// CreateUserClientRole calls CreateUserClientRoleFunc.
-func (mock *KeycloakInterfaceMock) CreateUserClientRole(role *v1alpha1.KeycloakUserRole, realmName string, clientID string, userID string) (string, error) {
-	if mock.CreateUserClientRoleFunc == nil {
-		panic("KeycloakInterfaceMock.CreateUserClientRoleFunc: method is nil but KeycloakInterface.CreateUserClientRole was just called")
+// ListRequiredActions calls ListRequiredActionsFunc.
+func (mock *KeycloakInterfaceMock) ListRequiredActions(ctx context.Context, realmName string) ([]*RequiredAction, error) {
+	if mock.ListRequiredActionsFunc == nil {
+		panic("KeycloakInterfaceMock.ListRequiredActionsFunc: method is nil but KeycloakInterface.ListRequiredActions was just called")
 	}
 	callInfo := struct {
-		Role      *v1alpha1.KeycloakUserRole
+		Ctx       context.Context
 		RealmName string
-		ClientID  string
-		UserID    string
 	}{
-		Role:      role,
+		Ctx:       ctx,
 		RealmName: realmName,
-		ClientID:  clientID,
-		UserID:    userID,
 	}
-	lockKeycloakInterfaceMockCreateUserClientRole.Lock()
-	mock.calls.CreateUserClientRole = append(mock.calls.CreateUserClientRole, callInfo)
-	lockKeycloakInterfaceMockCreateUserClientRole.Unlock()
-	return mock.CreateUserClientRoleFunc(role, realmName, clientID, userID)
+	lockKeycloakInterfaceMockListRequiredActions.Lock()
+	mock.calls.ListRequiredActions = append(mock.calls.ListRequiredActions, callInfo)
+	lockKeycloakInterfaceMockListRequiredActions.Unlock()
+	return mock.ListRequiredActionsFunc(ctx, realmName)
 }
 
-// CreateUserClientRoleCalls gets all the calls that were made to CreateUserClientRole.
+// ListRequiredActionsCalls gets all the calls that were made to ListRequiredActions.
 // Check the length with:
-//     len(mockedKeycloakInterface.CreateUserClientRoleCalls())
-func (mock *KeycloakInterfaceMock) CreateUserClientRoleCalls() []struct {
-	Role      *v1alpha1.KeycloakUserRole
+//     len(mockedKeycloakInterface.ListRequiredActionsCalls())
+func (mock *KeycloakInterfaceMock) ListRequiredActionsCalls() []struct {
+	Ctx       context.Context
 	RealmName string
-	ClientID  string
-	UserID    string
 } {
 	var calls []struct {
-		Role      *v1alpha1.KeycloakUserRole
+		Ctx       context.Context
 		RealmName string
-		ClientID  string
-		UserID    string
 	}
-	lockKeycloakInterfaceMockCreateUserClientRole.RLock()
-	calls = mock.calls.CreateUserClientRole
-	lockKeycloakInterfaceMockCreateUserClientRole.RUnlock()
+	lockKeycloakInterfaceMockListRequiredActions.RLock()
+	calls = mock.calls.ListRequiredActions
+	lockKeycloakInterfaceMockListRequiredActions.RUnlock()
 	return calls
 }
 
-// CreateUserRealmRole calls CreateUserRealmRoleFunc.
-func (mock *KeycloakInterfaceMock) CreateUserRealmRole(role *v1alpha1.KeycloakUserRole, realmName string, userID string) (string, error) {
-	if mock.CreateUserRealmRoleFunc == nil {
-		panic("KeycloakInterfaceMock.CreateUserRealmRoleFunc: method is nil but KeycloakInterface.CreateUserRealmRole was just called")
+// ListUserClientRoles calls ListUserClientRolesFunc.
+func (mock *KeycloakInterfaceMock) ListUserClientRoles(ctx context.Context, realmName string, clientID string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListUserClientRolesFunc == nil {
+		panic("KeycloakInterfaceMock.ListUserClientRolesFunc: method is nil but KeycloakInterface.ListUserClientRoles was just called")
 	}
 	callInfo := struct {
-		Role      *v1alpha1.KeycloakUserRole
+		Ctx       context.Context
 		RealmName string
+		ClientID  string
 		UserID    string
 	}{
-		Role:      role,
+		Ctx:       ctx,
 		RealmName: realmName,
+		ClientID:  clientID,
 		UserID:    userID,
 	}
-	lockKeycloakInterfaceMockCreateUserRealmRole.Lock()
-	mock.calls.CreateUserRealmRole = append(mock.calls.CreateUserRealmRole, callInfo)
-	lockKeycloakInterfaceMockCreateUserRealmRole.Unlock()
-	return mock.CreateUserRealmRoleFunc(role, realmName, userID)
+	lockKeycloakInterfaceMockListUserClientRoles.Lock()
+	mock.calls.ListUserClientRoles = append(mock.calls.ListUserClientRoles, callInfo)
+	lockKeycloakInterfaceMockListUserClientRoles.Unlock()
+	return mock.ListUserClientRolesFunc(ctx, realmName, clientID, userID)
 }
 
-// CreateUserRealmRoleCalls gets all the calls that were made to CreateUserRealmRole.
+// ListUserClientRolesCalls gets all the calls that were made to ListUserClientRoles.
 // Check the length with:
-//     len(mockedKeycloakInterface.CreateUserRealmRoleCalls())
-func (mock *KeycloakInterfaceMock) CreateUserRealmRoleCalls() []struct {
-	Role      *v1alpha1.KeycloakUserRole
+//     len(mockedKeycloakInterface.ListUserClientRolesCalls())
+func (mock *KeycloakInterfaceMock) ListUserClientRolesCalls() []struct {
+	Ctx       context.Context
 	RealmName string
+	ClientID  string
 	UserID    string
 } {
 	var calls []struct {
-		Role      *v1alpha1.KeycloakUserRole
+		Ctx       context.Context
 		RealmName string
+		ClientID  string
 		UserID    string
 	}
-	lockKeycloakInterfaceMockCreateUserRealmRole.RLock()
-	calls = mock.calls.CreateUserRealmRole
-	lockKeycloakInterfaceMockCreateUserRealmRole.RUnlock()
+	lockKeycloakInterfaceMockListUserClientRoles.RLock()
+	calls = mock.calls.ListUserClientRoles
+	lockKeycloakInterfaceMockListUserClientRoles.RUnlock()
 	return calls
 }
 
-// DeleteAuthenticatorConfig calls DeleteAuthenticatorConfigFunc.
-func (mock *KeycloakInterfaceMock) DeleteAuthenticatorConfig(configID string, realmName string) error {
-	if mock.DeleteAuthenticatorConfigFunc == nil {
-		panic("KeycloakInterfaceMock.DeleteAuthenticatorConfigFunc: method is nil but KeycloakInterface.DeleteAuthenticatorConfig was just called")
+// ListUserConsents calls ListUserConsentsFunc.
+func (mock *KeycloakInterfaceMock) ListUserConsents(ctx context.Context, userID string, realmName string) ([]*UserConsent, error) {
+	if mock.ListUserConsentsFunc == nil {
+		panic("KeycloakInterfaceMock.ListUserConsentsFunc: method is nil but KeycloakInterface.ListUserConsents was just called")
 	}
 	callInfo := struct {
-		ConfigID  string
+		Ctx       context.Context
+		UserID    string
 		RealmName string
 	}{
-		ConfigID:  configID,
+		Ctx:       ctx,
+		UserID:    userID,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockDeleteAuthenticatorConfig.Lock()
-	mock.calls.DeleteAuthenticatorConfig = append(mock.calls.DeleteAuthenticatorConfig, callInfo)
-	lockKeycloakInterfaceMockDeleteAuthenticatorConfig.Unlock()
-	return mock.DeleteAuthenticatorConfigFunc(configID, realmName)
+	lockKeycloakInterfaceMockListUserConsents.Lock()
+	mock.calls.ListUserConsents = append(mock.calls.ListUserConsents, callInfo)
+	lockKeycloakInterfaceMockListUserConsents.Unlock()
+	return mock.ListUserConsentsFunc(ctx, userID, realmName)
 }
 
-// DeleteAuthenticatorConfigCalls gets all the calls that were made to DeleteAuthenticatorConfig.
+// ListUserConsentsCalls gets all the calls that were made to ListUserConsents.
 // Check the length with:
-//     len(mockedKeycloakInterface.DeleteAuthenticatorConfigCalls())
-func (mock *KeycloakInterfaceMock) DeleteAuthenticatorConfigCalls() []struct {
-	ConfigID  string
+//     len(mockedKeycloakInterface.ListUserConsentsCalls())
+func (mock *KeycloakInterfaceMock) ListUserConsentsCalls() []struct {
+	Ctx       context.Context
+	UserID    string
 	RealmName string
 } {
 	var calls []struct {
-		ConfigID  string
+		Ctx       context.Context
+		UserID    string
 		RealmName string
 	}
-	lockKeycloakInterfaceMockDeleteAuthenticatorConfig.RLock()
-	calls = mock.calls.DeleteAuthenticatorConfig
-	lockKeycloakInterfaceMockDeleteAuthenticatorConfig.RUnlock()
+	lockKeycloakInterfaceMockListUserConsents.RLock()
+	calls = mock.calls.ListUserConsents
+	lockKeycloakInterfaceMockListUserConsents.RUnlock()
 	return calls
 }
 
-// DeleteClient calls DeleteClientFunc.
-func (mock *KeycloakInterfaceMock) DeleteClient(clientID string, realmName string) error {
-	if mock.DeleteClientFunc == nil {
-		panic("KeycloakInterfaceMock.DeleteClientFunc: method is nil but KeycloakInterface.DeleteClient was just called")
+// ListUserCredentials calls ListUserCredentialsFunc.
+func (mock *KeycloakInterfaceMock) ListUserCredentials(ctx context.Context, userID string, realmName string) ([]*Credential, error) {
+	if mock.ListUserCredentialsFunc == nil {
+		panic("KeycloakInterfaceMock.ListUserCredentialsFunc: method is nil but KeycloakInterface.ListUserCredentials was just called")
 	}
 	callInfo := struct {
-		ClientID  string
+		Ctx       context.Context
+		UserID    string
 		RealmName string
 	}{
-		ClientID:  clientID,
+		Ctx:       ctx,
+		UserID:    userID,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockDeleteClient.Lock()
-	mock.calls.DeleteClient = append(mock.calls.DeleteClient, callInfo)
-	lockKeycloakInterfaceMockDeleteClient.Unlock()
-	return mock.DeleteClientFunc(clientID, realmName)
+	lockKeycloakInterfaceMockListUserCredentials.Lock()
+	mock.calls.ListUserCredentials = append(mock.calls.ListUserCredentials, callInfo)
+	lockKeycloakInterfaceMockListUserCredentials.Unlock()
+	return mock.ListUserCredentialsFunc(ctx, userID, realmName)
 }
 
-// DeleteClientCalls gets all the calls that were made to DeleteClient.
+// ListUserCredentialsCalls gets all the calls that were made to ListUserCredentials.
 // Check the length with:
-//     len(mockedKeycloakInterface.DeleteClientCalls())
-func (mock *KeycloakInterfaceMock) DeleteClientCalls() []struct {
-	ClientID  string
+//     len(mockedKeycloakInterface.ListUserCredentialsCalls())
+func (mock *KeycloakInterfaceMock) ListUserCredentialsCalls() []struct {
+	Ctx       context.Context
+	UserID    string
 	RealmName string
 } {
 	var calls []struct {
-		ClientID  string
+		Ctx       context.Context
+		UserID    string
 		RealmName string
 	}
-	lockKeycloakInterfaceMockDeleteClient.RLock()
-	calls = mock.calls.DeleteClient
-	lockKeycloakInterfaceMockDeleteClient.RUnlock()
+	lockKeycloakInterfaceMockListUserCredentials.RLock()
+	calls = mock.calls.ListUserCredentials
+	lockKeycloakInterfaceMockListUserCredentials.RUnlock()
 	return calls
 }
 
-// DeleteIdentityProvider calls DeleteIdentityProviderFunc.
-func (mock *KeycloakInterfaceMock) DeleteIdentityProvider(alias string, realmName string) error {
-	if mock.DeleteIdentityProviderFunc == nil {
-		panic("KeycloakInterfaceMock.DeleteIdentityProviderFunc: method is nil but KeycloakInterface.DeleteIdentityProvider was just called")
+// ListUserRealmRoles calls ListUserRealmRolesFunc.
+func (mock *KeycloakInterfaceMock) ListUserRealmRoles(ctx context.Context, realmName string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
+	if mock.ListUserRealmRolesFunc == nil {
+		panic("KeycloakInterfaceMock.ListUserRealmRolesFunc: method is nil but KeycloakInterface.ListUserRealmRoles was just called")
 	}
 	callInfo := struct {
-		Alias     string
+		Ctx       context.Context
 		RealmName string
+		UserID    string
 	}{
-		Alias:     alias,
+		Ctx:       ctx,
 		RealmName: realmName,
+		UserID:    userID,
 	}
-	lockKeycloakInterfaceMockDeleteIdentityProvider.Lock()
-	mock.calls.DeleteIdentityProvider = append(mock.calls.DeleteIdentityProvider, callInfo)
-	lockKeycloakInterfaceMockDeleteIdentityProvider.Unlock()
-	return mock.DeleteIdentityProviderFunc(alias, realmName)
+	lockKeycloakInterfaceMockListUserRealmRoles.Lock()
+	mock.calls.ListUserRealmRoles = append(mock.calls.ListUserRealmRoles, callInfo)
+	lockKeycloakInterfaceMockListUserRealmRoles.Unlock()
+	return mock.ListUserRealmRolesFunc(ctx, realmName, userID)
 }
 
-// DeleteIdentityProviderCalls gets all the calls that were made to DeleteIdentityProvider.
+// ListUserRealmRolesCalls gets all the calls that were made to ListUserRealmRoles.
 // Check the length with:
-//     len(mockedKeycloakInterface.DeleteIdentityProviderCalls())
-func (mock *KeycloakInterfaceMock) DeleteIdentityProviderCalls() []struct {
-	Alias     string
+//     len(mockedKeycloakInterface.ListUserRealmRolesCalls())
+func (mock *KeycloakInterfaceMock) ListUserRealmRolesCalls() []struct {
+	Ctx       context.Context
 	RealmName string
+	UserID    string
 } {
 	var calls []struct {
-		Alias     string
+		Ctx       context.Context
 		RealmName string
+		UserID    string
 	}
-	lockKeycloakInterfaceMockDeleteIdentityProvider.RLock()
-	calls = mock.calls.DeleteIdentityProvider
-	lockKeycloakInterfaceMockDeleteIdentityProvider.RUnlock()
+	lockKeycloakInterfaceMockListUserRealmRoles.RLock()
+	calls = mock.calls.ListUserRealmRoles
+	lockKeycloakInterfaceMockListUserRealmRoles.RUnlock()
 	return calls
 }
 
-// DeleteRealm calls DeleteRealmFunc.
-func (mock *KeycloakInterfaceMock) DeleteRealm(realmName string) error {
-	if mock.DeleteRealmFunc == nil {
-		panic("KeycloakInterfaceMock.DeleteRealmFunc: method is nil but KeycloakInterface.DeleteRealm was just called")
+// ListUserSessions calls ListUserSessionsFunc.
+func (mock *KeycloakInterfaceMock) ListUserSessions(ctx context.Context, userID string, realmName string) ([]*UserSession, error) {
+	if mock.ListUserSessionsFunc == nil {
+		panic("KeycloakInterfaceMock.ListUserSessionsFunc: method is nil but KeycloakInterface.ListUserSessions was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
+		UserID    string
 		RealmName string
 	}{
+		Ctx:       ctx,
+		UserID:    userID,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockDeleteRealm.Lock()
-	mock.calls.DeleteRealm = append(mock.calls.DeleteRealm, callInfo)
-	lockKeycloakInterfaceMockDeleteRealm.Unlock()
-	return mock.DeleteRealmFunc(realmName)
+	lockKeycloakInterfaceMockListUserSessions.Lock()
+	mock.calls.ListUserSessions = append(mock.calls.ListUserSessions, callInfo)
+	lockKeycloakInterfaceMockListUserSessions.Unlock()
+	return mock.ListUserSessionsFunc(ctx, userID, realmName)
 }
 
-// DeleteRealmCalls gets all the calls that were made to DeleteRealm.
+// ListUserSessionsCalls gets all the calls that were made to ListUserSessions.
 // Check the length with:
-//     len(mockedKeycloakInterface.DeleteRealmCalls())
-func (mock *KeycloakInterfaceMock) DeleteRealmCalls() []struct {
+//     len(mockedKeycloakInterface.ListUserSessionsCalls())
+func (mock *KeycloakInterfaceMock) ListUserSessionsCalls() []struct {
+	Ctx       context.Context
+	UserID    string
 	RealmName string
 } {
 	var calls []struct {
+		Ctx       context.Context
+		UserID    string
 		RealmName string
 	}
-	lockKeycloakInterfaceMockDeleteRealm.RLock()
-	calls = mock.calls.DeleteRealm
-	lockKeycloakInterfaceMockDeleteRealm.RUnlock()
+	lockKeycloakInterfaceMockListUserSessions.RLock()
+	calls = mock.calls.ListUserSessions
+	lockKeycloakInterfaceMockListUserSessions.RUnlock()
 	return calls
 }
 
-// DeleteUser calls DeleteUserFunc.
-func (mock *KeycloakInterfaceMock) DeleteUser(userID string, realmName string) error {
-	if mock.DeleteUserFunc == nil {
-		panic("KeycloakInterfaceMock.DeleteUserFunc: method is nil but KeycloakInterface.DeleteUser was just called")
+// ListUsers calls ListUsersFunc.
+func (mock *KeycloakInterfaceMock) ListUsers(ctx context.Context, realmName string) ([]*v1alpha1.KeycloakAPIUser, error) {
+	if mock.ListUsersFunc == nil {
+		panic("KeycloakInterfaceMock.ListUsersFunc: method is nil but KeycloakInterface.ListUsers was just called")
 	}
 	callInfo := struct {
-		UserID    string
+		Ctx       context.Context
 		RealmName string
 	}{
-		UserID:    userID,
+		Ctx:       ctx,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockDeleteUser.Lock()
-	mock.calls.DeleteUser = append(mock.calls.DeleteUser, callInfo)
-	lockKeycloakInterfaceMockDeleteUser.Unlock()
-	return mock.DeleteUserFunc(userID, realmName)
+	lockKeycloakInterfaceMockListUsers.Lock()
+	mock.calls.ListUsers = append(mock.calls.ListUsers, callInfo)
+	lockKeycloakInterfaceMockListUsers.Unlock()
+	return mock.ListUsersFunc(ctx, realmName)
 }
 
-// DeleteUserCalls gets all the calls that were made to DeleteUser.
+// ListUsersCalls gets all the calls that were made to ListUsers.
 // Check the length with:
-//     len(mockedKeycloakInterface.DeleteUserCalls())
-func (mock *KeycloakInterfaceMock) DeleteUserCalls() []struct {
-	UserID    string
+//     len(mockedKeycloakInterface.ListUsersCalls())
+func (mock *KeycloakInterfaceMock) ListUsersCalls() []struct {
+	Ctx       context.Context
 	RealmName string
 } {
 	var calls []struct {
-		UserID    string
+		Ctx       context.Context
 		RealmName string
 	}
-	lockKeycloakInterfaceMockDeleteUser.RLock()
-	calls = mock.calls.DeleteUser
-	lockKeycloakInterfaceMockDeleteUser.RUnlock()
+	lockKeycloakInterfaceMockListUsers.RLock()
+	calls = mock.calls.ListUsers
+	lockKeycloakInterfaceMockListUsers.RUnlock()
 	return calls
 }
 
-// DeleteUserClientRole calls DeleteUserClientRoleFunc.
-func (mock *KeycloakInterfaceMock) DeleteUserClientRole(role *v1alpha1.KeycloakUserRole, realmName string, clientID string, userID string) error {
-	if mock.DeleteUserClientRoleFunc == nil {
-		panic("KeycloakInterfaceMock.DeleteUserClientRoleFunc: method is nil but KeycloakInterface.DeleteUserClientRole was just called")
+// ListUsersInClientRole calls ListUsersInClientRoleFunc.
+func (mock *KeycloakInterfaceMock) ListUsersInClientRole(ctx context.Context, clientID string, roleName string, realmName string, first int, max int) ([]*v1alpha1.KeycloakAPIUser, error) {
+	if mock.ListUsersInClientRoleFunc == nil {
+		panic("KeycloakInterfaceMock.ListUsersInClientRoleFunc: method is nil but KeycloakInterface.ListUsersInClientRole was just called")
 	}
 	callInfo := struct {
-		Role      *v1alpha1.KeycloakUserRole
-		RealmName string
+		Ctx       context.Context
 		ClientID  string
-		UserID    string
+		RoleName  string
+		RealmName string
+		First     int
+		Max       int
 	}{
-		Role:      role,
-		RealmName: realmName,
+		Ctx:       ctx,
 		ClientID:  clientID,
-		UserID:    userID,
+		RoleName:  roleName,
+		RealmName: realmName,
+		First:     first,
+		Max:       max,
 	}
-	lockKeycloakInterfaceMockDeleteUserClientRole.Lock()
-	mock.calls.DeleteUserClientRole = append(mock.calls.DeleteUserClientRole, callInfo)
-	lockKeycloakInterfaceMockDeleteUserClientRole.Unlock()
-	return mock.DeleteUserClientRoleFunc(role, realmName, clientID, userID)
+	lockKeycloakInterfaceMockListUsersInClientRole.Lock()
+	mock.calls.ListUsersInClientRole = append(mock.calls.ListUsersInClientRole, callInfo)
+	lockKeycloakInterfaceMockListUsersInClientRole.Unlock()
+	return mock.ListUsersInClientRoleFunc(ctx, clientID, roleName, realmName, first, max)
 }
 
-// DeleteUserClientRoleCalls gets all the calls that were made to DeleteUserClientRole.
+// ListUsersInClientRoleCalls gets all the calls that were made to ListUsersInClientRole.
 // Check the length with:
-//     len(mockedKeycloakInterface.DeleteUserClientRoleCalls())
-func (mock *KeycloakInterfaceMock) DeleteUserClientRoleCalls() []struct {
-	Role      *v1alpha1.KeycloakUserRole
-	RealmName string
+//     len(mockedKeycloakInterface.ListUsersInClientRoleCalls())
+func (mock *KeycloakInterfaceMock) ListUsersInClientRoleCalls() []struct {
+	Ctx       context.Context
 	ClientID  string
-	UserID    string
+	RoleName  string
+	RealmName string
+	First     int
+	Max       int
 } {
 	var calls []struct {
-		Role      *v1alpha1.KeycloakUserRole
-		RealmName string
+		Ctx       context.Context
 		ClientID  string
-		UserID    string
+		RoleName  string
+		RealmName string
+		First     int
+		Max       int
 	}
-	lockKeycloakInterfaceMockDeleteUserClientRole.RLock()
-	calls = mock.calls.DeleteUserClientRole
-	lockKeycloakInterfaceMockDeleteUserClientRole.RUnlock()
+	lockKeycloakInterfaceMockListUsersInClientRole.RLock()
+	calls = mock.calls.ListUsersInClientRole
+	lockKeycloakInterfaceMockListUsersInClientRole.RUnlock()
 	return calls
 }
 
-// DeleteUserFromGroup calls DeleteUserFromGroupFunc.
-func (mock *KeycloakInterfaceMock) DeleteUserFromGroup(realmName string, userID string, groupID string) error {
-	if mock.DeleteUserFromGroupFunc == nil {
-		panic("KeycloakInterfaceMock.DeleteUserFromGroupFunc: method is nil but KeycloakInterface.DeleteUserFromGroup was just called")
+// ListUsersInGroup calls ListUsersInGroupFunc.
+func (mock *KeycloakInterfaceMock) ListUsersInGroup(ctx context.Context, realmName string, groupID string) ([]*v1alpha1.KeycloakAPIUser, error) {
+	if mock.ListUsersInGroupFunc == nil {
+		panic("KeycloakInterfaceMock.ListUsersInGroupFunc: method is nil but KeycloakInterface.ListUsersInGroup was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
 		RealmName string
-		UserID    string
 		GroupID   string
 	}{
+		Ctx:       ctx,
 		RealmName: realmName,
-		UserID:    userID,
 		GroupID:   groupID,
 	}
-	lockKeycloakInterfaceMockDeleteUserFromGroup.Lock()
-	mock.calls.DeleteUserFromGroup = append(mock.calls.DeleteUserFromGroup, callInfo)
-	lockKeycloakInterfaceMockDeleteUserFromGroup.Unlock()
-	return mock.DeleteUserFromGroupFunc(realmName, userID, groupID)
+	lockKeycloakInterfaceMockListUsersInGroup.Lock()
+	mock.calls.ListUsersInGroup = append(mock.calls.ListUsersInGroup, callInfo)
+	lockKeycloakInterfaceMockListUsersInGroup.Unlock()
+	return mock.ListUsersInGroupFunc(ctx, realmName, groupID)
 }
 
-// DeleteUserFromGroupCalls gets all the calls that were made to DeleteUserFromGroup.
+// ListUsersInGroupCalls gets all the calls that were made to ListUsersInGroup.
 // Check the length with:
-//     len(mockedKeycloakInterface.DeleteUserFromGroupCalls())
-func (mock *KeycloakInterfaceMock) DeleteUserFromGroupCalls() []struct {
+//     len(mockedKeycloakInterface.ListUsersInGroupCalls())
+func (mock *KeycloakInterfaceMock) ListUsersInGroupCalls() []struct {
+	Ctx       context.Context
 	RealmName string
-	UserID    string
 	GroupID   string
 } {
 	var calls []struct {
+		Ctx       context.Context
 		RealmName string
-		UserID    string
 		GroupID   string
 	}
-	lockKeycloakInterfaceMockDeleteUserFromGroup.RLock()
-	calls = mock.calls.DeleteUserFromGroup
-	lockKeycloakInterfaceMockDeleteUserFromGroup.RUnlock()
+	lockKeycloakInterfaceMockListUsersInGroup.RLock()
+	calls = mock.calls.ListUsersInGroup
+	lockKeycloakInterfaceMockListUsersInGroup.RUnlock()
 	return calls
 }
 
-// DeleteUserRealmRole calls DeleteUserRealmRoleFunc.
-func (mock *KeycloakInterfaceMock) DeleteUserRealmRole(role *v1alpha1.KeycloakUserRole, realmName string, userID string) error {
-	if mock.DeleteUserRealmRoleFunc == nil {
-		panic("KeycloakInterfaceMock.DeleteUserRealmRoleFunc: method is nil but KeycloakInterface.DeleteUserRealmRole was just called")
+// MakeGroupDefault calls MakeGroupDefaultFunc.
+func (mock *KeycloakInterfaceMock) MakeGroupDefault(ctx context.Context, groupID string, realmName string) error {
+	if mock.MakeGroupDefaultFunc == nil {
+		panic("KeycloakInterfaceMock.MakeGroupDefaultFunc: method is nil but KeycloakInterface.MakeGroupDefault was just called")
 	}
 	callInfo := struct {
-		Role      *v1alpha1.KeycloakUserRole
+		Ctx       context.Context
+		GroupID   string
 		RealmName string
-		UserID    string
 	}{
-		Role:      role,
+		Ctx:       ctx,
+		GroupID:   groupID,
 		RealmName: realmName,
-		UserID:    userID,
 	}
-	lockKeycloakInterfaceMockDeleteUserRealmRole.Lock()
-	mock.calls.DeleteUserRealmRole = append(mock.calls.DeleteUserRealmRole, callInfo)
-	lockKeycloakInterfaceMockDeleteUserRealmRole.Unlock()
-	return mock.DeleteUserRealmRoleFunc(role, realmName, userID)
+	lockKeycloakInterfaceMockMakeGroupDefault.Lock()
+	mock.calls.MakeGroupDefault = append(mock.calls.MakeGroupDefault, callInfo)
+	lockKeycloakInterfaceMockMakeGroupDefault.Unlock()
+	return mock.MakeGroupDefaultFunc(ctx, groupID, realmName)
 }
 
-// DeleteUserRealmRoleCalls gets all the calls that were made to DeleteUserRealmRole.
+// MakeGroupDefaultCalls gets all the calls that were made to MakeGroupDefault.
 // Check the length with:
-//     len(mockedKeycloakInterface.DeleteUserRealmRoleCalls())
-func (mock *KeycloakInterfaceMock) DeleteUserRealmRoleCalls() []struct {
-	Role      *v1alpha1.KeycloakUserRole
+//     len(mockedKeycloakInterface.MakeGroupDefaultCalls())
+func (mock *KeycloakInterfaceMock) MakeGroupDefaultCalls() []struct {
+	Ctx       context.Context
+	GroupID   string
 	RealmName string
-	UserID    string
 } {
 	var calls []struct {
-		Role      *v1alpha1.KeycloakUserRole
+		Ctx       context.Context
+		GroupID   string
 		RealmName string
-		UserID    string
 	}
-	lockKeycloakInterfaceMockDeleteUserRealmRole.RLock()
-	calls = mock.calls.DeleteUserRealmRole
-	lockKeycloakInterfaceMockDeleteUserRealmRole.RUnlock()
+	lockKeycloakInterfaceMockMakeGroupDefault.RLock()
+	calls = mock.calls.MakeGroupDefault
+	lockKeycloakInterfaceMockMakeGroupDefault.RUnlock()
 	return calls
 }
 
-// FindAuthenticationExecutionForFlow calls FindAuthenticationExecutionForFlowFunc.
-func (mock *KeycloakInterfaceMock) FindAuthenticationExecutionForFlow(flowAlias string, realmName string, predicate func(*v1alpha1.AuthenticationExecutionInfo) bool) (*v1alpha1.AuthenticationExecutionInfo, error) {
-	if mock.FindAuthenticationExecutionForFlowFunc == nil {
-		panic("KeycloakInterfaceMock.FindAuthenticationExecutionForFlowFunc: method is nil but KeycloakInterface.FindAuthenticationExecutionForFlow was just called")
+// MoveCredentialAfter calls MoveCredentialAfterFunc.
+func (mock *KeycloakInterfaceMock) MoveCredentialAfter(ctx context.Context, userID string, credentialID string, newPreviousCredentialID string, realmName string) error {
+	if mock.MoveCredentialAfterFunc == nil {
+		panic("KeycloakInterfaceMock.MoveCredentialAfterFunc: method is nil but KeycloakInterface.MoveCredentialAfter was just called")
 	}
 	callInfo := struct {
-		FlowAlias string
-		RealmName string
-		Predicate func(*v1alpha1.AuthenticationExecutionInfo) bool
+		Ctx                     context.Context
+		UserID                  string
+		CredentialID            string
+		NewPreviousCredentialID string
+		RealmName               string
 	}{
-		FlowAlias: flowAlias,
-		RealmName: realmName,
-		Predicate: predicate,
-	}
-	lockKeycloakInterfaceMockFindAuthenticationExecutionForFlow.Lock()
-	mock.calls.FindAuthenticationExecutionForFlow = append(mock.calls.FindAuthenticationExecutionForFlow, callInfo)
-	lockKeycloakInterfaceMockFindAuthenticationExecutionForFlow.Unlock()
-	return mock.FindAuthenticationExecutionForFlowFunc(flowAlias, realmName, predicate)
+		Ctx:                     ctx,
+		UserID:                  userID,
+		CredentialID:            credentialID,
+		NewPreviousCredentialID: newPreviousCredentialID,
+		RealmName:               realmName,
+	}
+	lockKeycloakInterfaceMockMoveCredentialAfter.Lock()
+	mock.calls.MoveCredentialAfter = append(mock.calls.MoveCredentialAfter, callInfo)
+	lockKeycloakInterfaceMockMoveCredentialAfter.Unlock()
+	return mock.MoveCredentialAfterFunc(ctx, userID, credentialID, newPreviousCredentialID, realmName)
 }
 
-// FindAuthenticationExecutionForFlowCalls gets all the calls that were made to FindAuthenticationExecutionForFlow.
+// MoveCredentialAfterCalls gets all the calls that were made to MoveCredentialAfter.
 // Check the length with:
-//     len(mockedKeycloakInterface.FindAuthenticationExecutionForFlowCalls())
-func (mock *KeycloakInterfaceMock) FindAuthenticationExecutionForFlowCalls() []struct {
-	FlowAlias string
-	RealmName string
-	Predicate func(*v1alpha1.AuthenticationExecutionInfo) bool
+//     len(mockedKeycloakInterface.MoveCredentialAfterCalls())
+func (mock *KeycloakInterfaceMock) MoveCredentialAfterCalls() []struct {
+	Ctx                     context.Context
+	UserID                  string
+	CredentialID            string
+	NewPreviousCredentialID string
+	RealmName               string
 } {
 	var calls []struct {
-		FlowAlias string
-		RealmName string
-		Predicate func(*v1alpha1.AuthenticationExecutionInfo) bool
-	}
-	lockKeycloakInterfaceMockFindAuthenticationExecutionForFlow.RLock()
-	calls = mock.calls.FindAuthenticationExecutionForFlow
-	lockKeycloakInterfaceMockFindAuthenticationExecutionForFlow.RUnlock()
+		Ctx                     context.Context
+		UserID                  string
+		CredentialID            string
+		NewPreviousCredentialID string
+		RealmName               string
+	}
+	lockKeycloakInterfaceMockMoveCredentialAfter.RLock()
+	calls = mock.calls.MoveCredentialAfter
+	lockKeycloakInterfaceMockMoveCredentialAfter.RUnlock()
 	return calls
 }
 
-// FindAvailableGroupClientRole calls FindAvailableGroupClientRoleFunc.
-func (mock *KeycloakInterfaceMock) FindAvailableGroupClientRole(realmName string, clientID string, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error) {
-	if mock.FindAvailableGroupClientRoleFunc == nil {
-		panic("KeycloakInterfaceMock.FindAvailableGroupClientRoleFunc: method is nil but KeycloakInterface.FindAvailableGroupClientRole was just called")
+// MoveCredentialToFirst calls MoveCredentialToFirstFunc.
+func (mock *KeycloakInterfaceMock) MoveCredentialToFirst(ctx context.Context, userID string, credentialID string, realmName string) error {
+	if mock.MoveCredentialToFirstFunc == nil {
+		panic("KeycloakInterfaceMock.MoveCredentialToFirstFunc: method is nil but KeycloakInterface.MoveCredentialToFirst was just called")
 	}
 	callInfo := struct {
-		RealmName string
-		ClientID  string
-		GroupID   string
-		Predicate func(*v1alpha1.KeycloakUserRole) bool
+		Ctx          context.Context
+		UserID       string
+		CredentialID string
+		RealmName    string
 	}{
-		RealmName: realmName,
-		ClientID:  clientID,
-		GroupID:   groupID,
-		Predicate: predicate,
-	}
-	lockKeycloakInterfaceMockFindAvailableGroupClientRole.Lock()
-	mock.calls.FindAvailableGroupClientRole = append(mock.calls.FindAvailableGroupClientRole, callInfo)
-	lockKeycloakInterfaceMockFindAvailableGroupClientRole.Unlock()
-	return mock.FindAvailableGroupClientRoleFunc(realmName, clientID, groupID, predicate)
+		Ctx:          ctx,
+		UserID:       userID,
+		CredentialID: credentialID,
+		RealmName:    realmName,
+	}
+	lockKeycloakInterfaceMockMoveCredentialToFirst.Lock()
+	mock.calls.MoveCredentialToFirst = append(mock.calls.MoveCredentialToFirst, callInfo)
+	lockKeycloakInterfaceMockMoveCredentialToFirst.Unlock()
+	return mock.MoveCredentialToFirstFunc(ctx, userID, credentialID, realmName)
 }
 
-// FindAvailableGroupClientRoleCalls gets all the calls that were made to FindAvailableGroupClientRole.
+// MoveCredentialToFirstCalls gets all the calls that were made to MoveCredentialToFirst.
 // Check the length with:
-//     len(mockedKeycloakInterface.FindAvailableGroupClientRoleCalls())
-func (mock *KeycloakInterfaceMock) FindAvailableGroupClientRoleCalls() []struct {
-	RealmName string
-	ClientID  string
-	GroupID   string
-	Predicate func(*v1alpha1.KeycloakUserRole) bool
+//     len(mockedKeycloakInterface.MoveCredentialToFirstCalls())
+func (mock *KeycloakInterfaceMock) MoveCredentialToFirstCalls() []struct {
+	Ctx          context.Context
+	UserID       string
+	CredentialID string
+	RealmName    string
 } {
 	var calls []struct {
-		RealmName string
-		ClientID  string
-		GroupID   string
-		Predicate func(*v1alpha1.KeycloakUserRole) bool
-	}
-	lockKeycloakInterfaceMockFindAvailableGroupClientRole.RLock()
-	calls = mock.calls.FindAvailableGroupClientRole
-	lockKeycloakInterfaceMockFindAvailableGroupClientRole.RUnlock()
+		Ctx          context.Context
+		UserID       string
+		CredentialID string
+		RealmName    string
+	}
+	lockKeycloakInterfaceMockMoveCredentialToFirst.RLock()
+	calls = mock.calls.MoveCredentialToFirst
+	lockKeycloakInterfaceMockMoveCredentialToFirst.RUnlock()
 	return calls
 }
 
-// FindGroupByName calls FindGroupByNameFunc.
-func (mock *KeycloakInterfaceMock) FindGroupByName(groupName string, realmName string) (*Group, error) {
-	if mock.FindGroupByNameFunc == nil {
-		panic("KeycloakInterfaceMock.FindGroupByNameFunc: method is nil but KeycloakInterface.FindGroupByName was just called")
+// PatchRealm calls PatchRealmFunc.
+func (mock *KeycloakInterfaceMock) PatchRealm(ctx context.Context, realmName string, patch map[string]interface{}) error {
+	if mock.PatchRealmFunc == nil {
+		panic("KeycloakInterfaceMock.PatchRealmFunc: method is nil but KeycloakInterface.PatchRealm was just called")
 	}
 	callInfo := struct {
-		GroupName string
+		Ctx       context.Context
 		RealmName string
+		Patch     map[string]interface{}
 	}{
-		GroupName: groupName,
+		Ctx:       ctx,
 		RealmName: realmName,
+		Patch:     patch,
 	}
-	lockKeycloakInterfaceMockFindGroupByName.Lock()
-	mock.calls.FindGroupByName = append(mock.calls.FindGroupByName, callInfo)
-	lockKeycloakInterfaceMockFindGroupByName.Unlock()
-	return mock.FindGroupByNameFunc(groupName, realmName)
+	lockKeycloakInterfaceMockPatchRealm.Lock()
+	mock.calls.PatchRealm = append(mock.calls.PatchRealm, callInfo)
+	lockKeycloakInterfaceMockPatchRealm.Unlock()
+	return mock.PatchRealmFunc(ctx, realmName, patch)
 }
 
-// FindGroupByNameCalls gets all the calls that were made to FindGroupByName.
+// PatchRealmCalls gets all the calls that were made to PatchRealm.
 // Check the length with:
-//     len(mockedKeycloakInterface.FindGroupByNameCalls())
-func (mock *KeycloakInterfaceMock) FindGroupByNameCalls() []struct {
-	GroupName string
+//     len(mockedKeycloakInterface.PatchRealmCalls())
+func (mock *KeycloakInterfaceMock) PatchRealmCalls() []struct {
+	Ctx       context.Context
 	RealmName string
+	Patch     map[string]interface{}
 } {
 	var calls []struct {
-		GroupName string
+		Ctx       context.Context
 		RealmName string
+		Patch     map[string]interface{}
 	}
-	lockKeycloakInterfaceMockFindGroupByName.RLock()
-	calls = mock.calls.FindGroupByName
-	lockKeycloakInterfaceMockFindGroupByName.RUnlock()
+	lockKeycloakInterfaceMockPatchRealm.RLock()
+	calls = mock.calls.PatchRealm
+	lockKeycloakInterfaceMockPatchRealm.RUnlock()
 	return calls
 }
 
-// FindGroupClientRole calls FindGroupClientRoleFunc.
-func (mock *KeycloakInterfaceMock) FindGroupClientRole(realmName string, clientID string, groupID string, predicate func(*v1alpha1.KeycloakUserRole) bool) (*v1alpha1.KeycloakUserRole, error) {
-	if mock.FindGroupClientRoleFunc == nil {
-		panic("KeycloakInterfaceMock.FindGroupClientRoleFunc: method is nil but KeycloakInterface.FindGroupClientRole was just called")
+// Ping calls PingFunc.
+func (mock *KeycloakInterfaceMock) Ping(ctx context.Context) error {
+	if mock.PingFunc == nil {
+		panic("KeycloakInterfaceMock.PingFunc: method is nil but KeycloakInterface.Ping was just called")
 	}
 	callInfo := struct {
-		RealmName string
-		ClientID  string
-		GroupID   string
-		Predicate func(*v1alpha1.KeycloakUserRole) bool
+		Ctx context.Context
 	}{
-		RealmName: realmName,
-		ClientID:  clientID,
-		GroupID:   groupID,
-		Predicate: predicate,
+		Ctx: ctx,
 	}
-	lockKeycloakInterfaceMockFindGroupClientRole.Lock()
-	mock.calls.FindGroupClientRole = append(mock.calls.FindGroupClientRole, callInfo)
-	lockKeycloakInterfaceMockFindGroupClientRole.Unlock()
-	return mock.FindGroupClientRoleFunc(realmName, clientID, groupID, predicate)
+	lockKeycloakInterfaceMockPing.Lock()
+	mock.calls.Ping = append(mock.calls.Ping, callInfo)
+	lockKeycloakInterfaceMockPing.Unlock()
+	return mock.PingFunc(ctx)
 }
 
-// FindGroupClientRoleCalls gets all the calls that were made to FindGroupClientRole.
+// PingCalls gets all the calls that were made to Ping.
 // Check the length with:
-//     len(mockedKeycloakInterface.FindGroupClientRoleCalls())
-func (mock *KeycloakInterfaceMock) FindGroupClientRoleCalls() []struct {
-	RealmName string
-	ClientID  string
-	GroupID   string
-	Predicate func(*v1alpha1.KeycloakUserRole) bool
+//     len(mockedKeycloakInterface.PingCalls())
+func (mock *KeycloakInterfaceMock) PingCalls() []struct {
+	Ctx context.Context
 } {
 	var calls []struct {
-		RealmName string
-		ClientID  string
-		GroupID   string
-		Predicate func(*v1alpha1.KeycloakUserRole) bool
+		Ctx context.Context
 	}
-	lockKeycloakInterfaceMockFindGroupClientRole.RLock()
-	calls = mock.calls.FindGroupClientRole
-	lockKeycloakInterfaceMockFindGroupClientRole.RUnlock()
+	lockKeycloakInterfaceMockPing.RLock()
+	calls = mock.calls.Ping
+	lockKeycloakInterfaceMockPing.RUnlock()
 	return calls
 }
 
-// FindUserByEmail calls FindUserByEmailFunc.
-func (mock *KeycloakInterfaceMock) FindUserByEmail(email string, realm string) (*v1alpha1.KeycloakAPIUser, error) {
-	if mock.FindUserByEmailFunc == nil {
-		panic("KeycloakInterfaceMock.FindUserByEmailFunc: method is nil but KeycloakInterface.FindUserByEmail was just called")
+// PushClientRevocation calls PushClientRevocationFunc.
+func (mock *KeycloakInterfaceMock) PushClientRevocation(ctx context.Context, clientID string, realmName string) (*GlobalRequestResult, error) {
+	if mock.PushClientRevocationFunc == nil {
+		panic("KeycloakInterfaceMock.PushClientRevocationFunc: method is nil but KeycloakInterface.PushClientRevocation was just called")
 	}
 	callInfo := struct {
-		Email string
-		Realm string
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
 	}{
-		Email: email,
-		Realm: realm,
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockFindUserByEmail.Lock()
-	mock.calls.FindUserByEmail = append(mock.calls.FindUserByEmail, callInfo)
-	lockKeycloakInterfaceMockFindUserByEmail.Unlock()
-	return mock.FindUserByEmailFunc(email, realm)
+	lockKeycloakInterfaceMockPushClientRevocation.Lock()
+	mock.calls.PushClientRevocation = append(mock.calls.PushClientRevocation, callInfo)
+	lockKeycloakInterfaceMockPushClientRevocation.Unlock()
+	return mock.PushClientRevocationFunc(ctx, clientID, realmName)
 }
 
-// FindUserByEmailCalls gets all the calls that were made to FindUserByEmail.
+// PushClientRevocationCalls gets all the calls that were made to PushClientRevocation.
 // Check the length with:
-//     len(mockedKeycloakInterface.FindUserByEmailCalls())
-func (mock *KeycloakInterfaceMock) FindUserByEmailCalls() []struct {
-	Email string
-	Realm string
+//     len(mockedKeycloakInterface.PushClientRevocationCalls())
+func (mock *KeycloakInterfaceMock) PushClientRevocationCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
 } {
 	var calls []struct {
-		Email string
-		Realm string
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
 	}
-	lockKeycloakInterfaceMockFindUserByEmail.RLock()
-	calls = mock.calls.FindUserByEmail
-	lockKeycloakInterfaceMockFindUserByEmail.RUnlock()
+	lockKeycloakInterfaceMockPushClientRevocation.RLock()
+	calls = mock.calls.PushClientRevocation
+	lockKeycloakInterfaceMockPushClientRevocation.RUnlock()
 	return calls
 }
 
-// FindUserByUsername calls FindUserByUsernameFunc.
-func (mock *KeycloakInterfaceMock) FindUserByUsername(name string, realm string) (*v1alpha1.KeycloakAPIUser, error) {
-	if mock.FindUserByUsernameFunc == nil {
-		panic("KeycloakInterfaceMock.FindUserByUsernameFunc: method is nil but KeycloakInterface.FindUserByUsername was just called")
+// RefreshToken calls RefreshTokenFunc.
+func (mock *KeycloakInterfaceMock) RefreshToken(ctx context.Context, realmName string, clientID string, clientSecret string, refreshToken string) (*TokenResponse, error) {
+	if mock.RefreshTokenFunc == nil {
+		panic("KeycloakInterfaceMock.RefreshTokenFunc: method is nil but KeycloakInterface.RefreshToken was just called")
 	}
 	callInfo := struct {
-		Name  string
-		Realm string
+		Ctx          context.Context
+		RealmName    string
+		ClientID     string
+		ClientSecret string
+		RefreshToken string
 	}{
-		Name:  name,
-		Realm: realm,
-	}
-	lockKeycloakInterfaceMockFindUserByUsername.Lock()
-	mock.calls.FindUserByUsername = append(mock.calls.FindUserByUsername, callInfo)
-	lockKeycloakInterfaceMockFindUserByUsername.Unlock()
-	return mock.FindUserByUsernameFunc(name, realm)
+		Ctx:          ctx,
+		RealmName:    realmName,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RefreshToken: refreshToken,
+	}
+	lockKeycloakInterfaceMockRefreshToken.Lock()
+	mock.calls.RefreshToken = append(mock.calls.RefreshToken, callInfo)
+	lockKeycloakInterfaceMockRefreshToken.Unlock()
+	return mock.RefreshTokenFunc(ctx, realmName, clientID, clientSecret, refreshToken)
 }
 
-// FindUserByUsernameCalls gets all the calls that were made to FindUserByUsername.
+// RefreshTokenCalls gets all the calls that were made to RefreshToken.
 // Check the length with:
-//     len(mockedKeycloakInterface.FindUserByUsernameCalls())
-func (mock *KeycloakInterfaceMock) FindUserByUsernameCalls() []struct {
-	Name  string
-	Realm string
+//     len(mockedKeycloakInterface.RefreshTokenCalls())
+func (mock *KeycloakInterfaceMock) RefreshTokenCalls() []struct {
+	Ctx          context.Context
+	RealmName    string
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
 } {
 	var calls []struct {
-		Name  string
-		Realm string
-	}
-	lockKeycloakInterfaceMockFindUserByUsername.RLock()
-	calls = mock.calls.FindUserByUsername
-	lockKeycloakInterfaceMockFindUserByUsername.RUnlock()
+		Ctx          context.Context
+		RealmName    string
+		ClientID     string
+		ClientSecret string
+		RefreshToken string
+	}
+	lockKeycloakInterfaceMockRefreshToken.RLock()
+	calls = mock.calls.RefreshToken
+	lockKeycloakInterfaceMockRefreshToken.RUnlock()
 	return calls
 }
 
-// GetAuthenticatorConfig calls GetAuthenticatorConfigFunc.
-func (mock *KeycloakInterfaceMock) GetAuthenticatorConfig(configID string, realmName string) (*v1alpha1.AuthenticatorConfig, error) {
-	if mock.GetAuthenticatorConfigFunc == nil {
-		panic("KeycloakInterfaceMock.GetAuthenticatorConfigFunc: method is nil but KeycloakInterface.GetAuthenticatorConfig was just called")
+// RegenerateClientRegistrationToken calls RegenerateClientRegistrationTokenFunc.
+func (mock *KeycloakInterfaceMock) RegenerateClientRegistrationToken(ctx context.Context, clientID string, realmName string) (string, error) {
+	if mock.RegenerateClientRegistrationTokenFunc == nil {
+		panic("KeycloakInterfaceMock.RegenerateClientRegistrationTokenFunc: method is nil but KeycloakInterface.RegenerateClientRegistrationToken was just called")
 	}
 	callInfo := struct {
-		ConfigID  string
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
 	}{
-		ConfigID:  configID,
+		Ctx:       ctx,
+		ClientID:  clientID,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockGetAuthenticatorConfig.Lock()
-	mock.calls.GetAuthenticatorConfig = append(mock.calls.GetAuthenticatorConfig, callInfo)
-	lockKeycloakInterfaceMockGetAuthenticatorConfig.Unlock()
-	return mock.GetAuthenticatorConfigFunc(configID, realmName)
+	lockKeycloakInterfaceMockRegenerateClientRegistrationToken.Lock()
+	mock.calls.RegenerateClientRegistrationToken = append(mock.calls.RegenerateClientRegistrationToken, callInfo)
+	lockKeycloakInterfaceMockRegenerateClientRegistrationToken.Unlock()
+	return mock.RegenerateClientRegistrationTokenFunc(ctx, clientID, realmName)
 }
 
-// GetAuthenticatorConfigCalls gets all the calls that were made to GetAuthenticatorConfig.
+// RegenerateClientRegistrationTokenCalls gets all the calls that were made to RegenerateClientRegistrationToken.
 // Check the length with:
-//     len(mockedKeycloakInterface.GetAuthenticatorConfigCalls())
-func (mock *KeycloakInterfaceMock) GetAuthenticatorConfigCalls() []struct {
-	ConfigID  string
+//     len(mockedKeycloakInterface.RegenerateClientRegistrationTokenCalls())
+func (mock *KeycloakInterfaceMock) RegenerateClientRegistrationTokenCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
 	RealmName string
 } {
 	var calls []struct {
-		ConfigID  string
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
 	}
-	lockKeycloakInterfaceMockGetAuthenticatorConfig.RLock()
-	calls = mock.calls.GetAuthenticatorConfig
-	lockKeycloakInterfaceMockGetAuthenticatorConfig.RUnlock()
+	lockKeycloakInterfaceMockRegenerateClientRegistrationToken.RLock()
+	calls = mock.calls.RegenerateClientRegistrationToken
+	lockKeycloakInterfaceMockRegenerateClientRegistrationToken.RUnlock()
 	return calls
 }
 
-// GetClient calls GetClientFunc.
-func (mock *KeycloakInterfaceMock) GetClient(clientID string, realmName string) (*v1alpha1.KeycloakAPIClient, error) {
-	if mock.GetClientFunc == nil {
-		panic("KeycloakInterfaceMock.GetClientFunc: method is nil but KeycloakInterface.GetClient was just called")
+// RegenerateClientSecret calls RegenerateClientSecretFunc.
+func (mock *KeycloakInterfaceMock) RegenerateClientSecret(ctx context.Context, clientID string, realmName string) (string, error) {
+	if mock.RegenerateClientSecretFunc == nil {
+		panic("KeycloakInterfaceMock.RegenerateClientSecretFunc: method is nil but KeycloakInterface.RegenerateClientSecret was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
 		ClientID  string
 		RealmName string
 	}{
+		Ctx:       ctx,
 		ClientID:  clientID,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockGetClient.Lock()
-	mock.calls.GetClient = append(mock.calls.GetClient, callInfo)
-	lockKeycloakInterfaceMockGetClient.Unlock()
-	return mock.GetClientFunc(clientID, realmName)
+	lockKeycloakInterfaceMockRegenerateClientSecret.Lock()
+	mock.calls.RegenerateClientSecret = append(mock.calls.RegenerateClientSecret, callInfo)
+	lockKeycloakInterfaceMockRegenerateClientSecret.Unlock()
+	return mock.RegenerateClientSecretFunc(ctx, clientID, realmName)
 }
 
-// GetClientCalls gets all the calls that were made to GetClient.
+// RegenerateClientSecretCalls gets all the calls that were made to RegenerateClientSecret.
 // Check the length with:
-//     len(mockedKeycloakInterface.GetClientCalls())
-func (mock *KeycloakInterfaceMock) GetClientCalls() []struct {
+//     len(mockedKeycloakInterface.RegenerateClientSecretCalls())
+func (mock *KeycloakInterfaceMock) RegenerateClientSecretCalls() []struct {
+	Ctx       context.Context
 	ClientID  string
 	RealmName string
 } {
 	var calls []struct {
+		Ctx       context.Context
 		ClientID  string
 		RealmName string
 	}
-	lockKeycloakInterfaceMockGetClient.RLock()
-	calls = mock.calls.GetClient
-	lockKeycloakInterfaceMockGetClient.RUnlock()
+	lockKeycloakInterfaceMockRegenerateClientSecret.RLock()
+	calls = mock.calls.RegenerateClientSecret
+	lockKeycloakInterfaceMockRegenerateClientSecret.RUnlock()
 	return calls
 }
 
-// GetClientInstall calls GetClientInstallFunc.
-func (mock *KeycloakInterfaceMock) GetClientInstall(clientID string, realmName string) ([]byte, error) {
-	if mock.GetClientInstallFunc == nil {
-		panic("KeycloakInterfaceMock.GetClientInstallFunc: method is nil but KeycloakInterface.GetClientInstall was just called")
+// RegisterClientNode calls RegisterClientNodeFunc.
+func (mock *KeycloakInterfaceMock) RegisterClientNode(ctx context.Context, clientID string, realmName string, nodeHost string) error {
+	if mock.RegisterClientNodeFunc == nil {
+		panic("KeycloakInterfaceMock.RegisterClientNodeFunc: method is nil but KeycloakInterface.RegisterClientNode was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
 		ClientID  string
 		RealmName string
+		NodeHost  string
 	}{
+		Ctx:       ctx,
 		ClientID:  clientID,
 		RealmName: realmName,
+		NodeHost:  nodeHost,
 	}
-	lockKeycloakInterfaceMockGetClientInstall.Lock()
-	mock.calls.GetClientInstall = append(mock.calls.GetClientInstall, callInfo)
-	lockKeycloakInterfaceMockGetClientInstall.Unlock()
-	return mock.GetClientInstallFunc(clientID, realmName)
+	lockKeycloakInterfaceMockRegisterClientNode.Lock()
+	mock.calls.RegisterClientNode = append(mock.calls.RegisterClientNode, callInfo)
+	lockKeycloakInterfaceMockRegisterClientNode.Unlock()
+	return mock.RegisterClientNodeFunc(ctx, clientID, realmName, nodeHost)
 }
 
-// GetClientInstallCalls gets all the calls that were made to GetClientInstall.
+// RegisterClientNodeCalls gets all the calls that were made to RegisterClientNode.
 // Check the length with:
-//     len(mockedKeycloakInterface.GetClientInstallCalls())
-func (mock *KeycloakInterfaceMock) GetClientInstallCalls() []struct {
+//     len(mockedKeycloakInterface.RegisterClientNodeCalls())
+func (mock *KeycloakInterfaceMock) RegisterClientNodeCalls() []struct {
+	Ctx       context.Context
 	ClientID  string
 	RealmName string
+	NodeHost  string
 } {
 	var calls []struct {
+		Ctx       context.Context
 		ClientID  string
 		RealmName string
+		NodeHost  string
 	}
-	lockKeycloakInterfaceMockGetClientInstall.RLock()
-	calls = mock.calls.GetClientInstall
-	lockKeycloakInterfaceMockGetClientInstall.RUnlock()
+	lockKeycloakInterfaceMockRegisterClientNode.RLock()
+	calls = mock.calls.RegisterClientNode
+	lockKeycloakInterfaceMockRegisterClientNode.RUnlock()
 	return calls
 }
 
-// GetClientSecret calls GetClientSecretFunc.
-func (mock *KeycloakInterfaceMock) GetClientSecret(clientID string, realmName string) (string, error) {
-	if mock.GetClientSecretFunc == nil {
-		panic("KeycloakInterfaceMock.GetClientSecretFunc: method is nil but KeycloakInterface.GetClientSecret was just called")
+// RegisterRequiredAction calls RegisterRequiredActionFunc.
+func (mock *KeycloakInterfaceMock) RegisterRequiredAction(ctx context.Context, realmName string, providerID string) error {
+	if mock.RegisterRequiredActionFunc == nil {
+		panic("KeycloakInterfaceMock.RegisterRequiredActionFunc: method is nil but KeycloakInterface.RegisterRequiredAction was just called")
 	}
 	callInfo := struct {
-		ClientID  string
-		RealmName string
+		Ctx        context.Context
+		RealmName  string
+		ProviderID string
 	}{
-		ClientID:  clientID,
-		RealmName: realmName,
+		Ctx:        ctx,
+		RealmName:  realmName,
+		ProviderID: providerID,
 	}
-	lockKeycloakInterfaceMockGetClientSecret.Lock()
-	mock.calls.GetClientSecret = append(mock.calls.GetClientSecret, callInfo)
-	lockKeycloakInterfaceMockGetClientSecret.Unlock()
-	return mock.GetClientSecretFunc(clientID, realmName)
+	lockKeycloakInterfaceMockRegisterRequiredAction.Lock()
+	mock.calls.RegisterRequiredAction = append(mock.calls.RegisterRequiredAction, callInfo)
+	lockKeycloakInterfaceMockRegisterRequiredAction.Unlock()
+	return mock.RegisterRequiredActionFunc(ctx, realmName, providerID)
 }
 
-// GetClientSecretCalls gets all the calls that were made to GetClientSecret.
+// RegisterRequiredActionCalls gets all the calls that were made to RegisterRequiredAction.
 // Check the length with:
-//     len(mockedKeycloakInterface.GetClientSecretCalls())
-func (mock *KeycloakInterfaceMock) GetClientSecretCalls() []struct {
-	ClientID  string
-	RealmName string
+//     len(mockedKeycloakInterface.RegisterRequiredActionCalls())
+func (mock *KeycloakInterfaceMock) RegisterRequiredActionCalls() []struct {
+	Ctx        context.Context
+	RealmName  string
+	ProviderID string
 } {
 	var calls []struct {
-		ClientID  string
-		RealmName string
+		Ctx        context.Context
+		RealmName  string
+		ProviderID string
 	}
-	lockKeycloakInterfaceMockGetClientSecret.RLock()
-	calls = mock.calls.GetClientSecret
-	lockKeycloakInterfaceMockGetClientSecret.RUnlock()
+	lockKeycloakInterfaceMockRegisterRequiredAction.RLock()
+	calls = mock.calls.RegisterRequiredAction
+	lockKeycloakInterfaceMockRegisterRequiredAction.RUnlock()
 	return calls
 }
 
-// GetIdentityProvider calls GetIdentityProviderFunc.
-func (mock *KeycloakInterfaceMock) GetIdentityProvider(alias string, realmName string) (*v1alpha1.KeycloakIdentityProvider, error) {
-	if mock.GetIdentityProviderFunc == nil {
-		panic("KeycloakInterfaceMock.GetIdentityProviderFunc: method is nil but KeycloakInterface.GetIdentityProvider was just called")
+// RemoveCompositesFromClientRole calls RemoveCompositesFromClientRoleFunc.
+func (mock *KeycloakInterfaceMock) RemoveCompositesFromClientRole(ctx context.Context, clientID string, roleName string, realmName string, composites []*v1alpha1.KeycloakUserRole) error {
+	if mock.RemoveCompositesFromClientRoleFunc == nil {
+		panic("KeycloakInterfaceMock.RemoveCompositesFromClientRoleFunc: method is nil but KeycloakInterface.RemoveCompositesFromClientRole was just called")
 	}
 	callInfo := struct {
-		Alias     string
-		RealmName string
+		Ctx        context.Context
+		ClientID   string
+		RoleName   string
+		RealmName  string
+		Composites []*v1alpha1.KeycloakUserRole
 	}{
-		Alias:     alias,
-		RealmName: realmName,
+		Ctx:        ctx,
+		ClientID:   clientID,
+		RoleName:   roleName,
+		RealmName:  realmName,
+		Composites: composites,
 	}
-	lockKeycloakInterfaceMockGetIdentityProvider.Lock()
-	mock.calls.GetIdentityProvider = append(mock.calls.GetIdentityProvider, callInfo)
-	lockKeycloakInterfaceMockGetIdentityProvider.Unlock()
-	return mock.GetIdentityProviderFunc(alias, realmName)
+	lockKeycloakInterfaceMockRemoveCompositesFromClientRole.Lock()
+	mock.calls.RemoveCompositesFromClientRole = append(mock.calls.RemoveCompositesFromClientRole, callInfo)
+	lockKeycloakInterfaceMockRemoveCompositesFromClientRole.Unlock()
+	return mock.RemoveCompositesFromClientRoleFunc(ctx, clientID, roleName, realmName, composites)
 }
 
-// GetIdentityProviderCalls gets all the calls that were made to GetIdentityProvider.
+// RemoveCompositesFromClientRoleCalls gets all the calls that were made to RemoveCompositesFromClientRole.
 // Check the length with:
-//     len(mockedKeycloakInterface.GetIdentityProviderCalls())
-func (mock *KeycloakInterfaceMock) GetIdentityProviderCalls() []struct {
-	Alias     string
-	RealmName string
+//     len(mockedKeycloakInterface.RemoveCompositesFromClientRoleCalls())
+func (mock *KeycloakInterfaceMock) RemoveCompositesFromClientRoleCalls() []struct {
+	Ctx        context.Context
+	ClientID   string
+	RoleName   string
+	RealmName  string
+	Composites []*v1alpha1.KeycloakUserRole
 } {
 	var calls []struct {
-		Alias     string
-		RealmName string
+		Ctx        context.Context
+		ClientID   string
+		RoleName   string
+		RealmName  string
+		Composites []*v1alpha1.KeycloakUserRole
 	}
-	lockKeycloakInterfaceMockGetIdentityProvider.RLock()
-	calls = mock.calls.GetIdentityProvider
-	lockKeycloakInterfaceMockGetIdentityProvider.RUnlock()
+	lockKeycloakInterfaceMockRemoveCompositesFromClientRole.RLock()
+	calls = mock.calls.RemoveCompositesFromClientRole
+	lockKeycloakInterfaceMockRemoveCompositesFromClientRole.RUnlock()
 	return calls
 }
 
-// GetRealm calls GetRealmFunc.
-func (mock *KeycloakInterfaceMock) GetRealm(realmName string) (*v1alpha1.KeycloakRealm, error) {
-	if mock.GetRealmFunc == nil {
-		panic("KeycloakInterfaceMock.GetRealmFunc: method is nil but KeycloakInterface.GetRealm was just called")
+// RemoveDefaultClientScopeFromClient calls RemoveDefaultClientScopeFromClientFunc.
+func (mock *KeycloakInterfaceMock) RemoveDefaultClientScopeFromClient(ctx context.Context, clientID string, scopeID string, realmName string) error {
+	if mock.RemoveDefaultClientScopeFromClientFunc == nil {
+		panic("KeycloakInterfaceMock.RemoveDefaultClientScopeFromClientFunc: method is nil but KeycloakInterface.RemoveDefaultClientScopeFromClient was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		ScopeID   string
 		RealmName string
 	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		ScopeID:   scopeID,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockGetRealm.Lock()
-	mock.calls.GetRealm = append(mock.calls.GetRealm, callInfo)
-	lockKeycloakInterfaceMockGetRealm.Unlock()
-	return mock.GetRealmFunc(realmName)
+	lockKeycloakInterfaceMockRemoveDefaultClientScopeFromClient.Lock()
+	mock.calls.RemoveDefaultClientScopeFromClient = append(mock.calls.RemoveDefaultClientScopeFromClient, callInfo)
+	lockKeycloakInterfaceMockRemoveDefaultClientScopeFromClient.Unlock()
+	return mock.RemoveDefaultClientScopeFromClientFunc(ctx, clientID, scopeID, realmName)
 }
 
-// GetRealmCalls gets all the calls that were made to GetRealm.
+// RemoveDefaultClientScopeFromClientCalls gets all the calls that were made to RemoveDefaultClientScopeFromClient.
 // Check the length with:
-//     len(mockedKeycloakInterface.GetRealmCalls())
-func (mock *KeycloakInterfaceMock) GetRealmCalls() []struct {
+//     len(mockedKeycloakInterface.RemoveDefaultClientScopeFromClientCalls())
+func (mock *KeycloakInterfaceMock) RemoveDefaultClientScopeFromClientCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	ScopeID   string
 	RealmName string
 } {
 	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		ScopeID   string
 		RealmName string
 	}
-	lockKeycloakInterfaceMockGetRealm.RLock()
-	calls = mock.calls.GetRealm
-	lockKeycloakInterfaceMockGetRealm.RUnlock()
+	lockKeycloakInterfaceMockRemoveDefaultClientScopeFromClient.RLock()
+	calls = mock.calls.RemoveDefaultClientScopeFromClient
+	lockKeycloakInterfaceMockRemoveDefaultClientScopeFromClient.RUnlock()
 	return calls
 }
 
-// GetUser calls GetUserFunc.
-func (mock *KeycloakInterfaceMock) GetUser(userID string, realmName string) (*v1alpha1.KeycloakAPIUser, error) {
-	if mock.GetUserFunc == nil {
-		panic("KeycloakInterfaceMock.GetUserFunc: method is nil but KeycloakInterface.GetUser was just called")
+// RemoveFederatedIdentity calls RemoveFederatedIdentityFunc.
+func (mock *KeycloakInterfaceMock) RemoveFederatedIdentity(ctx context.Context, fid v1alpha1.FederatedIdentity, userID string, realmName string) error {
+	if mock.RemoveFederatedIdentityFunc == nil {
+		panic("KeycloakInterfaceMock.RemoveFederatedIdentityFunc: method is nil but KeycloakInterface.RemoveFederatedIdentity was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
+		Fid       v1alpha1.FederatedIdentity
 		UserID    string
 		RealmName string
 	}{
+		Ctx:       ctx,
+		Fid:       fid,
 		UserID:    userID,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockGetUser.Lock()
-	mock.calls.GetUser = append(mock.calls.GetUser, callInfo)
-	lockKeycloakInterfaceMockGetUser.Unlock()
-	return mock.GetUserFunc(userID, realmName)
+	lockKeycloakInterfaceMockRemoveFederatedIdentity.Lock()
+	mock.calls.RemoveFederatedIdentity = append(mock.calls.RemoveFederatedIdentity, callInfo)
+	lockKeycloakInterfaceMockRemoveFederatedIdentity.Unlock()
+	return mock.RemoveFederatedIdentityFunc(ctx, fid, userID, realmName)
 }
 
-// GetUserCalls gets all the calls that were made to GetUser.
+// RemoveFederatedIdentityCalls gets all the calls that were made to RemoveFederatedIdentity.
 // Check the length with:
-//     len(mockedKeycloakInterface.GetUserCalls())
-func (mock *KeycloakInterfaceMock) GetUserCalls() []struct {
+//     len(mockedKeycloakInterface.RemoveFederatedIdentityCalls())
+func (mock *KeycloakInterfaceMock) RemoveFederatedIdentityCalls() []struct {
+	Ctx       context.Context
+	Fid       v1alpha1.FederatedIdentity
 	UserID    string
 	RealmName string
 } {
 	var calls []struct {
+		Ctx       context.Context
+		Fid       v1alpha1.FederatedIdentity
 		UserID    string
 		RealmName string
 	}
-	lockKeycloakInterfaceMockGetUser.RLock()
-	calls = mock.calls.GetUser
-	lockKeycloakInterfaceMockGetUser.RUnlock()
+	lockKeycloakInterfaceMockRemoveFederatedIdentity.RLock()
+	calls = mock.calls.RemoveFederatedIdentity
+	lockKeycloakInterfaceMockRemoveFederatedIdentity.RUnlock()
 	return calls
 }
 
-// GetUserFederatedIdentities calls GetUserFederatedIdentitiesFunc.
-func (mock *KeycloakInterfaceMock) GetUserFederatedIdentities(userName string, realmName string) ([]v1alpha1.FederatedIdentity, error) {
-	if mock.GetUserFederatedIdentitiesFunc == nil {
-		panic("KeycloakInterfaceMock.GetUserFederatedIdentitiesFunc: method is nil but KeycloakInterface.GetUserFederatedIdentities was just called")
+// RemoveOptionalClientScopeFromClient calls RemoveOptionalClientScopeFromClientFunc.
+func (mock *KeycloakInterfaceMock) RemoveOptionalClientScopeFromClient(ctx context.Context, clientID string, scopeID string, realmName string) error {
+	if mock.RemoveOptionalClientScopeFromClientFunc == nil {
+		panic("KeycloakInterfaceMock.RemoveOptionalClientScopeFromClientFunc: method is nil but KeycloakInterface.RemoveOptionalClientScopeFromClient was just called")
 	}
 	callInfo := struct {
-		UserName  string
+		Ctx       context.Context
+		ClientID  string
+		ScopeID   string
 		RealmName string
 	}{
-		UserName:  userName,
+		Ctx:       ctx,
+		ClientID:  clientID,
+		ScopeID:   scopeID,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockGetUserFederatedIdentities.Lock()
-	mock.calls.GetUserFederatedIdentities = append(mock.calls.GetUserFederatedIdentities, callInfo)
-	lockKeycloakInterfaceMockGetUserFederatedIdentities.Unlock()
-	return mock.GetUserFederatedIdentitiesFunc(userName, realmName)
+	lockKeycloakInterfaceMockRemoveOptionalClientScopeFromClient.Lock()
+	mock.calls.RemoveOptionalClientScopeFromClient = append(mock.calls.RemoveOptionalClientScopeFromClient, callInfo)
+	lockKeycloakInterfaceMockRemoveOptionalClientScopeFromClient.Unlock()
+	return mock.RemoveOptionalClientScopeFromClientFunc(ctx, clientID, scopeID, realmName)
 }
 
-// GetUserFederatedIdentitiesCalls gets all the calls that were made to GetUserFederatedIdentities.
+// RemoveOptionalClientScopeFromClientCalls gets all the calls that were made to RemoveOptionalClientScopeFromClient.
 // Check the length with:
-//     len(mockedKeycloakInterface.GetUserFederatedIdentitiesCalls())
-func (mock *KeycloakInterfaceMock) GetUserFederatedIdentitiesCalls() []struct {
-	UserName  string
+//     len(mockedKeycloakInterface.RemoveOptionalClientScopeFromClientCalls())
+func (mock *KeycloakInterfaceMock) RemoveOptionalClientScopeFromClientCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	ScopeID   string
 	RealmName string
 } {
 	var calls []struct {
-		UserName  string
+		Ctx       context.Context
+		ClientID  string
+		ScopeID   string
 		RealmName string
 	}
-	lockKeycloakInterfaceMockGetUserFederatedIdentities.RLock()
-	calls = mock.calls.GetUserFederatedIdentities
-	lockKeycloakInterfaceMockGetUserFederatedIdentities.RUnlock()
+	lockKeycloakInterfaceMockRemoveOptionalClientScopeFromClient.RLock()
+	calls = mock.calls.RemoveOptionalClientScopeFromClient
+	lockKeycloakInterfaceMockRemoveOptionalClientScopeFromClient.RUnlock()
 	return calls
 }
 
-// ListAuthenticationExecutionsForFlow calls ListAuthenticationExecutionsForFlowFunc.
-func (mock *KeycloakInterfaceMock) ListAuthenticationExecutionsForFlow(flowAlias string, realmName string) ([]*v1alpha1.AuthenticationExecutionInfo, error) {
-	if mock.ListAuthenticationExecutionsForFlowFunc == nil {
-		panic("KeycloakInterfaceMock.ListAuthenticationExecutionsForFlowFunc: method is nil but KeycloakInterface.ListAuthenticationExecutionsForFlow was just called")
+// ResolveGroupPath calls ResolveGroupPathFunc.
+func (mock *KeycloakInterfaceMock) ResolveGroupPath(ctx context.Context, path string, realmName string, createMissing bool) (string, error) {
+	if mock.ResolveGroupPathFunc == nil {
+		panic("KeycloakInterfaceMock.ResolveGroupPathFunc: method is nil but KeycloakInterface.ResolveGroupPath was just called")
 	}
 	callInfo := struct {
-		FlowAlias string
-		RealmName string
+		Ctx           context.Context
+		Path          string
+		RealmName     string
+		CreateMissing bool
 	}{
-		FlowAlias: flowAlias,
-		RealmName: realmName,
-	}
-	lockKeycloakInterfaceMockListAuthenticationExecutionsForFlow.Lock()
-	mock.calls.ListAuthenticationExecutionsForFlow = append(mock.calls.ListAuthenticationExecutionsForFlow, callInfo)
-	lockKeycloakInterfaceMockListAuthenticationExecutionsForFlow.Unlock()
-	return mock.ListAuthenticationExecutionsForFlowFunc(flowAlias, realmName)
+		Ctx:           ctx,
+		Path:          path,
+		RealmName:     realmName,
+		CreateMissing: createMissing,
+	}
+	lockKeycloakInterfaceMockResolveGroupPath.Lock()
+	mock.calls.ResolveGroupPath = append(mock.calls.ResolveGroupPath, callInfo)
+	lockKeycloakInterfaceMockResolveGroupPath.Unlock()
+	return mock.ResolveGroupPathFunc(ctx, path, realmName, createMissing)
 }
 
-// ListAuthenticationExecutionsForFlowCalls gets all the calls that were made to ListAuthenticationExecutionsForFlow.
+// ResolveGroupPathCalls gets all the calls that were made to ResolveGroupPath.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListAuthenticationExecutionsForFlowCalls())
-func (mock *KeycloakInterfaceMock) ListAuthenticationExecutionsForFlowCalls() []struct {
-	FlowAlias string
-	RealmName string
+//     len(mockedKeycloakInterface.ResolveGroupPathCalls())
+func (mock *KeycloakInterfaceMock) ResolveGroupPathCalls() []struct {
+	Ctx           context.Context
+	Path          string
+	RealmName     string
+	CreateMissing bool
 } {
 	var calls []struct {
-		FlowAlias string
-		RealmName string
-	}
-	lockKeycloakInterfaceMockListAuthenticationExecutionsForFlow.RLock()
-	calls = mock.calls.ListAuthenticationExecutionsForFlow
-	lockKeycloakInterfaceMockListAuthenticationExecutionsForFlow.RUnlock()
+		Ctx           context.Context
+		Path          string
+		RealmName     string
+		CreateMissing bool
+	}
+	lockKeycloakInterfaceMockResolveGroupPath.RLock()
+	calls = mock.calls.ResolveGroupPath
+	lockKeycloakInterfaceMockResolveGroupPath.RUnlock()
 	return calls
 }
 
-// ListAvailableGroupClientRoles calls ListAvailableGroupClientRolesFunc.
-func (mock *KeycloakInterfaceMock) ListAvailableGroupClientRoles(realmName string, clientID string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
-	if mock.ListAvailableGroupClientRolesFunc == nil {
-		panic("KeycloakInterfaceMock.ListAvailableGroupClientRolesFunc: method is nil but KeycloakInterface.ListAvailableGroupClientRoles was just called")
+// RevokeOfflineSession calls RevokeOfflineSessionFunc.
+func (mock *KeycloakInterfaceMock) RevokeOfflineSession(ctx context.Context, userID string, clientID string, realmName string) error {
+	if mock.RevokeOfflineSessionFunc == nil {
+		panic("KeycloakInterfaceMock.RevokeOfflineSessionFunc: method is nil but KeycloakInterface.RevokeOfflineSession was just called")
 	}
 	callInfo := struct {
-		RealmName string
+		Ctx       context.Context
+		UserID    string
 		ClientID  string
-		GroupID   string
+		RealmName string
 	}{
-		RealmName: realmName,
+		Ctx:       ctx,
+		UserID:    userID,
 		ClientID:  clientID,
-		GroupID:   groupID,
+		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockListAvailableGroupClientRoles.Lock()
-	mock.calls.ListAvailableGroupClientRoles = append(mock.calls.ListAvailableGroupClientRoles, callInfo)
-	lockKeycloakInterfaceMockListAvailableGroupClientRoles.Unlock()
-	return mock.ListAvailableGroupClientRolesFunc(realmName, clientID, groupID)
+	lockKeycloakInterfaceMockRevokeOfflineSession.Lock()
+	mock.calls.RevokeOfflineSession = append(mock.calls.RevokeOfflineSession, callInfo)
+	lockKeycloakInterfaceMockRevokeOfflineSession.Unlock()
+	return mock.RevokeOfflineSessionFunc(ctx, userID, clientID, realmName)
 }
 
-// ListAvailableGroupClientRolesCalls gets all the calls that were made to ListAvailableGroupClientRoles.
+// RevokeOfflineSessionCalls gets all the calls that were made to RevokeOfflineSession.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListAvailableGroupClientRolesCalls())
-func (mock *KeycloakInterfaceMock) ListAvailableGroupClientRolesCalls() []struct {
-	RealmName string
+//     len(mockedKeycloakInterface.RevokeOfflineSessionCalls())
+func (mock *KeycloakInterfaceMock) RevokeOfflineSessionCalls() []struct {
+	Ctx       context.Context
+	UserID    string
 	ClientID  string
-	GroupID   string
+	RealmName string
 } {
 	var calls []struct {
-		RealmName string
+		Ctx       context.Context
+		UserID    string
 		ClientID  string
-		GroupID   string
+		RealmName string
 	}
-	lockKeycloakInterfaceMockListAvailableGroupClientRoles.RLock()
-	calls = mock.calls.ListAvailableGroupClientRoles
-	lockKeycloakInterfaceMockListAvailableGroupClientRoles.RUnlock()
+	lockKeycloakInterfaceMockRevokeOfflineSession.RLock()
+	calls = mock.calls.RevokeOfflineSession
+	lockKeycloakInterfaceMockRevokeOfflineSession.RUnlock()
 	return calls
 }
 
-// ListAvailableGroupRealmRoles calls ListAvailableGroupRealmRolesFunc.
-func (mock *KeycloakInterfaceMock) ListAvailableGroupRealmRoles(realmName string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
-	if mock.ListAvailableGroupRealmRolesFunc == nil {
-		panic("KeycloakInterfaceMock.ListAvailableGroupRealmRolesFunc: method is nil but KeycloakInterface.ListAvailableGroupRealmRoles was just called")
+// RevokeToken calls RevokeTokenFunc.
+func (mock *KeycloakInterfaceMock) RevokeToken(ctx context.Context, realmName string, clientID string, clientSecret string, token string, tokenTypeHint string) error {
+	if mock.RevokeTokenFunc == nil {
+		panic("KeycloakInterfaceMock.RevokeTokenFunc: method is nil but KeycloakInterface.RevokeToken was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		RealmName     string
+		ClientID      string
+		ClientSecret  string
+		Token         string
+		TokenTypeHint string
+	}{
+		Ctx:           ctx,
+		RealmName:     realmName,
+		ClientID:      clientID,
+		ClientSecret:  clientSecret,
+		Token:         token,
+		TokenTypeHint: tokenTypeHint,
+	}
+	lockKeycloakInterfaceMockRevokeToken.Lock()
+	mock.calls.RevokeToken = append(mock.calls.RevokeToken, callInfo)
+	lockKeycloakInterfaceMockRevokeToken.Unlock()
+	return mock.RevokeTokenFunc(ctx, realmName, clientID, clientSecret, token, tokenTypeHint)
+}
+
+// RevokeTokenCalls gets all the calls that were made to RevokeToken.
+// Check the length with:
+//     len(mockedKeycloakInterface.RevokeTokenCalls())
+func (mock *KeycloakInterfaceMock) RevokeTokenCalls() []struct {
+	Ctx           context.Context
+	RealmName     string
+	ClientID      string
+	ClientSecret  string
+	Token         string
+	TokenTypeHint string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		RealmName     string
+		ClientID      string
+		ClientSecret  string
+		Token         string
+		TokenTypeHint string
+	}
+	lockKeycloakInterfaceMockRevokeToken.RLock()
+	calls = mock.calls.RevokeToken
+	lockKeycloakInterfaceMockRevokeToken.RUnlock()
+	return calls
+}
+
+// RevokeUserConsent calls RevokeUserConsentFunc.
+func (mock *KeycloakInterfaceMock) RevokeUserConsent(ctx context.Context, userID string, clientID string, realmName string) error {
+	if mock.RevokeUserConsentFunc == nil {
+		panic("KeycloakInterfaceMock.RevokeUserConsentFunc: method is nil but KeycloakInterface.RevokeUserConsent was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
+		UserID    string
+		ClientID  string
 		RealmName string
-		GroupID   string
 	}{
+		Ctx:       ctx,
+		UserID:    userID,
+		ClientID:  clientID,
 		RealmName: realmName,
-		GroupID:   groupID,
 	}
-	lockKeycloakInterfaceMockListAvailableGroupRealmRoles.Lock()
-	mock.calls.ListAvailableGroupRealmRoles = append(mock.calls.ListAvailableGroupRealmRoles, callInfo)
-	lockKeycloakInterfaceMockListAvailableGroupRealmRoles.Unlock()
-	return mock.ListAvailableGroupRealmRolesFunc(realmName, groupID)
+	lockKeycloakInterfaceMockRevokeUserConsent.Lock()
+	mock.calls.RevokeUserConsent = append(mock.calls.RevokeUserConsent, callInfo)
+	lockKeycloakInterfaceMockRevokeUserConsent.Unlock()
+	return mock.RevokeUserConsentFunc(ctx, userID, clientID, realmName)
 }
 
-// ListAvailableGroupRealmRolesCalls gets all the calls that were made to ListAvailableGroupRealmRoles.
+// RevokeUserConsentCalls gets all the calls that were made to RevokeUserConsent.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListAvailableGroupRealmRolesCalls())
-func (mock *KeycloakInterfaceMock) ListAvailableGroupRealmRolesCalls() []struct {
+//     len(mockedKeycloakInterface.RevokeUserConsentCalls())
+func (mock *KeycloakInterfaceMock) RevokeUserConsentCalls() []struct {
+	Ctx       context.Context
+	UserID    string
+	ClientID  string
 	RealmName string
-	GroupID   string
 } {
 	var calls []struct {
+		Ctx       context.Context
+		UserID    string
+		ClientID  string
 		RealmName string
-		GroupID   string
 	}
-	lockKeycloakInterfaceMockListAvailableGroupRealmRoles.RLock()
-	calls = mock.calls.ListAvailableGroupRealmRoles
-	lockKeycloakInterfaceMockListAvailableGroupRealmRoles.RUnlock()
+	lockKeycloakInterfaceMockRevokeUserConsent.RLock()
+	calls = mock.calls.RevokeUserConsent
+	lockKeycloakInterfaceMockRevokeUserConsent.RUnlock()
 	return calls
 }
 
-// ListAvailableUserClientRoles calls ListAvailableUserClientRolesFunc.
-func (mock *KeycloakInterfaceMock) ListAvailableUserClientRoles(realmName string, clientID string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
-	if mock.ListAvailableUserClientRolesFunc == nil {
-		panic("KeycloakInterfaceMock.ListAvailableUserClientRolesFunc: method is nil but KeycloakInterface.ListAvailableUserClientRoles was just called")
+// SetGroupChild calls SetGroupChildFunc.
+func (mock *KeycloakInterfaceMock) SetGroupChild(ctx context.Context, groupID string, realmName string, childGroup *Group) error {
+	if mock.SetGroupChildFunc == nil {
+		panic("KeycloakInterfaceMock.SetGroupChildFunc: method is nil but KeycloakInterface.SetGroupChild was just called")
 	}
 	callInfo := struct {
-		RealmName string
-		ClientID  string
-		UserID    string
+		Ctx        context.Context
+		GroupID    string
+		RealmName  string
+		ChildGroup *Group
 	}{
-		RealmName: realmName,
-		ClientID:  clientID,
-		UserID:    userID,
+		Ctx:        ctx,
+		GroupID:    groupID,
+		RealmName:  realmName,
+		ChildGroup: childGroup,
 	}
-	lockKeycloakInterfaceMockListAvailableUserClientRoles.Lock()
-	mock.calls.ListAvailableUserClientRoles = append(mock.calls.ListAvailableUserClientRoles, callInfo)
-	lockKeycloakInterfaceMockListAvailableUserClientRoles.Unlock()
-	return mock.ListAvailableUserClientRolesFunc(realmName, clientID, userID)
+	lockKeycloakInterfaceMockSetGroupChild.Lock()
+	mock.calls.SetGroupChild = append(mock.calls.SetGroupChild, callInfo)
+	lockKeycloakInterfaceMockSetGroupChild.Unlock()
+	return mock.SetGroupChildFunc(ctx, groupID, realmName, childGroup)
 }
 
-// ListAvailableUserClientRolesCalls gets all the calls that were made to ListAvailableUserClientRoles.
+// SetGroupChildCalls gets all the calls that were made to SetGroupChild.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListAvailableUserClientRolesCalls())
-func (mock *KeycloakInterfaceMock) ListAvailableUserClientRolesCalls() []struct {
-	RealmName string
-	ClientID  string
-	UserID    string
+//     len(mockedKeycloakInterface.SetGroupChildCalls())
+func (mock *KeycloakInterfaceMock) SetGroupChildCalls() []struct {
+	Ctx        context.Context
+	GroupID    string
+	RealmName  string
+	ChildGroup *Group
 } {
 	var calls []struct {
-		RealmName string
-		ClientID  string
-		UserID    string
+		Ctx        context.Context
+		GroupID    string
+		RealmName  string
+		ChildGroup *Group
 	}
-	lockKeycloakInterfaceMockListAvailableUserClientRoles.RLock()
-	calls = mock.calls.ListAvailableUserClientRoles
-	lockKeycloakInterfaceMockListAvailableUserClientRoles.RUnlock()
+	lockKeycloakInterfaceMockSetGroupChild.RLock()
+	calls = mock.calls.SetGroupChild
+	lockKeycloakInterfaceMockSetGroupChild.RUnlock()
 	return calls
 }
 
-// ListAvailableUserRealmRoles calls ListAvailableUserRealmRolesFunc.
-func (mock *KeycloakInterfaceMock) ListAvailableUserRealmRoles(realmName string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
-	if mock.ListAvailableUserRealmRolesFunc == nil {
-		panic("KeycloakInterfaceMock.ListAvailableUserRealmRolesFunc: method is nil but KeycloakInterface.ListAvailableUserRealmRoles was just called")
+// SetRealmAttribute calls SetRealmAttributeFunc.
+func (mock *KeycloakInterfaceMock) SetRealmAttribute(ctx context.Context, realmName string, key string, value string) error {
+	if mock.SetRealmAttributeFunc == nil {
+		panic("KeycloakInterfaceMock.SetRealmAttributeFunc: method is nil but KeycloakInterface.SetRealmAttribute was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
 		RealmName string
-		UserID    string
+		Key       string
+		Value     string
 	}{
+		Ctx:       ctx,
 		RealmName: realmName,
-		UserID:    userID,
+		Key:       key,
+		Value:     value,
 	}
-	lockKeycloakInterfaceMockListAvailableUserRealmRoles.Lock()
-	mock.calls.ListAvailableUserRealmRoles = append(mock.calls.ListAvailableUserRealmRoles, callInfo)
-	lockKeycloakInterfaceMockListAvailableUserRealmRoles.Unlock()
-	return mock.ListAvailableUserRealmRolesFunc(realmName, userID)
+	lockKeycloakInterfaceMockSetRealmAttribute.Lock()
+	mock.calls.SetRealmAttribute = append(mock.calls.SetRealmAttribute, callInfo)
+	lockKeycloakInterfaceMockSetRealmAttribute.Unlock()
+	return mock.SetRealmAttributeFunc(ctx, realmName, key, value)
 }
 
-// ListAvailableUserRealmRolesCalls gets all the calls that were made to ListAvailableUserRealmRoles.
+// SetRealmAttributeCalls gets all the calls that were made to SetRealmAttribute.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListAvailableUserRealmRolesCalls())
-func (mock *KeycloakInterfaceMock) ListAvailableUserRealmRolesCalls() []struct {
+//     len(mockedKeycloakInterface.SetRealmAttributeCalls())
+func (mock *KeycloakInterfaceMock) SetRealmAttributeCalls() []struct {
+	Ctx       context.Context
 	RealmName string
-	UserID    string
+	Key       string
+	Value     string
 } {
 	var calls []struct {
+		Ctx       context.Context
 		RealmName string
-		UserID    string
+		Key       string
+		Value     string
 	}
-	lockKeycloakInterfaceMockListAvailableUserRealmRoles.RLock()
-	calls = mock.calls.ListAvailableUserRealmRoles
-	lockKeycloakInterfaceMockListAvailableUserRealmRoles.RUnlock()
+	lockKeycloakInterfaceMockSetRealmAttribute.RLock()
+	calls = mock.calls.SetRealmAttribute
+	lockKeycloakInterfaceMockSetRealmAttribute.RUnlock()
 	return calls
 }
 
-// ListClients calls ListClientsFunc.
-func (mock *KeycloakInterfaceMock) ListClients(realmName string) ([]*v1alpha1.KeycloakAPIClient, error) {
-	if mock.ListClientsFunc == nil {
-		panic("KeycloakInterfaceMock.ListClientsFunc: method is nil but KeycloakInterface.ListClients was just called")
+// SetTemporaryPassword calls SetTemporaryPasswordFunc.
+func (mock *KeycloakInterfaceMock) SetTemporaryPassword(ctx context.Context, userID string, realmName string, password string) error {
+	if mock.SetTemporaryPasswordFunc == nil {
+		panic("KeycloakInterfaceMock.SetTemporaryPasswordFunc: method is nil but KeycloakInterface.SetTemporaryPassword was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
+		UserID    string
 		RealmName string
+		Password  string
 	}{
+		Ctx:       ctx,
+		UserID:    userID,
 		RealmName: realmName,
+		Password:  password,
 	}
-	lockKeycloakInterfaceMockListClients.Lock()
-	mock.calls.ListClients = append(mock.calls.ListClients, callInfo)
-	lockKeycloakInterfaceMockListClients.Unlock()
-	return mock.ListClientsFunc(realmName)
+	lockKeycloakInterfaceMockSetTemporaryPassword.Lock()
+	mock.calls.SetTemporaryPassword = append(mock.calls.SetTemporaryPassword, callInfo)
+	lockKeycloakInterfaceMockSetTemporaryPassword.Unlock()
+	return mock.SetTemporaryPasswordFunc(ctx, userID, realmName, password)
 }
 
-// ListClientsCalls gets all the calls that were made to ListClients.
+// SetTemporaryPasswordCalls gets all the calls that were made to SetTemporaryPassword.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListClientsCalls())
-func (mock *KeycloakInterfaceMock) ListClientsCalls() []struct {
+//     len(mockedKeycloakInterface.SetTemporaryPasswordCalls())
+func (mock *KeycloakInterfaceMock) SetTemporaryPasswordCalls() []struct {
+	Ctx       context.Context
+	UserID    string
 	RealmName string
+	Password  string
 } {
 	var calls []struct {
+		Ctx       context.Context
+		UserID    string
 		RealmName string
+		Password  string
 	}
-	lockKeycloakInterfaceMockListClients.RLock()
-	calls = mock.calls.ListClients
-	lockKeycloakInterfaceMockListClients.RUnlock()
+	lockKeycloakInterfaceMockSetTemporaryPassword.RLock()
+	calls = mock.calls.SetTemporaryPassword
+	lockKeycloakInterfaceMockSetTemporaryPassword.RUnlock()
 	return calls
 }
 
-// ListDefaultGroups calls ListDefaultGroupsFunc.
-func (mock *KeycloakInterfaceMock) ListDefaultGroups(realmName string) ([]*Group, error) {
-	if mock.ListDefaultGroupsFunc == nil {
-		panic("KeycloakInterfaceMock.ListDefaultGroupsFunc: method is nil but KeycloakInterface.ListDefaultGroups was just called")
+// TestClientNodesAvailable calls TestClientNodesAvailableFunc.
+func (mock *KeycloakInterfaceMock) TestClientNodesAvailable(ctx context.Context, clientID string, realmName string) (*GlobalRequestResult, error) {
+	if mock.TestClientNodesAvailableFunc == nil {
+		panic("KeycloakInterfaceMock.TestClientNodesAvailableFunc: method is nil but KeycloakInterface.TestClientNodesAvailable was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
 	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
 		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockListDefaultGroups.Lock()
-	mock.calls.ListDefaultGroups = append(mock.calls.ListDefaultGroups, callInfo)
-	lockKeycloakInterfaceMockListDefaultGroups.Unlock()
-	return mock.ListDefaultGroupsFunc(realmName)
+	lockKeycloakInterfaceMockTestClientNodesAvailable.Lock()
+	mock.calls.TestClientNodesAvailable = append(mock.calls.TestClientNodesAvailable, callInfo)
+	lockKeycloakInterfaceMockTestClientNodesAvailable.Unlock()
+	return mock.TestClientNodesAvailableFunc(ctx, clientID, realmName)
 }
 
-// ListDefaultGroupsCalls gets all the calls that were made to ListDefaultGroups.
+// TestClientNodesAvailableCalls gets all the calls that were made to TestClientNodesAvailable.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListDefaultGroupsCalls())
-func (mock *KeycloakInterfaceMock) ListDefaultGroupsCalls() []struct {
+//     len(mockedKeycloakInterface.TestClientNodesAvailableCalls())
+func (mock *KeycloakInterfaceMock) TestClientNodesAvailableCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
 	RealmName string
 } {
 	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
 	}
-	lockKeycloakInterfaceMockListDefaultGroups.RLock()
-	calls = mock.calls.ListDefaultGroups
-	lockKeycloakInterfaceMockListDefaultGroups.RUnlock()
+	lockKeycloakInterfaceMockTestClientNodesAvailable.RLock()
+	calls = mock.calls.TestClientNodesAvailable
+	lockKeycloakInterfaceMockTestClientNodesAvailable.RUnlock()
+	return calls
+}
+
+// TriggerLDAPSync calls TriggerLDAPSyncFunc.
+func (mock *KeycloakInterfaceMock) TriggerLDAPSync(ctx context.Context, componentID string, realmName string, syncType LDAPSyncType) (*LDAPSyncResult, error) {
+	if mock.TriggerLDAPSyncFunc == nil {
+		panic("KeycloakInterfaceMock.TriggerLDAPSyncFunc: method is nil but KeycloakInterface.TriggerLDAPSync was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ComponentID string
+		RealmName   string
+		SyncType    LDAPSyncType
+	}{
+		Ctx:         ctx,
+		ComponentID: componentID,
+		RealmName:   realmName,
+		SyncType:    syncType,
+	}
+	lockKeycloakInterfaceMockTriggerLDAPSync.Lock()
+	mock.calls.TriggerLDAPSync = append(mock.calls.TriggerLDAPSync, callInfo)
+	lockKeycloakInterfaceMockTriggerLDAPSync.Unlock()
+	return mock.TriggerLDAPSyncFunc(ctx, componentID, realmName, syncType)
+}
+
+// TriggerLDAPSyncCalls gets all the calls that were made to TriggerLDAPSync.
+// Check the length with:
+//     len(mockedKeycloakInterface.TriggerLDAPSyncCalls())
+func (mock *KeycloakInterfaceMock) TriggerLDAPSyncCalls() []struct {
+	Ctx         context.Context
+	ComponentID string
+	RealmName   string
+	SyncType    LDAPSyncType
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ComponentID string
+		RealmName   string
+		SyncType    LDAPSyncType
+	}
+	lockKeycloakInterfaceMockTriggerLDAPSync.RLock()
+	calls = mock.calls.TriggerLDAPSync
+	lockKeycloakInterfaceMockTriggerLDAPSync.RUnlock()
 	return calls
 }
 
-// ListGroupClientRoles calls ListGroupClientRolesFunc.
-func (mock *KeycloakInterfaceMock) ListGroupClientRoles(realmName string, clientID string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
-	if mock.ListGroupClientRolesFunc == nil {
-		panic("KeycloakInterfaceMock.ListGroupClientRolesFunc: method is nil but KeycloakInterface.ListGroupClientRoles was just called")
+// UnlinkAllUsersFromIdP calls UnlinkAllUsersFromIdPFunc.
+func (mock *KeycloakInterfaceMock) UnlinkAllUsersFromIdP(ctx context.Context, realmName string, providerAlias string, concurrency int) (int, error) {
+	if mock.UnlinkAllUsersFromIdPFunc == nil {
+		panic("KeycloakInterfaceMock.UnlinkAllUsersFromIdPFunc: method is nil but KeycloakInterface.UnlinkAllUsersFromIdP was just called")
 	}
 	callInfo := struct {
-		RealmName string
-		ClientID  string
-		GroupID   string
+		Ctx           context.Context
+		RealmName     string
+		ProviderAlias string
+		Concurrency   int
 	}{
-		RealmName: realmName,
-		ClientID:  clientID,
-		GroupID:   groupID,
-	}
-	lockKeycloakInterfaceMockListGroupClientRoles.Lock()
-	mock.calls.ListGroupClientRoles = append(mock.calls.ListGroupClientRoles, callInfo)
-	lockKeycloakInterfaceMockListGroupClientRoles.Unlock()
-	return mock.ListGroupClientRolesFunc(realmName, clientID, groupID)
+		Ctx:           ctx,
+		RealmName:     realmName,
+		ProviderAlias: providerAlias,
+		Concurrency:   concurrency,
+	}
+	lockKeycloakInterfaceMockUnlinkAllUsersFromIdP.Lock()
+	mock.calls.UnlinkAllUsersFromIdP = append(mock.calls.UnlinkAllUsersFromIdP, callInfo)
+	lockKeycloakInterfaceMockUnlinkAllUsersFromIdP.Unlock()
+	return mock.UnlinkAllUsersFromIdPFunc(ctx, realmName, providerAlias, concurrency)
 }
 
-// ListGroupClientRolesCalls gets all the calls that were made to ListGroupClientRoles.
+// UnlinkAllUsersFromIdPCalls gets all the calls that were made to UnlinkAllUsersFromIdP.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListGroupClientRolesCalls())
-func (mock *KeycloakInterfaceMock) ListGroupClientRolesCalls() []struct {
-	RealmName string
-	ClientID  string
-	GroupID   string
+//     len(mockedKeycloakInterface.UnlinkAllUsersFromIdPCalls())
+func (mock *KeycloakInterfaceMock) UnlinkAllUsersFromIdPCalls() []struct {
+	Ctx           context.Context
+	RealmName     string
+	ProviderAlias string
+	Concurrency   int
 } {
 	var calls []struct {
-		RealmName string
-		ClientID  string
-		GroupID   string
-	}
-	lockKeycloakInterfaceMockListGroupClientRoles.RLock()
-	calls = mock.calls.ListGroupClientRoles
-	lockKeycloakInterfaceMockListGroupClientRoles.RUnlock()
+		Ctx           context.Context
+		RealmName     string
+		ProviderAlias string
+		Concurrency   int
+	}
+	lockKeycloakInterfaceMockUnlinkAllUsersFromIdP.RLock()
+	calls = mock.calls.UnlinkAllUsersFromIdP
+	lockKeycloakInterfaceMockUnlinkAllUsersFromIdP.RUnlock()
 	return calls
 }
 
-// ListGroupRealmRoles calls ListGroupRealmRolesFunc.
-func (mock *KeycloakInterfaceMock) ListGroupRealmRoles(realmName string, groupID string) ([]*v1alpha1.KeycloakUserRole, error) {
-	if mock.ListGroupRealmRolesFunc == nil {
-		panic("KeycloakInterfaceMock.ListGroupRealmRolesFunc: method is nil but KeycloakInterface.ListGroupRealmRoles was just called")
+// UnlinkUserFromIdP calls UnlinkUserFromIdPFunc.
+func (mock *KeycloakInterfaceMock) UnlinkUserFromIdP(ctx context.Context, userID string, realmName string, providerAlias string) error {
+	if mock.UnlinkUserFromIdPFunc == nil {
+		panic("KeycloakInterfaceMock.UnlinkUserFromIdPFunc: method is nil but KeycloakInterface.UnlinkUserFromIdP was just called")
 	}
 	callInfo := struct {
-		RealmName string
-		GroupID   string
+		Ctx           context.Context
+		UserID        string
+		RealmName     string
+		ProviderAlias string
 	}{
-		RealmName: realmName,
-		GroupID:   groupID,
-	}
-	lockKeycloakInterfaceMockListGroupRealmRoles.Lock()
-	mock.calls.ListGroupRealmRoles = append(mock.calls.ListGroupRealmRoles, callInfo)
-	lockKeycloakInterfaceMockListGroupRealmRoles.Unlock()
-	return mock.ListGroupRealmRolesFunc(realmName, groupID)
+		Ctx:           ctx,
+		UserID:        userID,
+		RealmName:     realmName,
+		ProviderAlias: providerAlias,
+	}
+	lockKeycloakInterfaceMockUnlinkUserFromIdP.Lock()
+	mock.calls.UnlinkUserFromIdP = append(mock.calls.UnlinkUserFromIdP, callInfo)
+	lockKeycloakInterfaceMockUnlinkUserFromIdP.Unlock()
+	return mock.UnlinkUserFromIdPFunc(ctx, userID, realmName, providerAlias)
 }
 
-// ListGroupRealmRolesCalls gets all the calls that were made to ListGroupRealmRoles.
+// UnlinkUserFromIdPCalls gets all the calls that were made to UnlinkUserFromIdP.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListGroupRealmRolesCalls())
-func (mock *KeycloakInterfaceMock) ListGroupRealmRolesCalls() []struct {
-	RealmName string
-	GroupID   string
+//     len(mockedKeycloakInterface.UnlinkUserFromIdPCalls())
+func (mock *KeycloakInterfaceMock) UnlinkUserFromIdPCalls() []struct {
+	Ctx           context.Context
+	UserID        string
+	RealmName     string
+	ProviderAlias string
 } {
 	var calls []struct {
-		RealmName string
-		GroupID   string
-	}
-	lockKeycloakInterfaceMockListGroupRealmRoles.RLock()
-	calls = mock.calls.ListGroupRealmRoles
-	lockKeycloakInterfaceMockListGroupRealmRoles.RUnlock()
+		Ctx           context.Context
+		UserID        string
+		RealmName     string
+		ProviderAlias string
+	}
+	lockKeycloakInterfaceMockUnlinkUserFromIdP.RLock()
+	calls = mock.calls.UnlinkUserFromIdP
+	lockKeycloakInterfaceMockUnlinkUserFromIdP.RUnlock()
 	return calls
 }
 
-// ListIdentityProviders calls ListIdentityProvidersFunc.
-func (mock *KeycloakInterfaceMock) ListIdentityProviders(realmName string) ([]*v1alpha1.KeycloakIdentityProvider, error) {
-	if mock.ListIdentityProvidersFunc == nil {
-		panic("KeycloakInterfaceMock.ListIdentityProvidersFunc: method is nil but KeycloakInterface.ListIdentityProviders was just called")
+// UnregisterClientNode calls UnregisterClientNodeFunc.
+func (mock *KeycloakInterfaceMock) UnregisterClientNode(ctx context.Context, clientID string, realmName string, nodeHost string) error {
+	if mock.UnregisterClientNodeFunc == nil {
+		panic("KeycloakInterfaceMock.UnregisterClientNodeFunc: method is nil but KeycloakInterface.UnregisterClientNode was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
+		NodeHost  string
 	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
 		RealmName: realmName,
+		NodeHost:  nodeHost,
 	}
-	lockKeycloakInterfaceMockListIdentityProviders.Lock()
-	mock.calls.ListIdentityProviders = append(mock.calls.ListIdentityProviders, callInfo)
-	lockKeycloakInterfaceMockListIdentityProviders.Unlock()
-	return mock.ListIdentityProvidersFunc(realmName)
+	lockKeycloakInterfaceMockUnregisterClientNode.Lock()
+	mock.calls.UnregisterClientNode = append(mock.calls.UnregisterClientNode, callInfo)
+	lockKeycloakInterfaceMockUnregisterClientNode.Unlock()
+	return mock.UnregisterClientNodeFunc(ctx, clientID, realmName, nodeHost)
 }
 
-// ListIdentityProvidersCalls gets all the calls that were made to ListIdentityProviders.
+// UnregisterClientNodeCalls gets all the calls that were made to UnregisterClientNode.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListIdentityProvidersCalls())
-func (mock *KeycloakInterfaceMock) ListIdentityProvidersCalls() []struct {
+//     len(mockedKeycloakInterface.UnregisterClientNodeCalls())
+func (mock *KeycloakInterfaceMock) UnregisterClientNodeCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
 	RealmName string
+	NodeHost  string
 } {
 	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
+		NodeHost  string
 	}
-	lockKeycloakInterfaceMockListIdentityProviders.RLock()
-	calls = mock.calls.ListIdentityProviders
-	lockKeycloakInterfaceMockListIdentityProviders.RUnlock()
+	lockKeycloakInterfaceMockUnregisterClientNode.RLock()
+	calls = mock.calls.UnregisterClientNode
+	lockKeycloakInterfaceMockUnregisterClientNode.RUnlock()
 	return calls
 }
 
-// ListRealms calls ListRealmsFunc.
-func (mock *KeycloakInterfaceMock) ListRealms() ([]*v1alpha1.KeycloakAPIRealm, error) {
-	if mock.ListRealmsFunc == nil {
-		panic("KeycloakInterfaceMock.ListRealmsFunc: method is nil but KeycloakInterface.ListRealms was just called")
+// UpdateAuthenticationExecutionForFlow calls UpdateAuthenticationExecutionForFlowFunc.
+func (mock *KeycloakInterfaceMock) UpdateAuthenticationExecutionForFlow(ctx context.Context, flowAlias string, realmName string, execution *v1alpha1.AuthenticationExecutionInfo) error {
+	if mock.UpdateAuthenticationExecutionForFlowFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateAuthenticationExecutionForFlowFunc: method is nil but KeycloakInterface.UpdateAuthenticationExecutionForFlow was just called")
 	}
 	callInfo := struct {
-	}{}
-	lockKeycloakInterfaceMockListRealms.Lock()
-	mock.calls.ListRealms = append(mock.calls.ListRealms, callInfo)
-	lockKeycloakInterfaceMockListRealms.Unlock()
-	return mock.ListRealmsFunc()
+		Ctx       context.Context
+		FlowAlias string
+		RealmName string
+		Execution *v1alpha1.AuthenticationExecutionInfo
+	}{
+		Ctx:       ctx,
+		FlowAlias: flowAlias,
+		RealmName: realmName,
+		Execution: execution,
+	}
+	lockKeycloakInterfaceMockUpdateAuthenticationExecutionForFlow.Lock()
+	mock.calls.UpdateAuthenticationExecutionForFlow = append(mock.calls.UpdateAuthenticationExecutionForFlow, callInfo)
+	lockKeycloakInterfaceMockUpdateAuthenticationExecutionForFlow.Unlock()
+	return mock.UpdateAuthenticationExecutionForFlowFunc(ctx, flowAlias, realmName, execution)
 }
 
-// ListRealmsCalls gets all the calls that were made to ListRealms.
+// UpdateAuthenticationExecutionForFlowCalls gets all the calls that were made to UpdateAuthenticationExecutionForFlow.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListRealmsCalls())
-func (mock *KeycloakInterfaceMock) ListRealmsCalls() []struct {
+//     len(mockedKeycloakInterface.UpdateAuthenticationExecutionForFlowCalls())
+func (mock *KeycloakInterfaceMock) UpdateAuthenticationExecutionForFlowCalls() []struct {
+	Ctx       context.Context
+	FlowAlias string
+	RealmName string
+	Execution *v1alpha1.AuthenticationExecutionInfo
 } {
 	var calls []struct {
+		Ctx       context.Context
+		FlowAlias string
+		RealmName string
+		Execution *v1alpha1.AuthenticationExecutionInfo
 	}
-	lockKeycloakInterfaceMockListRealms.RLock()
-	calls = mock.calls.ListRealms
-	lockKeycloakInterfaceMockListRealms.RUnlock()
+	lockKeycloakInterfaceMockUpdateAuthenticationExecutionForFlow.RLock()
+	calls = mock.calls.UpdateAuthenticationExecutionForFlow
+	lockKeycloakInterfaceMockUpdateAuthenticationExecutionForFlow.RUnlock()
 	return calls
 }
 
-// ListUserClientRoles calls ListUserClientRolesFunc.
-func (mock *KeycloakInterfaceMock) ListUserClientRoles(realmName string, clientID string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
-	if mock.ListUserClientRolesFunc == nil {
-		panic("KeycloakInterfaceMock.ListUserClientRolesFunc: method is nil but KeycloakInterface.ListUserClientRoles was just called")
+// UpdateAuthenticatorConfig calls UpdateAuthenticatorConfigFunc.
+func (mock *KeycloakInterfaceMock) UpdateAuthenticatorConfig(ctx context.Context, authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string) error {
+	if mock.UpdateAuthenticatorConfigFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateAuthenticatorConfigFunc: method is nil but KeycloakInterface.UpdateAuthenticatorConfig was just called")
 	}
 	callInfo := struct {
-		RealmName string
-		ClientID  string
-		UserID    string
+		Ctx                 context.Context
+		AuthenticatorConfig *v1alpha1.AuthenticatorConfig
+		RealmName           string
 	}{
-		RealmName: realmName,
-		ClientID:  clientID,
-		UserID:    userID,
+		Ctx:                 ctx,
+		AuthenticatorConfig: authenticatorConfig,
+		RealmName:           realmName,
 	}
-	lockKeycloakInterfaceMockListUserClientRoles.Lock()
-	mock.calls.ListUserClientRoles = append(mock.calls.ListUserClientRoles, callInfo)
-	lockKeycloakInterfaceMockListUserClientRoles.Unlock()
-	return mock.ListUserClientRolesFunc(realmName, clientID, userID)
+	lockKeycloakInterfaceMockUpdateAuthenticatorConfig.Lock()
+	mock.calls.UpdateAuthenticatorConfig = append(mock.calls.UpdateAuthenticatorConfig, callInfo)
+	lockKeycloakInterfaceMockUpdateAuthenticatorConfig.Unlock()
+	return mock.UpdateAuthenticatorConfigFunc(ctx, authenticatorConfig, realmName)
 }
 
-// ListUserClientRolesCalls gets all the calls that were made to ListUserClientRoles.
+// UpdateAuthenticatorConfigCalls gets all the calls that were made to UpdateAuthenticatorConfig.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListUserClientRolesCalls())
-func (mock *KeycloakInterfaceMock) ListUserClientRolesCalls() []struct {
-	RealmName string
-	ClientID  string
-	UserID    string
+//     len(mockedKeycloakInterface.UpdateAuthenticatorConfigCalls())
+func (mock *KeycloakInterfaceMock) UpdateAuthenticatorConfigCalls() []struct {
+	Ctx                 context.Context
+	AuthenticatorConfig *v1alpha1.AuthenticatorConfig
+	RealmName           string
 } {
 	var calls []struct {
-		RealmName string
-		ClientID  string
-		UserID    string
+		Ctx                 context.Context
+		AuthenticatorConfig *v1alpha1.AuthenticatorConfig
+		RealmName           string
 	}
-	lockKeycloakInterfaceMockListUserClientRoles.RLock()
-	calls = mock.calls.ListUserClientRoles
-	lockKeycloakInterfaceMockListUserClientRoles.RUnlock()
+	lockKeycloakInterfaceMockUpdateAuthenticatorConfig.RLock()
+	calls = mock.calls.UpdateAuthenticatorConfig
+	lockKeycloakInterfaceMockUpdateAuthenticatorConfig.RUnlock()
 	return calls
 }
 
-// ListUserRealmRoles calls ListUserRealmRolesFunc.
-func (mock *KeycloakInterfaceMock) ListUserRealmRoles(realmName string, userID string) ([]*v1alpha1.KeycloakUserRole, error) {
-	if mock.ListUserRealmRolesFunc == nil {
-		panic("KeycloakInterfaceMock.ListUserRealmRolesFunc: method is nil but KeycloakInterface.ListUserRealmRoles was just called")
+// UpdateAuthzPolicy calls UpdateAuthzPolicyFunc.
+func (mock *KeycloakInterfaceMock) UpdateAuthzPolicy(ctx context.Context, clientID string, realmName string, policyType string, policyID string, policy json.RawMessage) error {
+	if mock.UpdateAuthzPolicyFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateAuthzPolicyFunc: method is nil but KeycloakInterface.UpdateAuthzPolicy was just called")
 	}
 	callInfo := struct {
-		RealmName string
-		UserID    string
+		Ctx        context.Context
+		ClientID   string
+		RealmName  string
+		PolicyType string
+		PolicyID   string
+		Policy     json.RawMessage
 	}{
-		RealmName: realmName,
-		UserID:    userID,
-	}
-	lockKeycloakInterfaceMockListUserRealmRoles.Lock()
-	mock.calls.ListUserRealmRoles = append(mock.calls.ListUserRealmRoles, callInfo)
-	lockKeycloakInterfaceMockListUserRealmRoles.Unlock()
-	return mock.ListUserRealmRolesFunc(realmName, userID)
+		Ctx:        ctx,
+		ClientID:   clientID,
+		RealmName:  realmName,
+		PolicyType: policyType,
+		PolicyID:   policyID,
+		Policy:     policy,
+	}
+	lockKeycloakInterfaceMockUpdateAuthzPolicy.Lock()
+	mock.calls.UpdateAuthzPolicy = append(mock.calls.UpdateAuthzPolicy, callInfo)
+	lockKeycloakInterfaceMockUpdateAuthzPolicy.Unlock()
+	return mock.UpdateAuthzPolicyFunc(ctx, clientID, realmName, policyType, policyID, policy)
 }
 
-// ListUserRealmRolesCalls gets all the calls that were made to ListUserRealmRoles.
+// UpdateAuthzPolicyCalls gets all the calls that were made to UpdateAuthzPolicy.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListUserRealmRolesCalls())
-func (mock *KeycloakInterfaceMock) ListUserRealmRolesCalls() []struct {
-	RealmName string
-	UserID    string
+//     len(mockedKeycloakInterface.UpdateAuthzPolicyCalls())
+func (mock *KeycloakInterfaceMock) UpdateAuthzPolicyCalls() []struct {
+	Ctx        context.Context
+	ClientID   string
+	RealmName  string
+	PolicyType string
+	PolicyID   string
+	Policy     json.RawMessage
 } {
 	var calls []struct {
-		RealmName string
-		UserID    string
+		Ctx        context.Context
+		ClientID   string
+		RealmName  string
+		PolicyType string
+		PolicyID   string
+		Policy     json.RawMessage
 	}
-	lockKeycloakInterfaceMockListUserRealmRoles.RLock()
-	calls = mock.calls.ListUserRealmRoles
-	lockKeycloakInterfaceMockListUserRealmRoles.RUnlock()
+	lockKeycloakInterfaceMockUpdateAuthzPolicy.RLock()
+	calls = mock.calls.UpdateAuthzPolicy
+	lockKeycloakInterfaceMockUpdateAuthzPolicy.RUnlock()
 	return calls
 }
 
-// ListUsers calls ListUsersFunc.
-func (mock *KeycloakInterfaceMock) ListUsers(realmName string) ([]*v1alpha1.KeycloakAPIUser, error) {
-	if mock.ListUsersFunc == nil {
-		panic("KeycloakInterfaceMock.ListUsersFunc: method is nil but KeycloakInterface.ListUsers was just called")
+// UpdateAuthzResource calls UpdateAuthzResourceFunc.
+func (mock *KeycloakInterfaceMock) UpdateAuthzResource(ctx context.Context, clientID string, realmName string, resource *AuthzResource) error {
+	if mock.UpdateAuthzResourceFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateAuthzResourceFunc: method is nil but KeycloakInterface.UpdateAuthzResource was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
+		Resource  *AuthzResource
 	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
 		RealmName: realmName,
+		Resource:  resource,
 	}
-	lockKeycloakInterfaceMockListUsers.Lock()
-	mock.calls.ListUsers = append(mock.calls.ListUsers, callInfo)
-	lockKeycloakInterfaceMockListUsers.Unlock()
-	return mock.ListUsersFunc(realmName)
+	lockKeycloakInterfaceMockUpdateAuthzResource.Lock()
+	mock.calls.UpdateAuthzResource = append(mock.calls.UpdateAuthzResource, callInfo)
+	lockKeycloakInterfaceMockUpdateAuthzResource.Unlock()
+	return mock.UpdateAuthzResourceFunc(ctx, clientID, realmName, resource)
 }
 
-// ListUsersCalls gets all the calls that were made to ListUsers.
+// UpdateAuthzResourceCalls gets all the calls that were made to UpdateAuthzResource.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListUsersCalls())
-func (mock *KeycloakInterfaceMock) ListUsersCalls() []struct {
+//     len(mockedKeycloakInterface.UpdateAuthzResourceCalls())
+func (mock *KeycloakInterfaceMock) UpdateAuthzResourceCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
 	RealmName string
+	Resource  *AuthzResource
 } {
 	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
+		Resource  *AuthzResource
 	}
-	lockKeycloakInterfaceMockListUsers.RLock()
-	calls = mock.calls.ListUsers
-	lockKeycloakInterfaceMockListUsers.RUnlock()
+	lockKeycloakInterfaceMockUpdateAuthzResource.RLock()
+	calls = mock.calls.UpdateAuthzResource
+	lockKeycloakInterfaceMockUpdateAuthzResource.RUnlock()
 	return calls
 }
 
-// ListUsersInGroup calls ListUsersInGroupFunc.
-func (mock *KeycloakInterfaceMock) ListUsersInGroup(realmName string, groupID string) ([]*v1alpha1.KeycloakAPIUser, error) {
-	if mock.ListUsersInGroupFunc == nil {
-		panic("KeycloakInterfaceMock.ListUsersInGroupFunc: method is nil but KeycloakInterface.ListUsersInGroup was just called")
+// UpdateAuthzScope calls UpdateAuthzScopeFunc.
+func (mock *KeycloakInterfaceMock) UpdateAuthzScope(ctx context.Context, clientID string, realmName string, scope *AuthzScope) error {
+	if mock.UpdateAuthzScopeFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateAuthzScopeFunc: method is nil but KeycloakInterface.UpdateAuthzScope was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
-		GroupID   string
+		Scope     *AuthzScope
 	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
 		RealmName: realmName,
-		GroupID:   groupID,
+		Scope:     scope,
 	}
-	lockKeycloakInterfaceMockListUsersInGroup.Lock()
-	mock.calls.ListUsersInGroup = append(mock.calls.ListUsersInGroup, callInfo)
-	lockKeycloakInterfaceMockListUsersInGroup.Unlock()
-	return mock.ListUsersInGroupFunc(realmName, groupID)
+	lockKeycloakInterfaceMockUpdateAuthzScope.Lock()
+	mock.calls.UpdateAuthzScope = append(mock.calls.UpdateAuthzScope, callInfo)
+	lockKeycloakInterfaceMockUpdateAuthzScope.Unlock()
+	return mock.UpdateAuthzScopeFunc(ctx, clientID, realmName, scope)
 }
 
-// ListUsersInGroupCalls gets all the calls that were made to ListUsersInGroup.
+// UpdateAuthzScopeCalls gets all the calls that were made to UpdateAuthzScope.
 // Check the length with:
-//     len(mockedKeycloakInterface.ListUsersInGroupCalls())
-func (mock *KeycloakInterfaceMock) ListUsersInGroupCalls() []struct {
+//     len(mockedKeycloakInterface.UpdateAuthzScopeCalls())
+func (mock *KeycloakInterfaceMock) UpdateAuthzScopeCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
 	RealmName string
-	GroupID   string
+	Scope     *AuthzScope
 } {
 	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
-		GroupID   string
+		Scope     *AuthzScope
 	}
-	lockKeycloakInterfaceMockListUsersInGroup.RLock()
-	calls = mock.calls.ListUsersInGroup
-	lockKeycloakInterfaceMockListUsersInGroup.RUnlock()
+	lockKeycloakInterfaceMockUpdateAuthzScope.RLock()
+	calls = mock.calls.UpdateAuthzScope
+	lockKeycloakInterfaceMockUpdateAuthzScope.RUnlock()
 	return calls
 }
 
-// MakeGroupDefault calls MakeGroupDefaultFunc.
-func (mock *KeycloakInterfaceMock) MakeGroupDefault(groupID string, realmName string) error {
-	if mock.MakeGroupDefaultFunc == nil {
-		panic("KeycloakInterfaceMock.MakeGroupDefaultFunc: method is nil but KeycloakInterface.MakeGroupDefault was just called")
+// UpdateClient calls UpdateClientFunc.
+func (mock *KeycloakInterfaceMock) UpdateClient(ctx context.Context, specClient *v1alpha1.KeycloakAPIClient, realmName string) error {
+	if mock.UpdateClientFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateClientFunc: method is nil but KeycloakInterface.UpdateClient was just called")
 	}
 	callInfo := struct {
-		GroupID   string
-		RealmName string
+		Ctx        context.Context
+		SpecClient *v1alpha1.KeycloakAPIClient
+		RealmName  string
 	}{
-		GroupID:   groupID,
-		RealmName: realmName,
+		Ctx:        ctx,
+		SpecClient: specClient,
+		RealmName:  realmName,
 	}
-	lockKeycloakInterfaceMockMakeGroupDefault.Lock()
-	mock.calls.MakeGroupDefault = append(mock.calls.MakeGroupDefault, callInfo)
-	lockKeycloakInterfaceMockMakeGroupDefault.Unlock()
-	return mock.MakeGroupDefaultFunc(groupID, realmName)
+	lockKeycloakInterfaceMockUpdateClient.Lock()
+	mock.calls.UpdateClient = append(mock.calls.UpdateClient, callInfo)
+	lockKeycloakInterfaceMockUpdateClient.Unlock()
+	return mock.UpdateClientFunc(ctx, specClient, realmName)
 }
 
-// MakeGroupDefaultCalls gets all the calls that were made to MakeGroupDefault.
+// UpdateClientCalls gets all the calls that were made to UpdateClient.
 // Check the length with:
-//     len(mockedKeycloakInterface.MakeGroupDefaultCalls())
-func (mock *KeycloakInterfaceMock) MakeGroupDefaultCalls() []struct {
-	GroupID   string
-	RealmName string
+//     len(mockedKeycloakInterface.UpdateClientCalls())
+func (mock *KeycloakInterfaceMock) UpdateClientCalls() []struct {
+	Ctx        context.Context
+	SpecClient *v1alpha1.KeycloakAPIClient
+	RealmName  string
 } {
 	var calls []struct {
-		GroupID   string
-		RealmName string
+		Ctx        context.Context
+		SpecClient *v1alpha1.KeycloakAPIClient
+		RealmName  string
 	}
-	lockKeycloakInterfaceMockMakeGroupDefault.RLock()
-	calls = mock.calls.MakeGroupDefault
-	lockKeycloakInterfaceMockMakeGroupDefault.RUnlock()
+	lockKeycloakInterfaceMockUpdateClient.RLock()
+	calls = mock.calls.UpdateClient
+	lockKeycloakInterfaceMockUpdateClient.RUnlock()
 	return calls
 }
 
-// Ping calls PingFunc.
-func (mock *KeycloakInterfaceMock) Ping() error {
-	if mock.PingFunc == nil {
-		panic("KeycloakInterfaceMock.PingFunc: method is nil but KeycloakInterface.Ping was just called")
+// UpdateClientManagementPermissions calls UpdateClientManagementPermissionsFunc.
+func (mock *KeycloakInterfaceMock) UpdateClientManagementPermissions(ctx context.Context, clientID string, realmName string, enabled bool) (*ManagementPermissionReference, error) {
+	if mock.UpdateClientManagementPermissionsFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateClientManagementPermissionsFunc: method is nil but KeycloakInterface.UpdateClientManagementPermissions was just called")
 	}
 	callInfo := struct {
-	}{}
-	lockKeycloakInterfaceMockPing.Lock()
-	mock.calls.Ping = append(mock.calls.Ping, callInfo)
-	lockKeycloakInterfaceMockPing.Unlock()
-	return mock.PingFunc()
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Enabled   bool
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Enabled:   enabled,
+	}
+	lockKeycloakInterfaceMockUpdateClientManagementPermissions.Lock()
+	mock.calls.UpdateClientManagementPermissions = append(mock.calls.UpdateClientManagementPermissions, callInfo)
+	lockKeycloakInterfaceMockUpdateClientManagementPermissions.Unlock()
+	return mock.UpdateClientManagementPermissionsFunc(ctx, clientID, realmName, enabled)
 }
 
-// PingCalls gets all the calls that were made to Ping.
+// UpdateClientManagementPermissionsCalls gets all the calls that were made to UpdateClientManagementPermissions.
 // Check the length with:
-//     len(mockedKeycloakInterface.PingCalls())
-func (mock *KeycloakInterfaceMock) PingCalls() []struct {
+//     len(mockedKeycloakInterface.UpdateClientManagementPermissionsCalls())
+func (mock *KeycloakInterfaceMock) UpdateClientManagementPermissionsCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Enabled   bool
 } {
 	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Enabled   bool
 	}
-	lockKeycloakInterfaceMockPing.RLock()
-	calls = mock.calls.Ping
-	lockKeycloakInterfaceMockPing.RUnlock()
+	lockKeycloakInterfaceMockUpdateClientManagementPermissions.RLock()
+	calls = mock.calls.UpdateClientManagementPermissions
+	lockKeycloakInterfaceMockUpdateClientManagementPermissions.RUnlock()
 	return calls
 }
 
-// RemoveFederatedIdentity calls RemoveFederatedIdentityFunc.
-func (mock *KeycloakInterfaceMock) RemoveFederatedIdentity(fid v1alpha1.FederatedIdentity, userID string, realmName string) error {
-	if mock.RemoveFederatedIdentityFunc == nil {
-		panic("KeycloakInterfaceMock.RemoveFederatedIdentityFunc: method is nil but KeycloakInterface.RemoveFederatedIdentity was just called")
+// UpdateClientRole calls UpdateClientRoleFunc.
+func (mock *KeycloakInterfaceMock) UpdateClientRole(ctx context.Context, clientID string, realmName string, role *v1alpha1.KeycloakUserRole) error {
+	if mock.UpdateClientRoleFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateClientRoleFunc: method is nil but KeycloakInterface.UpdateClientRole was just called")
 	}
 	callInfo := struct {
-		Fid       v1alpha1.FederatedIdentity
-		UserID    string
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
+		Role      *v1alpha1.KeycloakUserRole
 	}{
-		Fid:       fid,
-		UserID:    userID,
+		Ctx:       ctx,
+		ClientID:  clientID,
 		RealmName: realmName,
+		Role:      role,
 	}
-	lockKeycloakInterfaceMockRemoveFederatedIdentity.Lock()
-	mock.calls.RemoveFederatedIdentity = append(mock.calls.RemoveFederatedIdentity, callInfo)
-	lockKeycloakInterfaceMockRemoveFederatedIdentity.Unlock()
-	return mock.RemoveFederatedIdentityFunc(fid, userID, realmName)
+	lockKeycloakInterfaceMockUpdateClientRole.Lock()
+	mock.calls.UpdateClientRole = append(mock.calls.UpdateClientRole, callInfo)
+	lockKeycloakInterfaceMockUpdateClientRole.Unlock()
+	return mock.UpdateClientRoleFunc(ctx, clientID, realmName, role)
 }
 
-// RemoveFederatedIdentityCalls gets all the calls that were made to RemoveFederatedIdentity.
+// UpdateClientRoleCalls gets all the calls that were made to UpdateClientRole.
 // Check the length with:
-//     len(mockedKeycloakInterface.RemoveFederatedIdentityCalls())
-func (mock *KeycloakInterfaceMock) RemoveFederatedIdentityCalls() []struct {
-	Fid       v1alpha1.FederatedIdentity
-	UserID    string
+//     len(mockedKeycloakInterface.UpdateClientRoleCalls())
+func (mock *KeycloakInterfaceMock) UpdateClientRoleCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
 	RealmName string
+	Role      *v1alpha1.KeycloakUserRole
 } {
 	var calls []struct {
-		Fid       v1alpha1.FederatedIdentity
-		UserID    string
+		Ctx       context.Context
+		ClientID  string
 		RealmName string
+		Role      *v1alpha1.KeycloakUserRole
 	}
-	lockKeycloakInterfaceMockRemoveFederatedIdentity.RLock()
-	calls = mock.calls.RemoveFederatedIdentity
-	lockKeycloakInterfaceMockRemoveFederatedIdentity.RUnlock()
+	lockKeycloakInterfaceMockUpdateClientRole.RLock()
+	calls = mock.calls.UpdateClientRole
+	lockKeycloakInterfaceMockUpdateClientRole.RUnlock()
 	return calls
 }
 
-// SetGroupChild calls SetGroupChildFunc.
-func (mock *KeycloakInterfaceMock) SetGroupChild(groupID string, realmName string, childGroup *Group) error {
-	if mock.SetGroupChildFunc == nil {
-		panic("KeycloakInterfaceMock.SetGroupChildFunc: method is nil but KeycloakInterface.SetGroupChild was just called")
+// UpdateClientScope calls UpdateClientScopeFunc.
+func (mock *KeycloakInterfaceMock) UpdateClientScope(ctx context.Context, scope *ClientScope, realmName string) error {
+	if mock.UpdateClientScopeFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateClientScopeFunc: method is nil but KeycloakInterface.UpdateClientScope was just called")
 	}
 	callInfo := struct {
-		GroupID    string
-		RealmName  string
-		ChildGroup *Group
+		Ctx       context.Context
+		Scope     *ClientScope
+		RealmName string
 	}{
-		GroupID:    groupID,
-		RealmName:  realmName,
-		ChildGroup: childGroup,
+		Ctx:       ctx,
+		Scope:     scope,
+		RealmName: realmName,
 	}
-	lockKeycloakInterfaceMockSetGroupChild.Lock()
-	mock.calls.SetGroupChild = append(mock.calls.SetGroupChild, callInfo)
-	lockKeycloakInterfaceMockSetGroupChild.Unlock()
-	return mock.SetGroupChildFunc(groupID, realmName, childGroup)
+	lockKeycloakInterfaceMockUpdateClientScope.Lock()
+	mock.calls.UpdateClientScope = append(mock.calls.UpdateClientScope, callInfo)
+	lockKeycloakInterfaceMockUpdateClientScope.Unlock()
+	return mock.UpdateClientScopeFunc(ctx, scope, realmName)
 }
 
-// SetGroupChildCalls gets all the calls that were made to SetGroupChild.
+// UpdateClientScopeCalls gets all the calls that were made to UpdateClientScope.
 // Check the length with:
-//     len(mockedKeycloakInterface.SetGroupChildCalls())
-func (mock *KeycloakInterfaceMock) SetGroupChildCalls() []struct {
-	GroupID    string
-	RealmName  string
-	ChildGroup *Group
+//     len(mockedKeycloakInterface.UpdateClientScopeCalls())
+func (mock *KeycloakInterfaceMock) UpdateClientScopeCalls() []struct {
+	Ctx       context.Context
+	Scope     *ClientScope
+	RealmName string
 } {
 	var calls []struct {
-		GroupID    string
-		RealmName  string
-		ChildGroup *Group
+		Ctx       context.Context
+		Scope     *ClientScope
+		RealmName string
 	}
-	lockKeycloakInterfaceMockSetGroupChild.RLock()
-	calls = mock.calls.SetGroupChild
-	lockKeycloakInterfaceMockSetGroupChild.RUnlock()
+	lockKeycloakInterfaceMockUpdateClientScope.RLock()
+	calls = mock.calls.UpdateClientScope
+	lockKeycloakInterfaceMockUpdateClientScope.RUnlock()
 	return calls
 }
 
-// UpdateAuthenticationExecutionForFlow calls UpdateAuthenticationExecutionForFlowFunc.
-func (mock *KeycloakInterfaceMock) UpdateAuthenticationExecutionForFlow(flowAlias string, realmName string, execution *v1alpha1.AuthenticationExecutionInfo) error {
-	if mock.UpdateAuthenticationExecutionForFlowFunc == nil {
-		panic("KeycloakInterfaceMock.UpdateAuthenticationExecutionForFlowFunc: method is nil but KeycloakInterface.UpdateAuthenticationExecutionForFlow was just called")
+// UpdateComponent calls UpdateComponentFunc.
+func (mock *KeycloakInterfaceMock) UpdateComponent(ctx context.Context, component *Component, realmName string) error {
+	if mock.UpdateComponentFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateComponentFunc: method is nil but KeycloakInterface.UpdateComponent was just called")
 	}
 	callInfo := struct {
-		FlowAlias string
+		Ctx       context.Context
+		Component *Component
 		RealmName string
-		Execution *v1alpha1.AuthenticationExecutionInfo
 	}{
-		FlowAlias: flowAlias,
+		Ctx:       ctx,
+		Component: component,
 		RealmName: realmName,
-		Execution: execution,
 	}
-	lockKeycloakInterfaceMockUpdateAuthenticationExecutionForFlow.Lock()
-	mock.calls.UpdateAuthenticationExecutionForFlow = append(mock.calls.UpdateAuthenticationExecutionForFlow, callInfo)
-	lockKeycloakInterfaceMockUpdateAuthenticationExecutionForFlow.Unlock()
-	return mock.UpdateAuthenticationExecutionForFlowFunc(flowAlias, realmName, execution)
+	lockKeycloakInterfaceMockUpdateComponent.Lock()
+	mock.calls.UpdateComponent = append(mock.calls.UpdateComponent, callInfo)
+	lockKeycloakInterfaceMockUpdateComponent.Unlock()
+	return mock.UpdateComponentFunc(ctx, component, realmName)
 }
 
-// UpdateAuthenticationExecutionForFlowCalls gets all the calls that were made to UpdateAuthenticationExecutionForFlow.
+// UpdateComponentCalls gets all the calls that were made to UpdateComponent.
 // Check the length with:
-//     len(mockedKeycloakInterface.UpdateAuthenticationExecutionForFlowCalls())
-func (mock *KeycloakInterfaceMock) UpdateAuthenticationExecutionForFlowCalls() []struct {
-	FlowAlias string
+//     len(mockedKeycloakInterface.UpdateComponentCalls())
+func (mock *KeycloakInterfaceMock) UpdateComponentCalls() []struct {
+	Ctx       context.Context
+	Component *Component
 	RealmName string
-	Execution *v1alpha1.AuthenticationExecutionInfo
 } {
 	var calls []struct {
-		FlowAlias string
+		Ctx       context.Context
+		Component *Component
 		RealmName string
-		Execution *v1alpha1.AuthenticationExecutionInfo
 	}
-	lockKeycloakInterfaceMockUpdateAuthenticationExecutionForFlow.RLock()
-	calls = mock.calls.UpdateAuthenticationExecutionForFlow
-	lockKeycloakInterfaceMockUpdateAuthenticationExecutionForFlow.RUnlock()
+	lockKeycloakInterfaceMockUpdateComponent.RLock()
+	calls = mock.calls.UpdateComponent
+	lockKeycloakInterfaceMockUpdateComponent.RUnlock()
 	return calls
 }
 
-// UpdateAuthenticatorConfig calls UpdateAuthenticatorConfigFunc.
-func (mock *KeycloakInterfaceMock) UpdateAuthenticatorConfig(authenticatorConfig *v1alpha1.AuthenticatorConfig, realmName string) error {
-	if mock.UpdateAuthenticatorConfigFunc == nil {
-		panic("KeycloakInterfaceMock.UpdateAuthenticatorConfigFunc: method is nil but KeycloakInterface.UpdateAuthenticatorConfig was just called")
+// UpdateCredentialLabel calls UpdateCredentialLabelFunc.
+func (mock *KeycloakInterfaceMock) UpdateCredentialLabel(ctx context.Context, userID string, credentialID string, label string, realmName string) error {
+	if mock.UpdateCredentialLabelFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateCredentialLabelFunc: method is nil but KeycloakInterface.UpdateCredentialLabel was just called")
 	}
 	callInfo := struct {
-		AuthenticatorConfig *v1alpha1.AuthenticatorConfig
-		RealmName           string
+		Ctx          context.Context
+		UserID       string
+		CredentialID string
+		Label        string
+		RealmName    string
 	}{
-		AuthenticatorConfig: authenticatorConfig,
-		RealmName:           realmName,
-	}
-	lockKeycloakInterfaceMockUpdateAuthenticatorConfig.Lock()
-	mock.calls.UpdateAuthenticatorConfig = append(mock.calls.UpdateAuthenticatorConfig, callInfo)
-	lockKeycloakInterfaceMockUpdateAuthenticatorConfig.Unlock()
-	return mock.UpdateAuthenticatorConfigFunc(authenticatorConfig, realmName)
+		Ctx:          ctx,
+		UserID:       userID,
+		CredentialID: credentialID,
+		Label:        label,
+		RealmName:    realmName,
+	}
+	lockKeycloakInterfaceMockUpdateCredentialLabel.Lock()
+	mock.calls.UpdateCredentialLabel = append(mock.calls.UpdateCredentialLabel, callInfo)
+	lockKeycloakInterfaceMockUpdateCredentialLabel.Unlock()
+	return mock.UpdateCredentialLabelFunc(ctx, userID, credentialID, label, realmName)
 }
 
-// UpdateAuthenticatorConfigCalls gets all the calls that were made to UpdateAuthenticatorConfig.
+// UpdateCredentialLabelCalls gets all the calls that were made to UpdateCredentialLabel.
 // Check the length with:
-//     len(mockedKeycloakInterface.UpdateAuthenticatorConfigCalls())
-func (mock *KeycloakInterfaceMock) UpdateAuthenticatorConfigCalls() []struct {
-	AuthenticatorConfig *v1alpha1.AuthenticatorConfig
-	RealmName           string
+//     len(mockedKeycloakInterface.UpdateCredentialLabelCalls())
+func (mock *KeycloakInterfaceMock) UpdateCredentialLabelCalls() []struct {
+	Ctx          context.Context
+	UserID       string
+	CredentialID string
+	Label        string
+	RealmName    string
 } {
 	var calls []struct {
-		AuthenticatorConfig *v1alpha1.AuthenticatorConfig
-		RealmName           string
-	}
-	lockKeycloakInterfaceMockUpdateAuthenticatorConfig.RLock()
-	calls = mock.calls.UpdateAuthenticatorConfig
-	lockKeycloakInterfaceMockUpdateAuthenticatorConfig.RUnlock()
+		Ctx          context.Context
+		UserID       string
+		CredentialID string
+		Label        string
+		RealmName    string
+	}
+	lockKeycloakInterfaceMockUpdateCredentialLabel.RLock()
+	calls = mock.calls.UpdateCredentialLabel
+	lockKeycloakInterfaceMockUpdateCredentialLabel.RUnlock()
 	return calls
 }
 
-// UpdateClient calls UpdateClientFunc.
-func (mock *KeycloakInterfaceMock) UpdateClient(specClient *v1alpha1.KeycloakAPIClient, realmName string) error {
-	if mock.UpdateClientFunc == nil {
-		panic("KeycloakInterfaceMock.UpdateClientFunc: method is nil but KeycloakInterface.UpdateClient was just called")
+// UpdateGroupManagementPermissions calls UpdateGroupManagementPermissionsFunc.
+func (mock *KeycloakInterfaceMock) UpdateGroupManagementPermissions(ctx context.Context, groupID string, realmName string, enabled bool) (*ManagementPermissionReference, error) {
+	if mock.UpdateGroupManagementPermissionsFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateGroupManagementPermissionsFunc: method is nil but KeycloakInterface.UpdateGroupManagementPermissions was just called")
 	}
 	callInfo := struct {
-		SpecClient *v1alpha1.KeycloakAPIClient
-		RealmName  string
+		Ctx       context.Context
+		GroupID   string
+		RealmName string
+		Enabled   bool
 	}{
-		SpecClient: specClient,
-		RealmName:  realmName,
+		Ctx:       ctx,
+		GroupID:   groupID,
+		RealmName: realmName,
+		Enabled:   enabled,
 	}
-	lockKeycloakInterfaceMockUpdateClient.Lock()
-	mock.calls.UpdateClient = append(mock.calls.UpdateClient, callInfo)
-	lockKeycloakInterfaceMockUpdateClient.Unlock()
-	return mock.UpdateClientFunc(specClient, realmName)
+	lockKeycloakInterfaceMockUpdateGroupManagementPermissions.Lock()
+	mock.calls.UpdateGroupManagementPermissions = append(mock.calls.UpdateGroupManagementPermissions, callInfo)
+	lockKeycloakInterfaceMockUpdateGroupManagementPermissions.Unlock()
+	return mock.UpdateGroupManagementPermissionsFunc(ctx, groupID, realmName, enabled)
 }
 
-// UpdateClientCalls gets all the calls that were made to UpdateClient.
+// UpdateGroupManagementPermissionsCalls gets all the calls that were made to UpdateGroupManagementPermissions.
 // Check the length with:
-//     len(mockedKeycloakInterface.UpdateClientCalls())
-func (mock *KeycloakInterfaceMock) UpdateClientCalls() []struct {
-	SpecClient *v1alpha1.KeycloakAPIClient
-	RealmName  string
+//     len(mockedKeycloakInterface.UpdateGroupManagementPermissionsCalls())
+func (mock *KeycloakInterfaceMock) UpdateGroupManagementPermissionsCalls() []struct {
+	Ctx       context.Context
+	GroupID   string
+	RealmName string
+	Enabled   bool
 } {
 	var calls []struct {
-		SpecClient *v1alpha1.KeycloakAPIClient
-		RealmName  string
+		Ctx       context.Context
+		GroupID   string
+		RealmName string
+		Enabled   bool
 	}
-	lockKeycloakInterfaceMockUpdateClient.RLock()
-	calls = mock.calls.UpdateClient
-	lockKeycloakInterfaceMockUpdateClient.RUnlock()
+	lockKeycloakInterfaceMockUpdateGroupManagementPermissions.RLock()
+	calls = mock.calls.UpdateGroupManagementPermissions
+	lockKeycloakInterfaceMockUpdateGroupManagementPermissions.RUnlock()
 	return calls
 }
 
 // UpdateIdentityProvider calls UpdateIdentityProviderFunc.
-func (mock *KeycloakInterfaceMock) UpdateIdentityProvider(specIdentityProvider *v1alpha1.KeycloakIdentityProvider, realmName string) error {
+func (mock *KeycloakInterfaceMock) UpdateIdentityProvider(ctx context.Context, specIdentityProvider *IdentityProvider, realmName string) error {
 	if mock.UpdateIdentityProviderFunc == nil {
 		panic("KeycloakInterfaceMock.UpdateIdentityProviderFunc: method is nil but KeycloakInterface.UpdateIdentityProvider was just called")
 	}
 	callInfo := struct {
-		SpecIdentityProvider *v1alpha1.KeycloakIdentityProvider
+		Ctx                  context.Context
+		SpecIdentityProvider *IdentityProvider
 		RealmName            string
 	}{
+		Ctx:                  ctx,
 		SpecIdentityProvider: specIdentityProvider,
 		RealmName:            realmName,
 	}
 	lockKeycloakInterfaceMockUpdateIdentityProvider.Lock()
 	mock.calls.UpdateIdentityProvider = append(mock.calls.UpdateIdentityProvider, callInfo)
 	lockKeycloakInterfaceMockUpdateIdentityProvider.Unlock()
-	return mock.UpdateIdentityProviderFunc(specIdentityProvider, realmName)
+	return mock.UpdateIdentityProviderFunc(ctx, specIdentityProvider, realmName)
 }
 
 // UpdateIdentityProviderCalls gets all the calls that were made to UpdateIdentityProvider.
 // Check the length with:
 //     len(mockedKeycloakInterface.UpdateIdentityProviderCalls())
 func (mock *KeycloakInterfaceMock) UpdateIdentityProviderCalls() []struct {
-	SpecIdentityProvider *v1alpha1.KeycloakIdentityProvider
+	Ctx                  context.Context
+	SpecIdentityProvider *IdentityProvider
 	RealmName            string
 } {
 	var calls []struct {
-		SpecIdentityProvider *v1alpha1.KeycloakIdentityProvider
+		Ctx                  context.Context
+		SpecIdentityProvider *IdentityProvider
 		RealmName            string
 	}
 	lockKeycloakInterfaceMockUpdateIdentityProvider.RLock()
@@ -2950,16 +11804,61 @@ func (mock *KeycloakInterfaceMock) UpdateIdentityProviderCalls() []struct {
 	return calls
 }
 
+// UpdateIdentityProviderMapper calls UpdateIdentityProviderMapperFunc.
+func (mock *KeycloakInterfaceMock) UpdateIdentityProviderMapper(ctx context.Context, alias string, realmName string, mapper *IdentityProviderMapper) error {
+	if mock.UpdateIdentityProviderMapperFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateIdentityProviderMapperFunc: method is nil but KeycloakInterface.UpdateIdentityProviderMapper was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Alias     string
+		RealmName string
+		Mapper    *IdentityProviderMapper
+	}{
+		Ctx:       ctx,
+		Alias:     alias,
+		RealmName: realmName,
+		Mapper:    mapper,
+	}
+	lockKeycloakInterfaceMockUpdateIdentityProviderMapper.Lock()
+	mock.calls.UpdateIdentityProviderMapper = append(mock.calls.UpdateIdentityProviderMapper, callInfo)
+	lockKeycloakInterfaceMockUpdateIdentityProviderMapper.Unlock()
+	return mock.UpdateIdentityProviderMapperFunc(ctx, alias, realmName, mapper)
+}
+
+// UpdateIdentityProviderMapperCalls gets all the calls that were made to UpdateIdentityProviderMapper.
+// Check the length with:
+//     len(mockedKeycloakInterface.UpdateIdentityProviderMapperCalls())
+func (mock *KeycloakInterfaceMock) UpdateIdentityProviderMapperCalls() []struct {
+	Ctx       context.Context
+	Alias     string
+	RealmName string
+	Mapper    *IdentityProviderMapper
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Alias     string
+		RealmName string
+		Mapper    *IdentityProviderMapper
+	}
+	lockKeycloakInterfaceMockUpdateIdentityProviderMapper.RLock()
+	calls = mock.calls.UpdateIdentityProviderMapper
+	lockKeycloakInterfaceMockUpdateIdentityProviderMapper.RUnlock()
+	return calls
+}
+
 // UpdatePassword calls UpdatePasswordFunc.
-func (mock *KeycloakInterfaceMock) UpdatePassword(user *v1alpha1.KeycloakAPIUser, realmName string, newPass string) error {
+func (mock *KeycloakInterfaceMock) UpdatePassword(ctx context.Context, user *v1alpha1.KeycloakAPIUser, realmName string, newPass string) error {
 	if mock.UpdatePasswordFunc == nil {
 		panic("KeycloakInterfaceMock.UpdatePasswordFunc: method is nil but KeycloakInterface.UpdatePassword was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
 		User      *v1alpha1.KeycloakAPIUser
 		RealmName string
 		NewPass   string
 	}{
+		Ctx:       ctx,
 		User:      user,
 		RealmName: realmName,
 		NewPass:   newPass,
@@ -2967,18 +11866,20 @@ func (mock *KeycloakInterfaceMock) UpdatePassword(user *v1alpha1.KeycloakAPIUser
 	lockKeycloakInterfaceMockUpdatePassword.Lock()
 	mock.calls.UpdatePassword = append(mock.calls.UpdatePassword, callInfo)
 	lockKeycloakInterfaceMockUpdatePassword.Unlock()
-	return mock.UpdatePasswordFunc(user, realmName, newPass)
+	return mock.UpdatePasswordFunc(ctx, user, realmName, newPass)
 }
 
 // UpdatePasswordCalls gets all the calls that were made to UpdatePassword.
 // Check the length with:
 //     len(mockedKeycloakInterface.UpdatePasswordCalls())
 func (mock *KeycloakInterfaceMock) UpdatePasswordCalls() []struct {
+	Ctx       context.Context
 	User      *v1alpha1.KeycloakAPIUser
 	RealmName string
 	NewPass   string
 } {
 	var calls []struct {
+		Ctx       context.Context
 		User      *v1alpha1.KeycloakAPIUser
 		RealmName string
 		NewPass   string
@@ -2989,29 +11890,119 @@ func (mock *KeycloakInterfaceMock) UpdatePasswordCalls() []struct {
 	return calls
 }
 
+// UpdateProtocolMapperForClient calls UpdateProtocolMapperForClientFunc.
+func (mock *KeycloakInterfaceMock) UpdateProtocolMapperForClient(ctx context.Context, clientID string, realmName string, mapper *ProtocolMapper) error {
+	if mock.UpdateProtocolMapperForClientFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateProtocolMapperForClientFunc: method is nil but KeycloakInterface.UpdateProtocolMapperForClient was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Mapper    *ProtocolMapper
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Mapper:    mapper,
+	}
+	lockKeycloakInterfaceMockUpdateProtocolMapperForClient.Lock()
+	mock.calls.UpdateProtocolMapperForClient = append(mock.calls.UpdateProtocolMapperForClient, callInfo)
+	lockKeycloakInterfaceMockUpdateProtocolMapperForClient.Unlock()
+	return mock.UpdateProtocolMapperForClientFunc(ctx, clientID, realmName, mapper)
+}
+
+// UpdateProtocolMapperForClientCalls gets all the calls that were made to UpdateProtocolMapperForClient.
+// Check the length with:
+//     len(mockedKeycloakInterface.UpdateProtocolMapperForClientCalls())
+func (mock *KeycloakInterfaceMock) UpdateProtocolMapperForClientCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Mapper    *ProtocolMapper
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Mapper    *ProtocolMapper
+	}
+	lockKeycloakInterfaceMockUpdateProtocolMapperForClient.RLock()
+	calls = mock.calls.UpdateProtocolMapperForClient
+	lockKeycloakInterfaceMockUpdateProtocolMapperForClient.RUnlock()
+	return calls
+}
+
+// UpdateProtocolMapperForClientScope calls UpdateProtocolMapperForClientScopeFunc.
+func (mock *KeycloakInterfaceMock) UpdateProtocolMapperForClientScope(ctx context.Context, scopeID string, realmName string, mapper *ProtocolMapper) error {
+	if mock.UpdateProtocolMapperForClientScopeFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateProtocolMapperForClientScopeFunc: method is nil but KeycloakInterface.UpdateProtocolMapperForClientScope was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+		Mapper    *ProtocolMapper
+	}{
+		Ctx:       ctx,
+		ScopeID:   scopeID,
+		RealmName: realmName,
+		Mapper:    mapper,
+	}
+	lockKeycloakInterfaceMockUpdateProtocolMapperForClientScope.Lock()
+	mock.calls.UpdateProtocolMapperForClientScope = append(mock.calls.UpdateProtocolMapperForClientScope, callInfo)
+	lockKeycloakInterfaceMockUpdateProtocolMapperForClientScope.Unlock()
+	return mock.UpdateProtocolMapperForClientScopeFunc(ctx, scopeID, realmName, mapper)
+}
+
+// UpdateProtocolMapperForClientScopeCalls gets all the calls that were made to UpdateProtocolMapperForClientScope.
+// Check the length with:
+//     len(mockedKeycloakInterface.UpdateProtocolMapperForClientScopeCalls())
+func (mock *KeycloakInterfaceMock) UpdateProtocolMapperForClientScopeCalls() []struct {
+	Ctx       context.Context
+	ScopeID   string
+	RealmName string
+	Mapper    *ProtocolMapper
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ScopeID   string
+		RealmName string
+		Mapper    *ProtocolMapper
+	}
+	lockKeycloakInterfaceMockUpdateProtocolMapperForClientScope.RLock()
+	calls = mock.calls.UpdateProtocolMapperForClientScope
+	lockKeycloakInterfaceMockUpdateProtocolMapperForClientScope.RUnlock()
+	return calls
+}
+
 // UpdateRealm calls UpdateRealmFunc.
-func (mock *KeycloakInterfaceMock) UpdateRealm(specRealm *v1alpha1.KeycloakRealm) error {
+func (mock *KeycloakInterfaceMock) UpdateRealm(ctx context.Context, specRealm *v1alpha1.KeycloakRealm) error {
 	if mock.UpdateRealmFunc == nil {
 		panic("KeycloakInterfaceMock.UpdateRealmFunc: method is nil but KeycloakInterface.UpdateRealm was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
 		SpecRealm *v1alpha1.KeycloakRealm
 	}{
+		Ctx:       ctx,
 		SpecRealm: specRealm,
 	}
 	lockKeycloakInterfaceMockUpdateRealm.Lock()
 	mock.calls.UpdateRealm = append(mock.calls.UpdateRealm, callInfo)
 	lockKeycloakInterfaceMockUpdateRealm.Unlock()
-	return mock.UpdateRealmFunc(specRealm)
+	return mock.UpdateRealmFunc(ctx, specRealm)
 }
 
 // UpdateRealmCalls gets all the calls that were made to UpdateRealm.
 // Check the length with:
 //     len(mockedKeycloakInterface.UpdateRealmCalls())
 func (mock *KeycloakInterfaceMock) UpdateRealmCalls() []struct {
+	Ctx       context.Context
 	SpecRealm *v1alpha1.KeycloakRealm
 } {
 	var calls []struct {
+		Ctx       context.Context
 		SpecRealm *v1alpha1.KeycloakRealm
 	}
 	lockKeycloakInterfaceMockUpdateRealm.RLock()
@@ -3020,32 +12011,118 @@ func (mock *KeycloakInterfaceMock) UpdateRealmCalls() []struct {
 	return calls
 }
 
+// UpdateRealmEventsConfig calls UpdateRealmEventsConfigFunc.
+func (mock *KeycloakInterfaceMock) UpdateRealmEventsConfig(ctx context.Context, realmName string, config *RealmEventsConfig) error {
+	if mock.UpdateRealmEventsConfigFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateRealmEventsConfigFunc: method is nil but KeycloakInterface.UpdateRealmEventsConfig was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		RealmName string
+		Config    *RealmEventsConfig
+	}{
+		Ctx:       ctx,
+		RealmName: realmName,
+		Config:    config,
+	}
+	lockKeycloakInterfaceMockUpdateRealmEventsConfig.Lock()
+	mock.calls.UpdateRealmEventsConfig = append(mock.calls.UpdateRealmEventsConfig, callInfo)
+	lockKeycloakInterfaceMockUpdateRealmEventsConfig.Unlock()
+	return mock.UpdateRealmEventsConfigFunc(ctx, realmName, config)
+}
+
+// UpdateRealmEventsConfigCalls gets all the calls that were made to UpdateRealmEventsConfig.
+// Check the length with:
+//     len(mockedKeycloakInterface.UpdateRealmEventsConfigCalls())
+func (mock *KeycloakInterfaceMock) UpdateRealmEventsConfigCalls() []struct {
+	Ctx       context.Context
+	RealmName string
+	Config    *RealmEventsConfig
+} {
+	var calls []struct {
+		Ctx       context.Context
+		RealmName string
+		Config    *RealmEventsConfig
+	}
+	lockKeycloakInterfaceMockUpdateRealmEventsConfig.RLock()
+	calls = mock.calls.UpdateRealmEventsConfig
+	lockKeycloakInterfaceMockUpdateRealmEventsConfig.RUnlock()
+	return calls
+}
+
+// UpdateRequiredAction calls UpdateRequiredActionFunc.
+func (mock *KeycloakInterfaceMock) UpdateRequiredAction(ctx context.Context, alias string, realmName string, action *RequiredAction) error {
+	if mock.UpdateRequiredActionFunc == nil {
+		panic("KeycloakInterfaceMock.UpdateRequiredActionFunc: method is nil but KeycloakInterface.UpdateRequiredAction was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Alias     string
+		RealmName string
+		Action    *RequiredAction
+	}{
+		Ctx:       ctx,
+		Alias:     alias,
+		RealmName: realmName,
+		Action:    action,
+	}
+	lockKeycloakInterfaceMockUpdateRequiredAction.Lock()
+	mock.calls.UpdateRequiredAction = append(mock.calls.UpdateRequiredAction, callInfo)
+	lockKeycloakInterfaceMockUpdateRequiredAction.Unlock()
+	return mock.UpdateRequiredActionFunc(ctx, alias, realmName, action)
+}
+
+// UpdateRequiredActionCalls gets all the calls that were made to UpdateRequiredAction.
+// Check the length with:
+//     len(mockedKeycloakInterface.UpdateRequiredActionCalls())
+func (mock *KeycloakInterfaceMock) UpdateRequiredActionCalls() []struct {
+	Ctx       context.Context
+	Alias     string
+	RealmName string
+	Action    *RequiredAction
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Alias     string
+		RealmName string
+		Action    *RequiredAction
+	}
+	lockKeycloakInterfaceMockUpdateRequiredAction.RLock()
+	calls = mock.calls.UpdateRequiredAction
+	lockKeycloakInterfaceMockUpdateRequiredAction.RUnlock()
+	return calls
+}
+
 // UpdateUser calls UpdateUserFunc.
-func (mock *KeycloakInterfaceMock) UpdateUser(specUser *v1alpha1.KeycloakAPIUser, realmName string) error {
+func (mock *KeycloakInterfaceMock) UpdateUser(ctx context.Context, specUser *v1alpha1.KeycloakAPIUser, realmName string) error {
 	if mock.UpdateUserFunc == nil {
 		panic("KeycloakInterfaceMock.UpdateUserFunc: method is nil but KeycloakInterface.UpdateUser was just called")
 	}
 	callInfo := struct {
+		Ctx       context.Context
 		SpecUser  *v1alpha1.KeycloakAPIUser
 		RealmName string
 	}{
+		Ctx:       ctx,
 		SpecUser:  specUser,
 		RealmName: realmName,
 	}
 	lockKeycloakInterfaceMockUpdateUser.Lock()
 	mock.calls.UpdateUser = append(mock.calls.UpdateUser, callInfo)
 	lockKeycloakInterfaceMockUpdateUser.Unlock()
-	return mock.UpdateUserFunc(specUser, realmName)
+	return mock.UpdateUserFunc(ctx, specUser, realmName)
 }
 
 // UpdateUserCalls gets all the calls that were made to UpdateUser.
 // Check the length with:
 //     len(mockedKeycloakInterface.UpdateUserCalls())
 func (mock *KeycloakInterfaceMock) UpdateUserCalls() []struct {
+	Ctx       context.Context
 	SpecUser  *v1alpha1.KeycloakAPIUser
 	RealmName string
 } {
 	var calls []struct {
+		Ctx       context.Context
 		SpecUser  *v1alpha1.KeycloakAPIUser
 		RealmName string
 	}
@@ -3054,3 +12131,54 @@ func (mock *KeycloakInterfaceMock) UpdateUserCalls() []struct {
 	lockKeycloakInterfaceMockUpdateUser.RUnlock()
 	return calls
 }
+
+// UploadClientCertificate calls UploadClientCertificateFunc.
+func (mock *KeycloakInterfaceMock) UploadClientCertificate(ctx context.Context, clientID string, realmName string, attr string, config *KeyStoreConfig, data []byte) (*Certificate, error) {
+	if mock.UploadClientCertificateFunc == nil {
+		panic("KeycloakInterfaceMock.UploadClientCertificateFunc: method is nil but KeycloakInterface.UploadClientCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Attr      string
+		Config    *KeyStoreConfig
+		Data      []byte
+	}{
+		Ctx:       ctx,
+		ClientID:  clientID,
+		RealmName: realmName,
+		Attr:      attr,
+		Config:    config,
+		Data:      data,
+	}
+	lockKeycloakInterfaceMockUploadClientCertificate.Lock()
+	mock.calls.UploadClientCertificate = append(mock.calls.UploadClientCertificate, callInfo)
+	lockKeycloakInterfaceMockUploadClientCertificate.Unlock()
+	return mock.UploadClientCertificateFunc(ctx, clientID, realmName, attr, config, data)
+}
+
+// UploadClientCertificateCalls gets all the calls that were made to UploadClientCertificate.
+// Check the length with:
+//     len(mockedKeycloakInterface.UploadClientCertificateCalls())
+func (mock *KeycloakInterfaceMock) UploadClientCertificateCalls() []struct {
+	Ctx       context.Context
+	ClientID  string
+	RealmName string
+	Attr      string
+	Config    *KeyStoreConfig
+	Data      []byte
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ClientID  string
+		RealmName string
+		Attr      string
+		Config    *KeyStoreConfig
+		Data      []byte
+	}
+	lockKeycloakInterfaceMockUploadClientCertificate.RLock()
+	calls = mock.calls.UploadClientCertificate
+	lockKeycloakInterfaceMockUploadClientCertificate.RUnlock()
+	return calls
+}
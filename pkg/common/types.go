@@ -1,9 +1,520 @@
 package common
 
+import (
+	"encoding/json"
+
+	"github.com/keycloak/keycloak-operator/pkg/apis/keycloak/v1alpha1"
+)
+
 // Group representation
 // https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_grouprepresentation
 type Group struct {
-	Name      string   `json:"name,omitempty"`
-	ID        string   `json:"id,omitempty"`
-	SubGroups []*Group `json:"subGroups,omitempty"`
+	Name       string              `json:"name,omitempty"`
+	ID         string              `json:"id,omitempty"`
+	Path       string              `json:"path,omitempty"`
+	Attributes map[string][]string `json:"attributes,omitempty"`
+	SubGroups  []*Group            `json:"subGroups,omitempty"`
+	// SubGroupCount is only returned by Keycloak versions that load the
+	// subgroups lazily, SubGroups is empty in that case
+	SubGroupCount int `json:"subGroupCount,omitempty"`
+}
+
+// IdentityProvider representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_identityproviderrepresentation
+type IdentityProvider struct {
+	Alias                     string            `json:"alias,omitempty"`
+	DisplayName               string            `json:"displayName,omitempty"`
+	InternalID                string            `json:"internalId,omitempty"`
+	ProviderID                string            `json:"providerId,omitempty"`
+	Enabled                   bool              `json:"enabled"`
+	TrustEmail                bool              `json:"trustEmail,omitempty"`
+	StoreToken                bool              `json:"storeToken,omitempty"`
+	AddReadTokenRoleOnCreate  bool              `json:"addReadTokenRoleOnCreate,omitempty"`
+	FirstBrokerLoginFlowAlias string            `json:"firstBrokerLoginFlowAlias,omitempty"`
+	PostBrokerLoginFlowAlias  string            `json:"postBrokerLoginFlowAlias,omitempty"`
+	LinkOnly                  bool              `json:"linkOnly,omitempty"`
+	Config                    map[string]string `json:"config,omitempty"`
+}
+
+// IdentityProviderMapper representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_identityprovidermapperrepresentation
+type IdentityProviderMapper struct {
+	ID                     string            `json:"id,omitempty"`
+	Name                   string            `json:"name,omitempty"`
+	IdentityProviderAlias  string            `json:"identityProviderAlias,omitempty"`
+	IdentityProviderMapper string            `json:"identityProviderMapper,omitempty"`
+	Config                 map[string]string `json:"config,omitempty"`
+}
+
+// ClientScope representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_clientscoperepresentation
+type ClientScope struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Protocol    string            `json:"protocol,omitempty"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+}
+
+// ProtocolMapper representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_protocolmapperrepresentation
+type ProtocolMapper struct {
+	ID             string            `json:"id,omitempty"`
+	Name           string            `json:"name,omitempty"`
+	Protocol       string            `json:"protocol,omitempty"`
+	ProtocolMapper string            `json:"protocolMapper,omitempty"`
+	Config         map[string]string `json:"config,omitempty"`
+}
+
+// ProtocolMapperEvaluation representation, a protocol mapper applied to the
+// tokens of a client and the client or client scope it comes from
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_protocolmapperevaluationrepresentation
+type ProtocolMapperEvaluation struct {
+	MapperID       string `json:"mapperId,omitempty"`
+	MapperName     string `json:"mapperName,omitempty"`
+	ProtocolMapper string `json:"protocolMapper,omitempty"`
+	ContainerID    string `json:"containerId,omitempty"`
+	ContainerName  string `json:"containerName,omitempty"`
+	ContainerType  string `json:"containerType,omitempty"`
+}
+
+// UserSession representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_usersessionrepresentation
+type UserSession struct {
+	ID        string `json:"id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	UserID    string `json:"userId,omitempty"`
+	IPAddress string `json:"ipAddress,omitempty"`
+	// Start and LastAccess are milliseconds since the epoch
+	Start      int64 `json:"start,omitempty"`
+	LastAccess int64 `json:"lastAccess,omitempty"`
+	// Clients maps the client IDs to the client names
+	Clients map[string]string `json:"clients,omitempty"`
+}
+
+// Credential representation of a user credential, e.g. a password or an OTP
+// device. The secrets of the credential are never returned
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_credentialrepresentation
+type Credential struct {
+	ID        string `json:"id,omitempty"`
+	Type      string `json:"type,omitempty"`
+	UserLabel string `json:"userLabel,omitempty"`
+	// CreatedDate is milliseconds since the epoch
+	CreatedDate int64 `json:"createdDate,omitempty"`
+	Priority    int   `json:"priority,omitempty"`
+}
+
+// UserConsent representation, the consent of a user to a client
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_userconsentrepresentation
+type UserConsent struct {
+	ClientID            string   `json:"clientId,omitempty"`
+	GrantedClientScopes []string `json:"grantedClientScopes,omitempty"`
+	// CreatedDate and LastUpdatedDate are milliseconds since the epoch
+	CreatedDate     int64 `json:"createdDate,omitempty"`
+	LastUpdatedDate int64 `json:"lastUpdatedDate,omitempty"`
+}
+
+// BruteForceStatus is the brute force detection status of a user
+type BruteForceStatus struct {
+	Disabled    bool `json:"disabled"`
+	NumFailures int  `json:"numFailures"`
+	// LastFailure is milliseconds since the epoch, zero if the user has no
+	// login failures
+	LastFailure   int64  `json:"lastFailure,omitempty"`
+	LastIPFailure string `json:"lastIPFailure,omitempty"`
+}
+
+// ManagementPermissionReference representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_managementpermissionreference
+type ManagementPermissionReference struct {
+	Enabled  bool   `json:"enabled"`
+	Resource string `json:"resource,omitempty"`
+	// ScopePermissions maps the scope names, e.g. "manage-members", to the
+	// IDs of the permissions guarding them
+	ScopePermissions map[string]string `json:"scopePermissions,omitempty"`
+}
+
+// ServerInfo representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_serverinforepresentation
+type ServerInfo struct {
+	SystemInfo SystemInfo             `json:"systemInfo"`
+	Themes     map[string][]ThemeInfo `json:"themes,omitempty"`
+	// ProviderTypes maps the SPI names, e.g. "authenticator", to the
+	// providers installed for them. It's built from the "providers" object
+	// Keycloak returns, which is keyed by provider ID
+	ProviderTypes map[string][]ProviderInfo `json:"-"`
+}
+
+// SystemInfo representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_systeminforepresentation
+type SystemInfo struct {
+	Version      string `json:"version,omitempty"`
+	ServerTime   string `json:"serverTime,omitempty"`
+	Uptime       string `json:"uptime,omitempty"`
+	UptimeMillis int64  `json:"uptimeMillis,omitempty"`
+}
+
+// ThemeInfo representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_themeinforepresentation
+type ThemeInfo struct {
+	Name    string   `json:"name,omitempty"`
+	Locales []string `json:"locales,omitempty"`
+}
+
+// ProviderInfo representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_providerrepresentation
+type ProviderInfo struct {
+	ID              string            `json:"id,omitempty"`
+	Order           int               `json:"order,omitempty"`
+	OperationalInfo map[string]string `json:"operationalInfo,omitempty"`
+}
+
+// RealmEvent representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_eventrepresentation
+type RealmEvent struct {
+	// Time is milliseconds since the epoch
+	Time      int64             `json:"time,omitempty"`
+	Type      string            `json:"type,omitempty"`
+	RealmID   string            `json:"realmId,omitempty"`
+	ClientID  string            `json:"clientId,omitempty"`
+	UserID    string            `json:"userId,omitempty"`
+	SessionID string            `json:"sessionId,omitempty"`
+	IPAddress string            `json:"ipAddress,omitempty"`
+	Details   map[string]string `json:"details,omitempty"`
+}
+
+// EventListParams filters the events returned by ListRealmEvents, unset
+// fields aren't used for filtering
+type EventListParams struct {
+	Types  []string
+	Client string
+	User   string
+	// DateFrom and DateTo are formatted as yyyy-MM-dd
+	DateFrom string
+	DateTo   string
+	First    int
+	Max      int
+}
+
+// AdminEvent representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_admineventrepresentation
+type AdminEvent struct {
+	// Time is milliseconds since the epoch
+	Time          int64  `json:"time,omitempty"`
+	OperationType string `json:"operationType,omitempty"`
+	ResourceType  string `json:"resourceType,omitempty"`
+	ResourcePath  string `json:"resourcePath,omitempty"`
+	// Representation is the JSON of the resource, it's only set when the realm
+	// includes the representation in admin events
+	Representation string `json:"representation,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// AdminEventListParams filters the events returned by ListAdminEvents, unset
+// fields aren't used for filtering
+type AdminEventListParams struct {
+	OperationTypes []string
+	ResourceTypes  []string
+	AuthRealm      string
+	AuthClient     string
+	AuthUser       string
+	// DateFrom and DateTo are formatted as yyyy-MM-dd
+	DateFrom string
+	DateTo   string
+	First    int
+	Max      int
+}
+
+// ClientListParams filters the clients returned by ListClientsWithParams,
+// unset fields aren't used for filtering
+type ClientListParams struct {
+	// ClientID matches the clientId of the client, not its ID
+	ClientID string
+	// ViewableOnly only returns the clients the caller can view
+	ViewableOnly bool
+}
+
+// RealmEventsConfig representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_realmeventsconfigrepresentation
+type RealmEventsConfig struct {
+	AdminEventsEnabled        bool `json:"adminEventsEnabled"`
+	AdminEventsDetailsEnabled bool `json:"adminEventsDetailsEnabled"`
+	EventsEnabled             bool `json:"eventsEnabled"`
+	// EventsExpiration is in seconds
+	EventsExpiration  int64    `json:"eventsExpiration,omitempty"`
+	EventsListeners   []string `json:"eventsListeners,omitempty"`
+	EnabledEventTypes []string `json:"enabledEventTypes,omitempty"`
+}
+
+// ClientInstallation is the adapter configuration of a client returned by
+// GetClientInstallationProvider, its format depends on the provider
+type ClientInstallation struct {
+	ContentType string
+	Data        []byte
+}
+
+// Certificate representation of a client key pair
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_certificaterepresentation
+type Certificate struct {
+	Kid         string `json:"kid,omitempty"`
+	Certificate string `json:"certificate,omitempty"`
+	PublicKey   string `json:"publicKey,omitempty"`
+	PrivateKey  string `json:"privateKey,omitempty"`
+}
+
+// KeyStoreConfig representation, Format is one of "JKS" or "PKCS12" for
+// keystores, UploadClientCertificate also accepts "Certificate PEM",
+// "Public Key PEM" and "JSON Web Key Set"
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_keystoreconfig
+type KeyStoreConfig struct {
+	RealmCertificate *bool  `json:"realmCertificate,omitempty"`
+	StorePassword    string `json:"storePassword,omitempty"`
+	KeyPassword      string `json:"keyPassword,omitempty"`
+	KeyAlias         string `json:"keyAlias,omitempty"`
+	RealmAlias       string `json:"realmAlias,omitempty"`
+	Format           string `json:"format,omitempty"`
+}
+
+// RequiredAction representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_requiredactionproviderrepresentation
+type RequiredAction struct {
+	Alias         string            `json:"alias,omitempty"`
+	Name          string            `json:"name,omitempty"`
+	ProviderID    string            `json:"providerId,omitempty"`
+	Enabled       bool              `json:"enabled"`
+	DefaultAction bool              `json:"defaultAction"`
+	Priority      int               `json:"priority,omitempty"`
+	Config        map[string]string `json:"config,omitempty"`
+}
+
+// GlobalRequestResult representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_globalrequestresult
+type GlobalRequestResult struct {
+	SuccessRequests []string `json:"successRequests,omitempty"`
+	FailedRequests  []string `json:"failedRequests,omitempty"`
+}
+
+// AuthzResource representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_resourcerepresentation
+type AuthzResource struct {
+	ID                 string              `json:"_id,omitempty"`
+	Name               string              `json:"name,omitempty"`
+	DisplayName        string              `json:"displayName,omitempty"`
+	URIs               []string            `json:"uris,omitempty"`
+	Type               string              `json:"type,omitempty"`
+	Scopes             []*AuthzScope       `json:"scopes,omitempty"`
+	OwnerManagedAccess bool                `json:"ownerManagedAccess,omitempty"`
+	Attributes         map[string][]string `json:"attributes,omitempty"`
+}
+
+// AuthzScope representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_scoperepresentation
+type AuthzScope struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	IconURI     string `json:"iconUri,omitempty"`
+}
+
+// AuthzScopeListParams filters the scopes returned by ListAuthzScopes, unset
+// fields aren't used for filtering
+type AuthzScopeListParams struct {
+	Name  string
+	First int
+	Max   int
+}
+
+// AuthzResourceListParams filters the resources returned by
+// ListAuthzResources, unset fields aren't used for filtering
+type AuthzResourceListParams struct {
+	Name  string
+	URI   string
+	Type  string
+	First int
+	Max   int
+}
+
+// AuthzPolicy has the attributes common to all the policy representations,
+// Raw has the whole representation since its other attributes depend on the
+// policy type
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_abstractpolicyrepresentation
+type AuthzPolicy struct {
+	ID    string          `json:"id,omitempty"`
+	Name  string          `json:"name,omitempty"`
+	Type  string          `json:"type,omitempty"`
+	Logic string          `json:"logic,omitempty"`
+	Raw   json.RawMessage `json:"-"`
+}
+
+// AuthzPermission representation of resource and scope based permissions
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_resourcepermissionrepresentation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_scopepermissionrepresentation
+type AuthzPermission struct {
+	ID               string `json:"id,omitempty"`
+	Name             string `json:"name,omitempty"`
+	Description      string `json:"description,omitempty"`
+	Type             string `json:"type,omitempty"`
+	Logic            string `json:"logic,omitempty"`
+	DecisionStrategy string `json:"decisionStrategy,omitempty"`
+	// ResourceType applies a resource permission to all the resources of the
+	// type instead of Resources
+	ResourceType string `json:"resourceType,omitempty"`
+	// Resources, Scopes and Policies are IDs, they're only set when creating
+	// the permission, use the ListAuthzPermission* methods to read them
+	Resources []string `json:"resources,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
+	Policies  []string `json:"policies,omitempty"`
+}
+
+// PolicyEvaluationRequest representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_policyevaluationrequest
+type PolicyEvaluationRequest struct {
+	ClientID     string   `json:"clientId,omitempty"`
+	UserID       string   `json:"userId,omitempty"`
+	RoleIDs      []string `json:"roleIds,omitempty"`
+	Entitlements bool     `json:"entitlements"`
+	// Context has the attributes of the evaluation context, e.g.
+	// {"attributes": {"kc.client.network.ip_address": "127.0.0.1"}}
+	Context map[string]map[string]string `json:"context,omitempty"`
+	// Resources limits the evaluation to the resources and their scopes, all
+	// the resources are evaluated when it's empty
+	Resources []*AuthzResource `json:"resources,omitempty"`
+}
+
+// PolicyEvaluationResponse representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_policyevaluationresponse
+type PolicyEvaluationResponse struct {
+	// Status is PERMIT or DENY
+	Status       string                    `json:"status,omitempty"`
+	Entitlements bool                      `json:"entitlements"`
+	Results      []*PolicyEvaluationResult `json:"results,omitempty"`
+}
+
+// PolicyEvaluationResult is the evaluation of a resource in a
+// PolicyEvaluationResponse
+type PolicyEvaluationResult struct {
+	Resource      *AuthzResource `json:"resource,omitempty"`
+	Scopes        []*AuthzScope  `json:"scopes,omitempty"`
+	AllowedScopes []*AuthzScope  `json:"allowedScopes,omitempty"`
+	// Status is PERMIT or DENY
+	Status string `json:"status,omitempty"`
+}
+
+// Component representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_componentrepresentation
+type Component struct {
+	ID           string              `json:"id,omitempty"`
+	Name         string              `json:"name,omitempty"`
+	ProviderID   string              `json:"providerId,omitempty"`
+	ProviderType string              `json:"providerType,omitempty"`
+	ParentID     string              `json:"parentId,omitempty"`
+	SubType      string              `json:"subType,omitempty"`
+	Config       map[string][]string `json:"config,omitempty"`
+}
+
+// ComponentListParams filters the components returned by ListComponents,
+// unset fields aren't used for filtering
+type ComponentListParams struct {
+	// Parent is the ID of the parent, e.g. of the realm or of an LDAP provider
+	// for its mappers
+	Parent string
+	// Type is the provider type, e.g. "org.keycloak.storage.UserStorageProvider"
+	Type string
+	Name string
+}
+
+// LDAPSyncType is the kind of synchronization done by TriggerLDAPSync
+type LDAPSyncType string
+
+const (
+	// SyncTypeFull synchronizes all the LDAP users
+	SyncTypeFull LDAPSyncType = "triggerFullSync"
+	// SyncTypeChangedUsers synchronizes the LDAP users changed since the last
+	// synchronization
+	SyncTypeChangedUsers LDAPSyncType = "triggerChangedUsersSync"
+)
+
+// LDAPSyncResult representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_synchronizationresult
+type LDAPSyncResult struct {
+	Added   int    `json:"added"`
+	Updated int    `json:"updated"`
+	Removed int    `json:"removed"`
+	Failed  int    `json:"failed"`
+	Status  string `json:"status,omitempty"`
+}
+
+// RealmKeyMetadata representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_keysmetadatarepresentation
+type RealmKeyMetadata struct {
+	// Active maps the algorithms to the kid of the key used for them
+	Active map[string]string `json:"active,omitempty"`
+	Keys   []*KeyMetadata    `json:"keys,omitempty"`
+}
+
+// KeyMetadata representation
+// https://www.keycloak.org/docs-api/9.0/rest-api/index.html#_keymetadatarepresentation
+type KeyMetadata struct {
+	Kid         string `json:"kid,omitempty"`
+	Use         string `json:"use,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Algorithm   string `json:"algorithm,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Certificate string `json:"certificate,omitempty"`
+	// Provider is the ID of the key provider component
+	Provider string `json:"providerId,omitempty"`
+}
+
+// OIDCConfiguration is the OpenID Connect discovery document of a realm
+// https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type OIDCConfiguration struct {
+	Issuer                string   `json:"issuer,omitempty"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint,omitempty"`
+	TokenEndpoint         string   `json:"token_endpoint,omitempty"`
+	UserinfoEndpoint      string   `json:"userinfo_endpoint,omitempty"`
+	EndSessionEndpoint    string   `json:"end_session_endpoint,omitempty"`
+	JWKSUri               string   `json:"jwks_uri,omitempty"`
+	SupportedGrantTypes   []string `json:"grant_types_supported,omitempty"`
+	SupportedScopes       []string `json:"scopes_supported,omitempty"`
+}
+
+// JWKS is the JSON Web Key Set of a realm, used to validate the signatures of
+// the tokens it issues
+// https://tools.ietf.org/html/rfc7517#section-5
+type JWKS struct {
+	Keys []*JWK `json:"keys"`
+}
+
+// JWK is a public key of a realm
+// https://tools.ietf.org/html/rfc7517#section-4
+type JWK struct {
+	Kid string   `json:"kid,omitempty"`
+	Kty string   `json:"kty,omitempty"`
+	Alg string   `json:"alg,omitempty"`
+	Use string   `json:"use,omitempty"`
+	N   string   `json:"n,omitempty"`
+	E   string   `json:"e,omitempty"`
+	X5c []string `json:"x5c,omitempty"`
+}
+
+// TokenResponse is the response of the token endpoint of a realm, it adds the
+// token exchange fields missing from v1alpha1.TokenResponse
+// https://tools.ietf.org/html/rfc8693#section-2.2.1
+type TokenResponse struct {
+	v1alpha1.TokenResponse
+	IssuedTokenType string `json:"issued_token_type,omitempty"`
+	Scope           string `json:"scope,omitempty"`
+}
+
+// TokenIntrospection is the introspection response of a token, only Active is
+// set for expired, revoked or otherwise invalid tokens
+// https://tools.ietf.org/html/rfc7662#section-2.2
+type TokenIntrospection struct {
+	Active   bool   `json:"active"`
+	Sub      string `json:"sub,omitempty"`
+	Scope    string `json:"scope,omitempty"`
+	ClientID string `json:"client_id,omitempty"`
+	Username string `json:"username,omitempty"`
+	Exp      int64  `json:"exp,omitempty"`
+	Iat      int64  `json:"iat,omitempty"`
 }